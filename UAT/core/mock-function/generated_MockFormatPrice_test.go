@@ -5,6 +5,8 @@ package mockfunction_test
 
 import (
 	_imptest "github.com/toejough/imptest"
+	_reflect "reflect"
+	_time "time"
 )
 
 type FormatPriceMockArgs struct {
@@ -30,6 +32,11 @@ func (c *FormatPriceMockCall) Return(result0 string) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *FormatPriceMockCall) ReturnAfter(d _time.Duration, result0 string) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type FormatPriceMockMethod struct {
 	*_imptest.DependencyMethod
 	// Eventually provides async version of this function for concurrent code.
@@ -42,16 +49,29 @@ func (m *FormatPriceMockMethod) ArgsEqual(amount float64, currency string) *Form
 	return &FormatPriceMockCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *FormatPriceMockMethod) ArgsShould(matchers ...any) *FormatPriceMockCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *FormatPriceMockMethod) ArgsShould(amount any, currency any) *FormatPriceMockCall {
+	_call := m.DependencyMethod.ArgsShould(amount, currency)
+	return &FormatPriceMockCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *FormatPriceMockMethod) ArgsWhere(predicate func(FormatPriceMockArgs) error) *FormatPriceMockCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args FormatPriceMockArgs
+		args.Amount, _ = raw[0].(float64)
+		args.Currency, _ = raw[1].(string)
+		return predicate(args)
+	})
 	return &FormatPriceMockCall{DependencyCall: call}
 }
 
 // MockFormatPrice creates a mock FormatPrice function and returns (mock, expectation handle).
 func MockFormatPrice(t _imptest.TestReporter) (func(amount float64, currency string) string, *FormatPriceMockMethod) {
 	ctrl := _imptest.GetOrCreateImp(t)
-	imp := newFormatPriceMockMethod(_imptest.NewDependencyMethod(ctrl, "FormatPrice"))
+	imp := newFormatPriceMockMethod(_imptest.NewDependencyMethod(ctrl, "FormatPrice").Results(_reflect.TypeFor[string]()))
 	mock := func(amount float64, currency string) string {
 		call := &_imptest.GenericCall{
 			MethodName:   "FormatPrice",
@@ -60,9 +80,7 @@ func MockFormatPrice(t _imptest.TestReporter) (func(amount float64, currency str
 		}
 		ctrl.CallChan <- call
 		resp := <-call.ResponseChan
-		if resp.Type == "panic" {
-			panic(resp.PanicValue)
-		}
+		resp.Resolve()
 
 		var result1 string
 		if len(resp.ReturnValues) > 0 {
@@ -37,16 +37,29 @@ func (m *NotifyMockMethod) ArgsEqual(userID int, message string) *NotifyMockCall
 	return &NotifyMockCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *NotifyMockMethod) ArgsShould(matchers ...any) *NotifyMockCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *NotifyMockMethod) ArgsShould(userID any, message any) *NotifyMockCall {
+	_call := m.DependencyMethod.ArgsShould(userID, message)
+	return &NotifyMockCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *NotifyMockMethod) ArgsWhere(predicate func(NotifyMockArgs) error) *NotifyMockCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args NotifyMockArgs
+		args.UserID, _ = raw[0].(int)
+		args.Message, _ = raw[1].(string)
+		return predicate(args)
+	})
 	return &NotifyMockCall{DependencyCall: call}
 }
 
 // MockNotify creates a mock Notify function and returns (mock, expectation handle).
 func MockNotify(t _imptest.TestReporter) (func(userID int, message string), *NotifyMockMethod) {
 	ctrl := _imptest.GetOrCreateImp(t)
-	imp := newNotifyMockMethod(_imptest.NewDependencyMethod(ctrl, "Notify"))
+	imp := newNotifyMockMethod(_imptest.NewDependencyMethod(ctrl, "Notify").Results())
 	mock := func(userID int, message string) {
 		call := &_imptest.GenericCall{
 			MethodName:   "Notify",
@@ -55,9 +68,7 @@ func MockNotify(t _imptest.TestReporter) (func(userID int, message string), *Not
 		}
 		ctrl.CallChan <- call
 		resp := <-call.ResponseChan
-		if resp.Type == "panic" {
-			panic(resp.PanicValue)
-		}
+		resp.Resolve()
 
 	}
 	return mock, imp
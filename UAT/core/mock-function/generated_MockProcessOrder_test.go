@@ -7,6 +7,8 @@ import (
 	context "context"
 	_imptest "github.com/toejough/imptest"
 	mockfunction "github.com/toejough/imptest/UAT/core/mock-function"
+	_reflect "reflect"
+	_time "time"
 )
 
 type ProcessOrderMockArgs struct {
@@ -32,6 +34,11 @@ func (c *ProcessOrderMockCall) Return(result0 *mockfunction.Order, result1 error
 	c.DependencyCall.Return(result0, result1)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *ProcessOrderMockCall) ReturnAfter(d _time.Duration, result0 *mockfunction.Order, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
 type ProcessOrderMockMethod struct {
 	*_imptest.DependencyMethod
 	// Eventually provides async version of this function for concurrent code.
@@ -44,16 +51,29 @@ func (m *ProcessOrderMockMethod) ArgsEqual(ctx context.Context, orderID int) *Pr
 	return &ProcessOrderMockCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *ProcessOrderMockMethod) ArgsShould(matchers ...any) *ProcessOrderMockCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *ProcessOrderMockMethod) ArgsShould(ctx any, orderID any) *ProcessOrderMockCall {
+	_call := m.DependencyMethod.ArgsShould(ctx, orderID)
+	return &ProcessOrderMockCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *ProcessOrderMockMethod) ArgsWhere(predicate func(ProcessOrderMockArgs) error) *ProcessOrderMockCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args ProcessOrderMockArgs
+		args.Ctx, _ = raw[0].(context.Context)
+		args.OrderID, _ = raw[1].(int)
+		return predicate(args)
+	})
 	return &ProcessOrderMockCall{DependencyCall: call}
 }
 
 // MockProcessOrder creates a mock ProcessOrder function and returns (mock, expectation handle).
 func MockProcessOrder(t _imptest.TestReporter) (func(ctx context.Context, orderID int) (*mockfunction.Order, error), *ProcessOrderMockMethod) {
 	ctrl := _imptest.GetOrCreateImp(t)
-	imp := newProcessOrderMockMethod(_imptest.NewDependencyMethod(ctrl, "ProcessOrder"))
+	imp := newProcessOrderMockMethod(_imptest.NewDependencyMethod(ctrl, "ProcessOrder").Results(_reflect.TypeFor[*mockfunction.Order](), _reflect.TypeFor[error]()))
 	mock := func(ctx context.Context, orderID int) (*mockfunction.Order, error) {
 		call := &_imptest.GenericCall{
 			MethodName:   "ProcessOrder",
@@ -62,9 +82,7 @@ func MockProcessOrder(t _imptest.TestReporter) (func(ctx context.Context, orderI
 		}
 		ctrl.CallChan <- call
 		resp := <-call.ResponseChan
-		if resp.Type == "panic" {
-			panic(resp.PanicValue)
-		}
+		resp.Resolve()
 
 		var result1 *mockfunction.Order
 		if len(resp.ReturnValues) > 0 {
@@ -6,6 +6,8 @@ package mockfunction_test
 import (
 	_imptest "github.com/toejough/imptest"
 	mockfunction "github.com/toejough/imptest/UAT/core/mock-function"
+	_reflect "reflect"
+	_time "time"
 )
 
 type TransformDataMockArgs struct {
@@ -18,6 +20,12 @@ type TransformDataMockCall struct {
 	*_imptest.DependencyCall
 }
 
+// FillItems copies values into the caller's Items slice before the mock returns.
+func (c *TransformDataMockCall) FillItems(values []*mockfunction.Order) *TransformDataMockCall {
+	c.FillArg(0, values)
+	return c
+}
+
 // GetArgs returns the typed arguments for this call.
 func (c *TransformDataMockCall) GetArgs() TransformDataMockArgs {
 	raw := c.RawArgs()
@@ -33,6 +41,11 @@ func (c *TransformDataMockCall) Return(result0 *mockfunction.Order, result1 erro
 	c.DependencyCall.Return(result0, result1)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *TransformDataMockCall) ReturnAfter(d _time.Duration, result0 *mockfunction.Order, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
 type TransformDataMockMethod struct {
 	*_imptest.DependencyMethod
 	// Eventually provides async version of this function for concurrent code.
@@ -45,16 +58,30 @@ func (m *TransformDataMockMethod) ArgsEqual(items []*mockfunction.Order, lookup
 	return &TransformDataMockCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *TransformDataMockMethod) ArgsShould(matchers ...any) *TransformDataMockCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *TransformDataMockMethod) ArgsShould(items any, lookup any, processor any) *TransformDataMockCall {
+	_call := m.DependencyMethod.ArgsShould(items, lookup, processor)
+	return &TransformDataMockCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *TransformDataMockMethod) ArgsWhere(predicate func(TransformDataMockArgs) error) *TransformDataMockCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args TransformDataMockArgs
+		args.Items, _ = raw[0].([]*mockfunction.Order)
+		args.Lookup, _ = raw[1].(map[string]*mockfunction.Order)
+		args.Processor, _ = raw[2].(func(*mockfunction.Order) error)
+		return predicate(args)
+	})
 	return &TransformDataMockCall{DependencyCall: call}
 }
 
 // MockTransformData creates a mock TransformData function and returns (mock, expectation handle).
 func MockTransformData(t _imptest.TestReporter) (func(items []*mockfunction.Order, lookup map[string]*mockfunction.Order, processor func(*mockfunction.Order) error) (*mockfunction.Order, error), *TransformDataMockMethod) {
 	ctrl := _imptest.GetOrCreateImp(t)
-	imp := newTransformDataMockMethod(_imptest.NewDependencyMethod(ctrl, "TransformData"))
+	imp := newTransformDataMockMethod(_imptest.NewDependencyMethod(ctrl, "TransformData").Results(_reflect.TypeFor[*mockfunction.Order](), _reflect.TypeFor[error]()))
 	mock := func(items []*mockfunction.Order, lookup map[string]*mockfunction.Order, processor func(*mockfunction.Order) error) (*mockfunction.Order, error) {
 		call := &_imptest.GenericCall{
 			MethodName:   "TransformData",
@@ -63,9 +90,7 @@ func MockTransformData(t _imptest.TestReporter) (func(items []*mockfunction.Orde
 		}
 		ctrl.CallChan <- call
 		resp := <-call.ResponseChan
-		if resp.Type == "panic" {
-			panic(resp.PanicValue)
-		}
+		resp.Resolve()
 
 		var result1 *mockfunction.Order
 		if len(resp.ReturnValues) > 0 {
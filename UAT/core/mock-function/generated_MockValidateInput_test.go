@@ -5,6 +5,8 @@ package mockfunction_test
 
 import (
 	_imptest "github.com/toejough/imptest"
+	_reflect "reflect"
+	_time "time"
 )
 
 type ValidateInputMockArgs struct {
@@ -28,6 +30,11 @@ func (c *ValidateInputMockCall) Return(result0 error) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *ValidateInputMockCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type ValidateInputMockMethod struct {
 	*_imptest.DependencyMethod
 	// Eventually provides async version of this function for concurrent code.
@@ -40,16 +47,28 @@ func (m *ValidateInputMockMethod) ArgsEqual(input string) *ValidateInputMockCall
 	return &ValidateInputMockCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *ValidateInputMockMethod) ArgsShould(matchers ...any) *ValidateInputMockCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *ValidateInputMockMethod) ArgsShould(input any) *ValidateInputMockCall {
+	_call := m.DependencyMethod.ArgsShould(input)
+	return &ValidateInputMockCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *ValidateInputMockMethod) ArgsWhere(predicate func(ValidateInputMockArgs) error) *ValidateInputMockCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args ValidateInputMockArgs
+		args.Input, _ = raw[0].(string)
+		return predicate(args)
+	})
 	return &ValidateInputMockCall{DependencyCall: call}
 }
 
 // MockValidateInput creates a mock ValidateInput function and returns (mock, expectation handle).
 func MockValidateInput(t _imptest.TestReporter) (func(input string) error, *ValidateInputMockMethod) {
 	ctrl := _imptest.GetOrCreateImp(t)
-	imp := newValidateInputMockMethod(_imptest.NewDependencyMethod(ctrl, "ValidateInput"))
+	imp := newValidateInputMockMethod(_imptest.NewDependencyMethod(ctrl, "ValidateInput").Results(_reflect.TypeFor[error]()))
 	mock := func(input string) error {
 		call := &_imptest.GenericCall{
 			MethodName:   "ValidateInput",
@@ -58,9 +77,7 @@ func MockValidateInput(t _imptest.TestReporter) (func(input string) error, *Vali
 		}
 		ctrl.CallChan <- call
 		resp := <-call.ResponseChan
-		if resp.Type == "panic" {
-			panic(resp.PanicValue)
-		}
+		resp.Resolve()
 
 		var result1 error
 		if len(resp.ReturnValues) > 0 {
@@ -5,6 +5,8 @@ package handlers_test
 
 import (
 	_imptest "github.com/toejough/imptest"
+	_reflect "reflect"
+	_time "time"
 )
 
 type ValidatorMockArgs struct {
@@ -28,6 +30,11 @@ func (c *ValidatorMockCall) Return(result0 error) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *ValidatorMockCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type ValidatorMockMethod struct {
 	*_imptest.DependencyMethod
 	// Eventually provides async version of this function for concurrent code.
@@ -40,16 +47,28 @@ func (m *ValidatorMockMethod) ArgsEqual(data string) *ValidatorMockCall {
 	return &ValidatorMockCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *ValidatorMockMethod) ArgsShould(matchers ...any) *ValidatorMockCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *ValidatorMockMethod) ArgsShould(data any) *ValidatorMockCall {
+	_call := m.DependencyMethod.ArgsShould(data)
+	return &ValidatorMockCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *ValidatorMockMethod) ArgsWhere(predicate func(ValidatorMockArgs) error) *ValidatorMockCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args ValidatorMockArgs
+		args.Data, _ = raw[0].(string)
+		return predicate(args)
+	})
 	return &ValidatorMockCall{DependencyCall: call}
 }
 
 // MockValidator creates a mock Validator function and returns (mock, expectation handle).
 func MockValidator(t _imptest.TestReporter) (func(data string) error, *ValidatorMockMethod) {
 	ctrl := _imptest.GetOrCreateImp(t)
-	imp := newValidatorMockMethod(_imptest.NewDependencyMethod(ctrl, "Validator"))
+	imp := newValidatorMockMethod(_imptest.NewDependencyMethod(ctrl, "Validator").Results(_reflect.TypeFor[error]()))
 	mock := func(data string) error {
 		call := &_imptest.GenericCall{
 			MethodName:   "Validator",
@@ -58,9 +77,7 @@ func MockValidator(t _imptest.TestReporter) (func(data string) error, *Validator
 		}
 		ctrl.CallChan <- call
 		resp := <-call.ResponseChan
-		if resp.Type == "panic" {
-			panic(resp.PanicValue)
-		}
+		resp.Resolve()
 
 		var result1 error
 		if len(resp.ReturnValues) > 0 {
@@ -6,6 +6,8 @@ package basic_test
 import (
 	_imptest "github.com/toejough/imptest"
 	basic "github.com/toejough/imptest/UAT/core/mock-interface"
+	_reflect "reflect"
+	_time "time"
 )
 
 type CustomOpsImp struct {
@@ -49,6 +51,11 @@ func (c *CustomOpsMockAddCall) Return(result0 int) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *CustomOpsMockAddCall) ReturnAfter(d _time.Duration, result0 int) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type CustomOpsMockAddMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -59,9 +66,22 @@ func (m *CustomOpsMockAddMethod) ArgsEqual(a int, b int) *CustomOpsMockAddCall {
 	return &CustomOpsMockAddCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *CustomOpsMockAddMethod) ArgsShould(matchers ...any) *CustomOpsMockAddCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *CustomOpsMockAddMethod) ArgsShould(a any, b any) *CustomOpsMockAddCall {
+	_call := m.DependencyMethod.ArgsShould(a, b)
+	return &CustomOpsMockAddCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *CustomOpsMockAddMethod) ArgsWhere(predicate func(CustomOpsMockAddArgs) error) *CustomOpsMockAddCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args CustomOpsMockAddArgs
+		args.A, _ = raw[0].(int)
+		args.B, _ = raw[1].(int)
+		return predicate(args)
+	})
 	return &CustomOpsMockAddCall{DependencyCall: call}
 }
 
@@ -74,6 +94,11 @@ func (c *CustomOpsMockFinishCall) Return(result0 bool) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *CustomOpsMockFinishCall) ReturnAfter(d _time.Duration, result0 bool) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type CustomOpsMockLogArgs struct {
 	Message string
 }
@@ -100,9 +125,21 @@ func (m *CustomOpsMockLogMethod) ArgsEqual(message string) *CustomOpsMockLogCall
 	return &CustomOpsMockLogCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *CustomOpsMockLogMethod) ArgsShould(matchers ...any) *CustomOpsMockLogCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *CustomOpsMockLogMethod) ArgsShould(message any) *CustomOpsMockLogCall {
+	_call := m.DependencyMethod.ArgsShould(message)
+	return &CustomOpsMockLogCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *CustomOpsMockLogMethod) ArgsWhere(predicate func(CustomOpsMockLogArgs) error) *CustomOpsMockLogCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args CustomOpsMockLogArgs
+		args.Message, _ = raw[0].(string)
+		return predicate(args)
+	})
 	return &CustomOpsMockLogCall{DependencyCall: call}
 }
 
@@ -129,6 +166,11 @@ func (c *CustomOpsMockNotifyCall) Return(result0 bool) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *CustomOpsMockNotifyCall) ReturnAfter(d _time.Duration, result0 bool) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type CustomOpsMockNotifyMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -143,9 +185,27 @@ func (m *CustomOpsMockNotifyMethod) ArgsEqual(message string, ids ...int) *Custo
 	return &CustomOpsMockNotifyCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *CustomOpsMockNotifyMethod) ArgsShould(matchers ...any) *CustomOpsMockNotifyCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *CustomOpsMockNotifyMethod) ArgsShould(message any, ids ...any) *CustomOpsMockNotifyCall {
+	_matchers := []any{message}
+	_matchers = append(_matchers, ids...)
+	_call := m.DependencyMethod.ArgsShould(_matchers...)
+	return &CustomOpsMockNotifyCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *CustomOpsMockNotifyMethod) ArgsWhere(predicate func(CustomOpsMockNotifyArgs) error) *CustomOpsMockNotifyCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args CustomOpsMockNotifyArgs
+		args.Message, _ = raw[0].(string)
+		for _, value := range raw[1:] {
+			elem, _ := value.(int)
+			args.Ids = append(args.Ids, elem)
+		}
+		return predicate(args)
+	})
 	return &CustomOpsMockNotifyCall{DependencyCall: call}
 }
 
@@ -172,6 +232,11 @@ func (c *CustomOpsMockStoreCall) Return(result0 int, result1 error) {
 	c.DependencyCall.Return(result0, result1)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *CustomOpsMockStoreCall) ReturnAfter(d _time.Duration, result0 int, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
 type CustomOpsMockStoreMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -182,9 +247,22 @@ func (m *CustomOpsMockStoreMethod) ArgsEqual(key string, value any) *CustomOpsMo
 	return &CustomOpsMockStoreCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *CustomOpsMockStoreMethod) ArgsShould(matchers ...any) *CustomOpsMockStoreCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *CustomOpsMockStoreMethod) ArgsShould(key any, value any) *CustomOpsMockStoreCall {
+	_call := m.DependencyMethod.ArgsShould(key, value)
+	return &CustomOpsMockStoreCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *CustomOpsMockStoreMethod) ArgsWhere(predicate func(CustomOpsMockStoreArgs) error) *CustomOpsMockStoreCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args CustomOpsMockStoreArgs
+		args.Key, _ = raw[0].(string)
+		args.Value, _ = raw[1].(any)
+		return predicate(args)
+	})
 	return &CustomOpsMockStoreCall{DependencyCall: call}
 }
 
@@ -192,18 +270,18 @@ func (m *CustomOpsMockStoreMethod) ArgsShould(matchers ...any) *CustomOpsMockSto
 func MockCustomOps(t _imptest.TestReporter) (basic.Ops, *CustomOpsImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &CustomOpsImp{
-		Add:    newCustomOpsMockAddMethod(_imptest.NewDependencyMethod(ctrl, "Add")),
-		Store:  newCustomOpsMockStoreMethod(_imptest.NewDependencyMethod(ctrl, "Store")),
-		Log:    newCustomOpsMockLogMethod(_imptest.NewDependencyMethod(ctrl, "Log")),
-		Notify: newCustomOpsMockNotifyMethod(_imptest.NewDependencyMethod(ctrl, "Notify")),
-		Finish: _imptest.NewDependencyMethod(ctrl, "Finish"),
+		Add:    newCustomOpsMockAddMethod(_imptest.NewDependencyMethod(ctrl, "Add").Results(_reflect.TypeFor[int]())),
+		Store:  newCustomOpsMockStoreMethod(_imptest.NewDependencyMethod(ctrl, "Store").Results(_reflect.TypeFor[int](), _reflect.TypeFor[error]())),
+		Log:    newCustomOpsMockLogMethod(_imptest.NewDependencyMethod(ctrl, "Log").Results()),
+		Notify: newCustomOpsMockNotifyMethod(_imptest.NewDependencyMethod(ctrl, "Notify").Results(_reflect.TypeFor[bool]())),
+		Finish: _imptest.NewDependencyMethod(ctrl, "Finish").Results(_reflect.TypeFor[bool]()),
 	}
 	imp.Eventually = &CustomOpsImpEventually{
-		Add:    newCustomOpsMockAddMethod(_imptest.NewDependencyMethod(ctrl, "Add").AsEventually()),
-		Store:  newCustomOpsMockStoreMethod(_imptest.NewDependencyMethod(ctrl, "Store").AsEventually()),
-		Log:    newCustomOpsMockLogMethod(_imptest.NewDependencyMethod(ctrl, "Log").AsEventually()),
-		Notify: newCustomOpsMockNotifyMethod(_imptest.NewDependencyMethod(ctrl, "Notify").AsEventually()),
-		Finish: _imptest.NewDependencyMethod(ctrl, "Finish").AsEventually(),
+		Add:    newCustomOpsMockAddMethod(_imptest.NewDependencyMethod(ctrl, "Add").Results(_reflect.TypeFor[int]()).AsEventually()),
+		Store:  newCustomOpsMockStoreMethod(_imptest.NewDependencyMethod(ctrl, "Store").Results(_reflect.TypeFor[int](), _reflect.TypeFor[error]()).AsEventually()),
+		Log:    newCustomOpsMockLogMethod(_imptest.NewDependencyMethod(ctrl, "Log").Results().AsEventually()),
+		Notify: newCustomOpsMockNotifyMethod(_imptest.NewDependencyMethod(ctrl, "Notify").Results(_reflect.TypeFor[bool]()).AsEventually()),
+		Finish: _imptest.NewDependencyMethod(ctrl, "Finish").Results(_reflect.TypeFor[bool]()).AsEventually(),
 	}
 	mock := &mockCustomOpsImpl{ctrl: ctrl}
 	return mock, imp
@@ -222,9 +300,7 @@ func (impl *mockCustomOpsImpl) Add(a int, b int) int {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 int
 	if len(resp.ReturnValues) > 0 {
@@ -245,9 +321,7 @@ func (impl *mockCustomOpsImpl) Finish() bool {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 bool
 	if len(resp.ReturnValues) > 0 {
@@ -268,9 +342,7 @@ func (impl *mockCustomOpsImpl) Log(message string) {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 }
 
@@ -287,9 +359,7 @@ func (impl *mockCustomOpsImpl) Notify(message string, ids ...int) bool {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 bool
 	if len(resp.ReturnValues) > 0 {
@@ -310,9 +380,7 @@ func (impl *mockCustomOpsImpl) Store(key string, value any) (int, error) {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 int
 	if len(resp.ReturnValues) > 0 {
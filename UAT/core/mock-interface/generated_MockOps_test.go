@@ -6,6 +6,8 @@ package basic_test
 import (
 	_imptest "github.com/toejough/imptest"
 	basic "github.com/toejough/imptest/UAT/core/mock-interface"
+	_reflect "reflect"
+	_time "time"
 )
 
 type OpsImp struct {
@@ -49,6 +51,11 @@ func (c *OpsMockAddCall) Return(result0 int) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *OpsMockAddCall) ReturnAfter(d _time.Duration, result0 int) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type OpsMockAddMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -59,9 +66,22 @@ func (m *OpsMockAddMethod) ArgsEqual(a int, b int) *OpsMockAddCall {
 	return &OpsMockAddCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *OpsMockAddMethod) ArgsShould(matchers ...any) *OpsMockAddCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *OpsMockAddMethod) ArgsShould(a any, b any) *OpsMockAddCall {
+	_call := m.DependencyMethod.ArgsShould(a, b)
+	return &OpsMockAddCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *OpsMockAddMethod) ArgsWhere(predicate func(OpsMockAddArgs) error) *OpsMockAddCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args OpsMockAddArgs
+		args.A, _ = raw[0].(int)
+		args.B, _ = raw[1].(int)
+		return predicate(args)
+	})
 	return &OpsMockAddCall{DependencyCall: call}
 }
 
@@ -74,6 +94,11 @@ func (c *OpsMockFinishCall) Return(result0 bool) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *OpsMockFinishCall) ReturnAfter(d _time.Duration, result0 bool) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type OpsMockLogArgs struct {
 	Message string
 }
@@ -100,9 +125,21 @@ func (m *OpsMockLogMethod) ArgsEqual(message string) *OpsMockLogCall {
 	return &OpsMockLogCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *OpsMockLogMethod) ArgsShould(matchers ...any) *OpsMockLogCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *OpsMockLogMethod) ArgsShould(message any) *OpsMockLogCall {
+	_call := m.DependencyMethod.ArgsShould(message)
+	return &OpsMockLogCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *OpsMockLogMethod) ArgsWhere(predicate func(OpsMockLogArgs) error) *OpsMockLogCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args OpsMockLogArgs
+		args.Message, _ = raw[0].(string)
+		return predicate(args)
+	})
 	return &OpsMockLogCall{DependencyCall: call}
 }
 
@@ -129,6 +166,11 @@ func (c *OpsMockNotifyCall) Return(result0 bool) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *OpsMockNotifyCall) ReturnAfter(d _time.Duration, result0 bool) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type OpsMockNotifyMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -143,9 +185,27 @@ func (m *OpsMockNotifyMethod) ArgsEqual(message string, ids ...int) *OpsMockNoti
 	return &OpsMockNotifyCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *OpsMockNotifyMethod) ArgsShould(matchers ...any) *OpsMockNotifyCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *OpsMockNotifyMethod) ArgsShould(message any, ids ...any) *OpsMockNotifyCall {
+	_matchers := []any{message}
+	_matchers = append(_matchers, ids...)
+	_call := m.DependencyMethod.ArgsShould(_matchers...)
+	return &OpsMockNotifyCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *OpsMockNotifyMethod) ArgsWhere(predicate func(OpsMockNotifyArgs) error) *OpsMockNotifyCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args OpsMockNotifyArgs
+		args.Message, _ = raw[0].(string)
+		for _, value := range raw[1:] {
+			elem, _ := value.(int)
+			args.Ids = append(args.Ids, elem)
+		}
+		return predicate(args)
+	})
 	return &OpsMockNotifyCall{DependencyCall: call}
 }
 
@@ -172,6 +232,11 @@ func (c *OpsMockStoreCall) Return(result0 int, result1 error) {
 	c.DependencyCall.Return(result0, result1)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *OpsMockStoreCall) ReturnAfter(d _time.Duration, result0 int, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
 type OpsMockStoreMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -182,9 +247,22 @@ func (m *OpsMockStoreMethod) ArgsEqual(key string, value any) *OpsMockStoreCall
 	return &OpsMockStoreCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *OpsMockStoreMethod) ArgsShould(matchers ...any) *OpsMockStoreCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *OpsMockStoreMethod) ArgsShould(key any, value any) *OpsMockStoreCall {
+	_call := m.DependencyMethod.ArgsShould(key, value)
+	return &OpsMockStoreCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *OpsMockStoreMethod) ArgsWhere(predicate func(OpsMockStoreArgs) error) *OpsMockStoreCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args OpsMockStoreArgs
+		args.Key, _ = raw[0].(string)
+		args.Value, _ = raw[1].(any)
+		return predicate(args)
+	})
 	return &OpsMockStoreCall{DependencyCall: call}
 }
 
@@ -192,18 +270,18 @@ func (m *OpsMockStoreMethod) ArgsShould(matchers ...any) *OpsMockStoreCall {
 func MockOps(t _imptest.TestReporter) (basic.Ops, *OpsImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &OpsImp{
-		Add:    newOpsMockAddMethod(_imptest.NewDependencyMethod(ctrl, "Add")),
-		Store:  newOpsMockStoreMethod(_imptest.NewDependencyMethod(ctrl, "Store")),
-		Log:    newOpsMockLogMethod(_imptest.NewDependencyMethod(ctrl, "Log")),
-		Notify: newOpsMockNotifyMethod(_imptest.NewDependencyMethod(ctrl, "Notify")),
-		Finish: _imptest.NewDependencyMethod(ctrl, "Finish"),
+		Add:    newOpsMockAddMethod(_imptest.NewDependencyMethod(ctrl, "Add").Results(_reflect.TypeFor[int]())),
+		Store:  newOpsMockStoreMethod(_imptest.NewDependencyMethod(ctrl, "Store").Results(_reflect.TypeFor[int](), _reflect.TypeFor[error]())),
+		Log:    newOpsMockLogMethod(_imptest.NewDependencyMethod(ctrl, "Log").Results()),
+		Notify: newOpsMockNotifyMethod(_imptest.NewDependencyMethod(ctrl, "Notify").Results(_reflect.TypeFor[bool]())),
+		Finish: _imptest.NewDependencyMethod(ctrl, "Finish").Results(_reflect.TypeFor[bool]()),
 	}
 	imp.Eventually = &OpsImpEventually{
-		Add:    newOpsMockAddMethod(_imptest.NewDependencyMethod(ctrl, "Add").AsEventually()),
-		Store:  newOpsMockStoreMethod(_imptest.NewDependencyMethod(ctrl, "Store").AsEventually()),
-		Log:    newOpsMockLogMethod(_imptest.NewDependencyMethod(ctrl, "Log").AsEventually()),
-		Notify: newOpsMockNotifyMethod(_imptest.NewDependencyMethod(ctrl, "Notify").AsEventually()),
-		Finish: _imptest.NewDependencyMethod(ctrl, "Finish").AsEventually(),
+		Add:    newOpsMockAddMethod(_imptest.NewDependencyMethod(ctrl, "Add").Results(_reflect.TypeFor[int]()).AsEventually()),
+		Store:  newOpsMockStoreMethod(_imptest.NewDependencyMethod(ctrl, "Store").Results(_reflect.TypeFor[int](), _reflect.TypeFor[error]()).AsEventually()),
+		Log:    newOpsMockLogMethod(_imptest.NewDependencyMethod(ctrl, "Log").Results().AsEventually()),
+		Notify: newOpsMockNotifyMethod(_imptest.NewDependencyMethod(ctrl, "Notify").Results(_reflect.TypeFor[bool]()).AsEventually()),
+		Finish: _imptest.NewDependencyMethod(ctrl, "Finish").Results(_reflect.TypeFor[bool]()).AsEventually(),
 	}
 	mock := &mockOpsImpl{ctrl: ctrl}
 	return mock, imp
@@ -222,9 +300,7 @@ func (impl *mockOpsImpl) Add(a int, b int) int {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 int
 	if len(resp.ReturnValues) > 0 {
@@ -245,9 +321,7 @@ func (impl *mockOpsImpl) Finish() bool {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 bool
 	if len(resp.ReturnValues) > 0 {
@@ -268,9 +342,7 @@ func (impl *mockOpsImpl) Log(message string) {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 }
 
@@ -287,9 +359,7 @@ func (impl *mockOpsImpl) Notify(message string, ids ...int) bool {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 bool
 	if len(resp.ReturnValues) > 0 {
@@ -310,9 +380,7 @@ func (impl *mockOpsImpl) Store(key string, value any) (int, error) {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 int
 	if len(resp.ReturnValues) > 0 {
@@ -3,8 +3,41 @@ package basic_test
 import (
 	"errors"
 	"testing"
+
+	"github.com/toejough/imptest/match"
 )
 
+// TestTypedArgsShould demonstrates compile-time arity for matcher-based argument checks.
+// This test verifies that:
+// 1. ArgsShould takes one matcher per parameter, named after the parameters
+// 2. Variadic methods take a variadic tail of matchers, one per variadic argument
+// 3. Wrong arity would cause compile errors (see commented examples below)
+func TestTypedArgsShould(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Store_OneMatcherPerParam", func(t *testing.T) {
+		t.Parallel()
+		mock, imp := MockOps(t)
+
+		go func() {
+			_, _ = mock.Store("key", "value")
+		}()
+
+		imp.Store.ArgsShould(match.HavePrefix("k"), match.BeAny).Return(1, nil)
+	})
+
+	t.Run("Notify_VariadicTail", func(t *testing.T) {
+		t.Parallel()
+		mock, imp := MockOps(t)
+
+		go func() {
+			_ = mock.Notify("alert", 1, 2, 3)
+		}()
+
+		imp.Notify.ArgsShould("alert", 1, match.BeAny, match.BeNumerically(">", 2)).Return(true)
+	})
+}
+
 // TestTypedReturn demonstrates compile-time type safety for return value injection.
 // This test verifies that:
 // 1. Typed Return methods exist on call wrappers
@@ -99,3 +132,16 @@ func TestTypedReturn(t *testing.T) {
 //     // COMPILE ERROR: too many arguments (expected 2, got 3)
 //     call.Return(42, nil, "extra")
 // }
+
+// The following would cause COMPILE ERRORS with typed ArgsShould:
+//
+// func TestWrongArity(t *testing.T) {
+//     mock, imp := MockOps(t)
+//     go func() { _, _ = mock.Store("key", "value") }()
+//
+//     // COMPILE ERROR: not enough arguments (have (Matcher), want (any, any))
+//     imp.Store.ArgsShould(match.BeAny)
+//
+//     // COMPILE ERROR: too many arguments (have (Matcher, Matcher, Matcher), want (any, any))
+//     imp.Store.ArgsShould(match.BeAny, match.BeAny, match.BeAny)
+// }
@@ -5,6 +5,8 @@ package mockmethod_test
 
 import (
 	_imptest "github.com/toejough/imptest"
+	_reflect "reflect"
+	_time "time"
 )
 
 type CounterAddMockArgs struct {
@@ -28,6 +30,11 @@ func (c *CounterAddMockCall) Return(result0 int) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *CounterAddMockCall) ReturnAfter(d _time.Duration, result0 int) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type CounterAddMockMethod struct {
 	*_imptest.DependencyMethod
 	// Eventually provides async version of this function for concurrent code.
@@ -40,16 +47,28 @@ func (m *CounterAddMockMethod) ArgsEqual(n int) *CounterAddMockCall {
 	return &CounterAddMockCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *CounterAddMockMethod) ArgsShould(matchers ...any) *CounterAddMockCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *CounterAddMockMethod) ArgsShould(n any) *CounterAddMockCall {
+	_call := m.DependencyMethod.ArgsShould(n)
+	return &CounterAddMockCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *CounterAddMockMethod) ArgsWhere(predicate func(CounterAddMockArgs) error) *CounterAddMockCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args CounterAddMockArgs
+		args.N, _ = raw[0].(int)
+		return predicate(args)
+	})
 	return &CounterAddMockCall{DependencyCall: call}
 }
 
 // MockCounterAdd creates a mock Counter.Add function and returns (mock, expectation handle).
 func MockCounterAdd(t _imptest.TestReporter) (func(n int) int, *CounterAddMockMethod) {
 	ctrl := _imptest.GetOrCreateImp(t)
-	imp := newCounterAddMockMethod(_imptest.NewDependencyMethod(ctrl, "Counter.Add"))
+	imp := newCounterAddMockMethod(_imptest.NewDependencyMethod(ctrl, "Counter.Add").Results(_reflect.TypeFor[int]()))
 	mock := func(n int) int {
 		call := &_imptest.GenericCall{
 			MethodName:   "Counter.Add",
@@ -58,9 +77,7 @@ func MockCounterAdd(t _imptest.TestReporter) (func(n int) int, *CounterAddMockMe
 		}
 		ctrl.CallChan <- call
 		resp := <-call.ResponseChan
-		if resp.Type == "panic" {
-			panic(resp.PanicValue)
-		}
+		resp.Resolve()
 
 		var result1 int
 		if len(resp.ReturnValues) > 0 {
@@ -5,6 +5,8 @@ package mockmethod_test
 
 import (
 	_imptest "github.com/toejough/imptest"
+	_reflect "reflect"
+	_time "time"
 )
 
 type CounterIncMockCall struct {
@@ -16,10 +18,15 @@ func (c *CounterIncMockCall) Return(result0 int) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *CounterIncMockCall) ReturnAfter(d _time.Duration, result0 int) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 // MockCounterInc creates a mock Counter.Inc function and returns (mock, expectation handle).
 func MockCounterInc(t _imptest.TestReporter) (func() int, *_imptest.DependencyMethod) {
 	ctrl := _imptest.GetOrCreateImp(t)
-	imp := _imptest.NewDependencyMethod(ctrl, "Counter.Inc")
+	imp := _imptest.NewDependencyMethod(ctrl, "Counter.Inc").Results(_reflect.TypeFor[int]())
 	mock := func() int {
 		call := &_imptest.GenericCall{
 			MethodName:   "Counter.Inc",
@@ -28,9 +35,7 @@ func MockCounterInc(t _imptest.TestReporter) (func() int, *_imptest.DependencyMe
 		}
 		ctrl.CallChan <- call
 		resp := <-call.ResponseChan
-		if resp.Type == "panic" {
-			panic(resp.PanicValue)
-		}
+		resp.Resolve()
 
 		var result1 int
 		if len(resp.ReturnValues) > 0 {
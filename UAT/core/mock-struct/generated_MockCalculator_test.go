@@ -5,6 +5,8 @@ package mockstruct_test
 
 import (
 	_imptest "github.com/toejough/imptest"
+	_reflect "reflect"
+	_time "time"
 )
 
 type CalculatorImp struct {
@@ -46,6 +48,11 @@ func (c *CalculatorMockAddCall) Return(result0 int) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *CalculatorMockAddCall) ReturnAfter(d _time.Duration, result0 int) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type CalculatorMockAddMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -56,9 +63,22 @@ func (m *CalculatorMockAddMethod) ArgsEqual(a int, b int) *CalculatorMockAddCall
 	return &CalculatorMockAddCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *CalculatorMockAddMethod) ArgsShould(matchers ...any) *CalculatorMockAddCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *CalculatorMockAddMethod) ArgsShould(a any, b any) *CalculatorMockAddCall {
+	_call := m.DependencyMethod.ArgsShould(a, b)
+	return &CalculatorMockAddCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *CalculatorMockAddMethod) ArgsWhere(predicate func(CalculatorMockAddArgs) error) *CalculatorMockAddCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args CalculatorMockAddArgs
+		args.A, _ = raw[0].(int)
+		args.B, _ = raw[1].(int)
+		return predicate(args)
+	})
 	return &CalculatorMockAddCall{DependencyCall: call}
 }
 
@@ -71,6 +91,11 @@ func (c *CalculatorMockGetCall) Return(result0 int, result1 error) {
 	c.DependencyCall.Return(result0, result1)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *CalculatorMockGetCall) ReturnAfter(d _time.Duration, result0 int, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
 type CalculatorMockInterface interface {
 	Add(a int, b int) int
 	Get() (int, error)
@@ -99,6 +124,11 @@ func (c *CalculatorMockStoreCall) Return(result0 int) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *CalculatorMockStoreCall) ReturnAfter(d _time.Duration, result0 int) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type CalculatorMockStoreMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -109,9 +139,21 @@ func (m *CalculatorMockStoreMethod) ArgsEqual(value int) *CalculatorMockStoreCal
 	return &CalculatorMockStoreCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *CalculatorMockStoreMethod) ArgsShould(matchers ...any) *CalculatorMockStoreCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *CalculatorMockStoreMethod) ArgsShould(value any) *CalculatorMockStoreCall {
+	_call := m.DependencyMethod.ArgsShould(value)
+	return &CalculatorMockStoreCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *CalculatorMockStoreMethod) ArgsWhere(predicate func(CalculatorMockStoreArgs) error) *CalculatorMockStoreCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args CalculatorMockStoreArgs
+		args.Value, _ = raw[0].(int)
+		return predicate(args)
+	})
 	return &CalculatorMockStoreCall{DependencyCall: call}
 }
 
@@ -119,16 +161,16 @@ func (m *CalculatorMockStoreMethod) ArgsShould(matchers ...any) *CalculatorMockS
 func MockCalculator(t _imptest.TestReporter) (CalculatorMockInterface, *CalculatorImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &CalculatorImp{
-		Add:   newCalculatorMockAddMethod(_imptest.NewDependencyMethod(ctrl, "Add")),
-		Get:   _imptest.NewDependencyMethod(ctrl, "Get"),
-		Reset: _imptest.NewDependencyMethod(ctrl, "Reset"),
-		Store: newCalculatorMockStoreMethod(_imptest.NewDependencyMethod(ctrl, "Store")),
+		Add:   newCalculatorMockAddMethod(_imptest.NewDependencyMethod(ctrl, "Add").Results(_reflect.TypeFor[int]())),
+		Get:   _imptest.NewDependencyMethod(ctrl, "Get").Results(_reflect.TypeFor[int](), _reflect.TypeFor[error]()),
+		Reset: _imptest.NewDependencyMethod(ctrl, "Reset").Results(),
+		Store: newCalculatorMockStoreMethod(_imptest.NewDependencyMethod(ctrl, "Store").Results(_reflect.TypeFor[int]())),
 	}
 	imp.Eventually = &CalculatorImpEventually{
-		Add:   newCalculatorMockAddMethod(_imptest.NewDependencyMethod(ctrl, "Add").AsEventually()),
-		Get:   _imptest.NewDependencyMethod(ctrl, "Get").AsEventually(),
-		Reset: _imptest.NewDependencyMethod(ctrl, "Reset").AsEventually(),
-		Store: newCalculatorMockStoreMethod(_imptest.NewDependencyMethod(ctrl, "Store").AsEventually()),
+		Add:   newCalculatorMockAddMethod(_imptest.NewDependencyMethod(ctrl, "Add").Results(_reflect.TypeFor[int]()).AsEventually()),
+		Get:   _imptest.NewDependencyMethod(ctrl, "Get").Results(_reflect.TypeFor[int](), _reflect.TypeFor[error]()).AsEventually(),
+		Reset: _imptest.NewDependencyMethod(ctrl, "Reset").Results().AsEventually(),
+		Store: newCalculatorMockStoreMethod(_imptest.NewDependencyMethod(ctrl, "Store").Results(_reflect.TypeFor[int]()).AsEventually()),
 	}
 	mock := &mockCalculatorImpl{ctrl: ctrl}
 	return mock, imp
@@ -147,9 +189,7 @@ func (impl *mockCalculatorImpl) Add(a int, b int) int {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 int
 	if len(resp.ReturnValues) > 0 {
@@ -170,9 +210,7 @@ func (impl *mockCalculatorImpl) Get() (int, error) {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 int
 	if len(resp.ReturnValues) > 0 {
@@ -200,9 +238,7 @@ func (impl *mockCalculatorImpl) Reset() {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 }
 
@@ -215,9 +251,7 @@ func (impl *mockCalculatorImpl) Store(value int) int {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 int
 	if len(resp.ReturnValues) > 0 {
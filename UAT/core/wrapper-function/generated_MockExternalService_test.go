@@ -6,6 +6,8 @@ package callable_test
 import (
 	_imptest "github.com/toejough/imptest"
 	callable "github.com/toejough/imptest/UAT/core/wrapper-function"
+	_reflect "reflect"
+	_time "time"
 )
 
 type ExternalServiceImp struct {
@@ -41,6 +43,11 @@ func (c *ExternalServiceMockFetchDataCall) Return(result0 string, result1 error)
 	c.DependencyCall.Return(result0, result1)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *ExternalServiceMockFetchDataCall) ReturnAfter(d _time.Duration, result0 string, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
 type ExternalServiceMockFetchDataMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -51,9 +58,21 @@ func (m *ExternalServiceMockFetchDataMethod) ArgsEqual(id int) *ExternalServiceM
 	return &ExternalServiceMockFetchDataCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *ExternalServiceMockFetchDataMethod) ArgsShould(matchers ...any) *ExternalServiceMockFetchDataCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *ExternalServiceMockFetchDataMethod) ArgsShould(id any) *ExternalServiceMockFetchDataCall {
+	_call := m.DependencyMethod.ArgsShould(id)
+	return &ExternalServiceMockFetchDataCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *ExternalServiceMockFetchDataMethod) ArgsWhere(predicate func(ExternalServiceMockFetchDataArgs) error) *ExternalServiceMockFetchDataCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args ExternalServiceMockFetchDataArgs
+		args.Id, _ = raw[0].(int)
+		return predicate(args)
+	})
 	return &ExternalServiceMockFetchDataCall{DependencyCall: call}
 }
 
@@ -78,6 +97,11 @@ func (c *ExternalServiceMockProcessCall) Return(result0 string) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *ExternalServiceMockProcessCall) ReturnAfter(d _time.Duration, result0 string) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type ExternalServiceMockProcessMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -88,9 +112,21 @@ func (m *ExternalServiceMockProcessMethod) ArgsEqual(data string) *ExternalServi
 	return &ExternalServiceMockProcessCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *ExternalServiceMockProcessMethod) ArgsShould(matchers ...any) *ExternalServiceMockProcessCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *ExternalServiceMockProcessMethod) ArgsShould(data any) *ExternalServiceMockProcessCall {
+	_call := m.DependencyMethod.ArgsShould(data)
+	return &ExternalServiceMockProcessCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *ExternalServiceMockProcessMethod) ArgsWhere(predicate func(ExternalServiceMockProcessArgs) error) *ExternalServiceMockProcessCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args ExternalServiceMockProcessArgs
+		args.Data, _ = raw[0].(string)
+		return predicate(args)
+	})
 	return &ExternalServiceMockProcessCall{DependencyCall: call}
 }
 
@@ -98,12 +134,12 @@ func (m *ExternalServiceMockProcessMethod) ArgsShould(matchers ...any) *External
 func MockExternalService(t _imptest.TestReporter) (callable.ExternalService, *ExternalServiceImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &ExternalServiceImp{
-		FetchData: newExternalServiceMockFetchDataMethod(_imptest.NewDependencyMethod(ctrl, "FetchData")),
-		Process:   newExternalServiceMockProcessMethod(_imptest.NewDependencyMethod(ctrl, "Process")),
+		FetchData: newExternalServiceMockFetchDataMethod(_imptest.NewDependencyMethod(ctrl, "FetchData").Results(_reflect.TypeFor[string](), _reflect.TypeFor[error]())),
+		Process:   newExternalServiceMockProcessMethod(_imptest.NewDependencyMethod(ctrl, "Process").Results(_reflect.TypeFor[string]())),
 	}
 	imp.Eventually = &ExternalServiceImpEventually{
-		FetchData: newExternalServiceMockFetchDataMethod(_imptest.NewDependencyMethod(ctrl, "FetchData").AsEventually()),
-		Process:   newExternalServiceMockProcessMethod(_imptest.NewDependencyMethod(ctrl, "Process").AsEventually()),
+		FetchData: newExternalServiceMockFetchDataMethod(_imptest.NewDependencyMethod(ctrl, "FetchData").Results(_reflect.TypeFor[string](), _reflect.TypeFor[error]()).AsEventually()),
+		Process:   newExternalServiceMockProcessMethod(_imptest.NewDependencyMethod(ctrl, "Process").Results(_reflect.TypeFor[string]()).AsEventually()),
 	}
 	mock := &mockExternalServiceImpl{ctrl: ctrl}
 	return mock, imp
@@ -122,9 +158,7 @@ func (impl *mockExternalServiceImpl) FetchData(id int) (string, error) {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 string
 	if len(resp.ReturnValues) > 0 {
@@ -152,9 +186,7 @@ func (impl *mockExternalServiceImpl) Process(data string) string {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 string
 	if len(resp.ReturnValues) > 0 {
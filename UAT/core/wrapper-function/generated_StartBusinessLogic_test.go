@@ -6,7 +6,6 @@ package callable_test
 import (
 	_imptest "github.com/toejough/imptest"
 	callable "github.com/toejough/imptest/UAT/core/wrapper-function"
-	_reflect "reflect"
 )
 
 type StartBusinessLogicCallHandle struct {
@@ -17,6 +16,23 @@ type StartBusinessLogicCallHandle struct {
 	Eventually *StartBusinessLogicCallHandleEventually
 }
 
+// GoexitedShould verifies the function exited via runtime.Goexit (e.g. t.FailNow) without
+// returning or panicking. The matcher is applied to the goroutine's stack trace as a string.
+func (h *StartBusinessLogicCallHandle) GoexitedShould(matcher any) {
+	h.T.Helper()
+	h.WaitForResponse()
+
+	if h.Goexited {
+		ok, msg := _imptest.MatchValue(string(h.GoexitStack), matcher)
+		if !ok {
+			h.T.Fatalf("goexit stack: %s", msg)
+		}
+		return
+	}
+
+	h.T.Fatalf("expected function to exit via runtime.Goexit, but %s", h.Outcome())
+}
+
 // PanicEquals verifies the function panics with the expected value.
 func (h *StartBusinessLogicCallHandle) PanicEquals(expected any) {
 	h.T.Helper()
@@ -30,7 +46,7 @@ func (h *StartBusinessLogicCallHandle) PanicEquals(expected any) {
 		return
 	}
 
-	h.T.Fatalf("expected function to panic, but it returned")
+	h.T.Fatalf("expected function to panic, but %s", h.Outcome())
 }
 
 // PanicShould verifies the function panics with a value matching the given matcher.
@@ -39,14 +55,14 @@ func (h *StartBusinessLogicCallHandle) PanicShould(matcher any) {
 	h.WaitForResponse()
 
 	if h.Panicked != nil {
-		ok, msg := _imptest.MatchValue(h.Panicked, matcher)
+		ok, msg := _imptest.MatchPanic(h.Panicked, h.PanicStack, matcher)
 		if !ok {
 			h.T.Fatalf("panic value: %s", msg)
 		}
 		return
 	}
 
-	h.T.Fatalf("expected function to panic, but it returned")
+	h.T.Fatalf("expected function to panic, but %s", h.Outcome())
 }
 
 // ReturnsEqual verifies the function returned the expected values.
@@ -55,16 +71,16 @@ func (h *StartBusinessLogicCallHandle) ReturnsEqual(v0 string, v1 error) {
 	h.WaitForResponse()
 
 	if h.Returned != nil {
-		if !_reflect.DeepEqual(h.Returned.Result0, v0) {
-			h.T.Fatalf("expected return value 0 to be %v, got %v", v0, h.Returned.Result0)
+		if ok, msg := _imptest.EqualValue(h.T, h.Returned.Result0, v0); !ok {
+			h.T.Fatalf("return value 0: %s", msg)
 		}
-		if !_reflect.DeepEqual(h.Returned.Result1, v1) {
-			h.T.Fatalf("expected return value 1 to be %v, got %v", v1, h.Returned.Result1)
+		if ok, msg := _imptest.EqualValue(h.T, h.Returned.Result1, v1); !ok {
+			h.T.Fatalf("return value 1: %s", msg)
 		}
 		return
 	}
 
-	h.T.Fatalf("expected function to return, but it panicked with: %v", h.Panicked)
+	h.T.Fatalf("expected function to return, but %s", h.Outcome())
 }
 
 // ReturnsShould verifies the return values match the given matchers.
@@ -86,7 +102,7 @@ func (h *StartBusinessLogicCallHandle) ReturnsShould(v0 any, v1 any) {
 		return
 	}
 
-	h.T.Fatalf("expected function to return, but it panicked with: %v", h.Panicked)
+	h.T.Fatalf("expected function to return, but %s", h.Outcome())
 }
 
 type StartBusinessLogicCallHandleEventually struct {
@@ -108,7 +124,7 @@ func (e *StartBusinessLogicCallHandleEventually) ensureStarted() *_imptest.Pendi
 		e.h.pendingCompletion = e.h.controller.RegisterPendingCompletion()
 		go func() {
 			e.h.WaitForResponse()
-			e.h.pendingCompletion.SetCompleted(e.h.Returned, e.h.Panicked)
+			e.h.pendingCompletion.SetCompleted(e.h.Completion())
 		}()
 	}
 	return e.h.pendingCompletion
@@ -127,12 +143,16 @@ func StartBusinessLogic(t _imptest.TestReporter, fn func(callable.ExternalServic
 	}
 	handle.Eventually = &StartBusinessLogicCallHandleEventually{h: handle}
 	go func() {
+		_completed := false
 		defer func() {
 			if r := recover(); r != nil {
-				handle.PanicChan <- r
+				handle.RecordPanic(r)
+			} else if !_completed {
+				handle.RecordGoexit()
 			}
 		}()
 		ret0, ret1 := fn(svc, id)
+		_completed = true
 		handle.ReturnChan <- StartBusinessLogicReturnsReturn{Result0: ret0, Result1: ret1}
 	}()
 	return handle
@@ -5,7 +5,6 @@ package callable_test
 
 import (
 	_imptest "github.com/toejough/imptest"
-	_reflect "reflect"
 )
 
 type StartCalculatorDivideCallHandle struct {
@@ -16,6 +15,23 @@ type StartCalculatorDivideCallHandle struct {
 	Eventually *StartCalculatorDivideCallHandleEventually
 }
 
+// GoexitedShould verifies the function exited via runtime.Goexit (e.g. t.FailNow) without
+// returning or panicking. The matcher is applied to the goroutine's stack trace as a string.
+func (h *StartCalculatorDivideCallHandle) GoexitedShould(matcher any) {
+	h.T.Helper()
+	h.WaitForResponse()
+
+	if h.Goexited {
+		ok, msg := _imptest.MatchValue(string(h.GoexitStack), matcher)
+		if !ok {
+			h.T.Fatalf("goexit stack: %s", msg)
+		}
+		return
+	}
+
+	h.T.Fatalf("expected function to exit via runtime.Goexit, but %s", h.Outcome())
+}
+
 // PanicEquals verifies the function panics with the expected value.
 func (h *StartCalculatorDivideCallHandle) PanicEquals(expected any) {
 	h.T.Helper()
@@ -29,7 +45,7 @@ func (h *StartCalculatorDivideCallHandle) PanicEquals(expected any) {
 		return
 	}
 
-	h.T.Fatalf("expected function to panic, but it returned")
+	h.T.Fatalf("expected function to panic, but %s", h.Outcome())
 }
 
 // PanicShould verifies the function panics with a value matching the given matcher.
@@ -38,14 +54,14 @@ func (h *StartCalculatorDivideCallHandle) PanicShould(matcher any) {
 	h.WaitForResponse()
 
 	if h.Panicked != nil {
-		ok, msg := _imptest.MatchValue(h.Panicked, matcher)
+		ok, msg := _imptest.MatchPanic(h.Panicked, h.PanicStack, matcher)
 		if !ok {
 			h.T.Fatalf("panic value: %s", msg)
 		}
 		return
 	}
 
-	h.T.Fatalf("expected function to panic, but it returned")
+	h.T.Fatalf("expected function to panic, but %s", h.Outcome())
 }
 
 // ReturnsEqual verifies the function returned the expected values.
@@ -54,16 +70,16 @@ func (h *StartCalculatorDivideCallHandle) ReturnsEqual(v0 int, v1 bool) {
 	h.WaitForResponse()
 
 	if h.Returned != nil {
-		if !_reflect.DeepEqual(h.Returned.Result0, v0) {
-			h.T.Fatalf("expected return value 0 to be %v, got %v", v0, h.Returned.Result0)
+		if ok, msg := _imptest.EqualValue(h.T, h.Returned.Result0, v0); !ok {
+			h.T.Fatalf("return value 0: %s", msg)
 		}
-		if !_reflect.DeepEqual(h.Returned.Result1, v1) {
-			h.T.Fatalf("expected return value 1 to be %v, got %v", v1, h.Returned.Result1)
+		if ok, msg := _imptest.EqualValue(h.T, h.Returned.Result1, v1); !ok {
+			h.T.Fatalf("return value 1: %s", msg)
 		}
 		return
 	}
 
-	h.T.Fatalf("expected function to return, but it panicked with: %v", h.Panicked)
+	h.T.Fatalf("expected function to return, but %s", h.Outcome())
 }
 
 // ReturnsShould verifies the return values match the given matchers.
@@ -85,7 +101,7 @@ func (h *StartCalculatorDivideCallHandle) ReturnsShould(v0 any, v1 any) {
 		return
 	}
 
-	h.T.Fatalf("expected function to return, but it panicked with: %v", h.Panicked)
+	h.T.Fatalf("expected function to return, but %s", h.Outcome())
 }
 
 type StartCalculatorDivideCallHandleEventually struct {
@@ -107,7 +123,7 @@ func (e *StartCalculatorDivideCallHandleEventually) ensureStarted() *_imptest.Pe
 		e.h.pendingCompletion = e.h.controller.RegisterPendingCompletion()
 		go func() {
 			e.h.WaitForResponse()
-			e.h.pendingCompletion.SetCompleted(e.h.Returned, e.h.Panicked)
+			e.h.pendingCompletion.SetCompleted(e.h.Completion())
 		}()
 	}
 	return e.h.pendingCompletion
@@ -126,12 +142,16 @@ func StartCalculatorDivide(t _imptest.TestReporter, fn func(int, int) (int, bool
 	}
 	handle.Eventually = &StartCalculatorDivideCallHandleEventually{h: handle}
 	go func() {
+		_completed := false
 		defer func() {
 			if r := recover(); r != nil {
-				handle.PanicChan <- r
+				handle.RecordPanic(r)
+			} else if !_completed {
+				handle.RecordGoexit()
 			}
 		}()
 		ret0, ret1 := fn(numerator, denominator)
+		_completed = true
 		handle.ReturnChan <- StartCalculatorDivideReturnsReturn{Result0: ret0, Result1: ret1}
 	}()
 	return handle
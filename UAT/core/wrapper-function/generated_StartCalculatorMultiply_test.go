@@ -5,7 +5,6 @@ package callable_test
 
 import (
 	_imptest "github.com/toejough/imptest"
-	_reflect "reflect"
 )
 
 type StartCalculatorMultiplyCallHandle struct {
@@ -16,6 +15,23 @@ type StartCalculatorMultiplyCallHandle struct {
 	Eventually *StartCalculatorMultiplyCallHandleEventually
 }
 
+// GoexitedShould verifies the function exited via runtime.Goexit (e.g. t.FailNow) without
+// returning or panicking. The matcher is applied to the goroutine's stack trace as a string.
+func (h *StartCalculatorMultiplyCallHandle) GoexitedShould(matcher any) {
+	h.T.Helper()
+	h.WaitForResponse()
+
+	if h.Goexited {
+		ok, msg := _imptest.MatchValue(string(h.GoexitStack), matcher)
+		if !ok {
+			h.T.Fatalf("goexit stack: %s", msg)
+		}
+		return
+	}
+
+	h.T.Fatalf("expected function to exit via runtime.Goexit, but %s", h.Outcome())
+}
+
 // PanicEquals verifies the function panics with the expected value.
 func (h *StartCalculatorMultiplyCallHandle) PanicEquals(expected any) {
 	h.T.Helper()
@@ -29,7 +45,7 @@ func (h *StartCalculatorMultiplyCallHandle) PanicEquals(expected any) {
 		return
 	}
 
-	h.T.Fatalf("expected function to panic, but it returned")
+	h.T.Fatalf("expected function to panic, but %s", h.Outcome())
 }
 
 // PanicShould verifies the function panics with a value matching the given matcher.
@@ -38,14 +54,14 @@ func (h *StartCalculatorMultiplyCallHandle) PanicShould(matcher any) {
 	h.WaitForResponse()
 
 	if h.Panicked != nil {
-		ok, msg := _imptest.MatchValue(h.Panicked, matcher)
+		ok, msg := _imptest.MatchPanic(h.Panicked, h.PanicStack, matcher)
 		if !ok {
 			h.T.Fatalf("panic value: %s", msg)
 		}
 		return
 	}
 
-	h.T.Fatalf("expected function to panic, but it returned")
+	h.T.Fatalf("expected function to panic, but %s", h.Outcome())
 }
 
 // ReturnsEqual verifies the function returned the expected values.
@@ -54,13 +70,13 @@ func (h *StartCalculatorMultiplyCallHandle) ReturnsEqual(v0 int) {
 	h.WaitForResponse()
 
 	if h.Returned != nil {
-		if !_reflect.DeepEqual(h.Returned.Result0, v0) {
-			h.T.Fatalf("expected return value 0 to be %v, got %v", v0, h.Returned.Result0)
+		if ok, msg := _imptest.EqualValue(h.T, h.Returned.Result0, v0); !ok {
+			h.T.Fatalf("return value 0: %s", msg)
 		}
 		return
 	}
 
-	h.T.Fatalf("expected function to return, but it panicked with: %v", h.Panicked)
+	h.T.Fatalf("expected function to return, but %s", h.Outcome())
 }
 
 // ReturnsShould verifies the return values match the given matchers.
@@ -78,7 +94,7 @@ func (h *StartCalculatorMultiplyCallHandle) ReturnsShould(v0 any) {
 		return
 	}
 
-	h.T.Fatalf("expected function to return, but it panicked with: %v", h.Panicked)
+	h.T.Fatalf("expected function to return, but %s", h.Outcome())
 }
 
 type StartCalculatorMultiplyCallHandleEventually struct {
@@ -100,7 +116,7 @@ func (e *StartCalculatorMultiplyCallHandleEventually) ensureStarted() *_imptest.
 		e.h.pendingCompletion = e.h.controller.RegisterPendingCompletion()
 		go func() {
 			e.h.WaitForResponse()
-			e.h.pendingCompletion.SetCompleted(e.h.Returned, e.h.Panicked)
+			e.h.pendingCompletion.SetCompleted(e.h.Completion())
 		}()
 	}
 	return e.h.pendingCompletion
@@ -118,12 +134,16 @@ func StartCalculatorMultiply(t _imptest.TestReporter, fn func(int) int, value in
 	}
 	handle.Eventually = &StartCalculatorMultiplyCallHandleEventually{h: handle}
 	go func() {
+		_completed := false
 		defer func() {
 			if r := recover(); r != nil {
-				handle.PanicChan <- r
+				handle.RecordPanic(r)
+			} else if !_completed {
+				handle.RecordGoexit()
 			}
 		}()
 		ret0 := fn(value)
+		_completed = true
 		handle.ReturnChan <- StartCalculatorMultiplyReturnsReturn{Result0: ret0}
 	}()
 	return handle
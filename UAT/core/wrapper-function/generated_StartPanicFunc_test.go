@@ -20,11 +20,28 @@ func (h *StartPanicFuncCallHandle) Completes() {
 	h.T.Helper()
 	h.WaitForResponse()
 
-	if h.Panicked != nil {
-		h.T.Fatalf("expected function to complete, but it panicked with: %v", h.Panicked)
+	if h.Panicked != nil || h.Goexited {
+		h.T.Fatalf("expected function to complete, but %s", h.Outcome())
 	}
 }
 
+// GoexitedShould verifies the function exited via runtime.Goexit (e.g. t.FailNow) without
+// returning or panicking. The matcher is applied to the goroutine's stack trace as a string.
+func (h *StartPanicFuncCallHandle) GoexitedShould(matcher any) {
+	h.T.Helper()
+	h.WaitForResponse()
+
+	if h.Goexited {
+		ok, msg := _imptest.MatchValue(string(h.GoexitStack), matcher)
+		if !ok {
+			h.T.Fatalf("goexit stack: %s", msg)
+		}
+		return
+	}
+
+	h.T.Fatalf("expected function to exit via runtime.Goexit, but %s", h.Outcome())
+}
+
 // PanicEquals verifies the function panics with the expected value.
 func (h *StartPanicFuncCallHandle) PanicEquals(expected any) {
 	h.T.Helper()
@@ -38,7 +55,7 @@ func (h *StartPanicFuncCallHandle) PanicEquals(expected any) {
 		return
 	}
 
-	h.T.Fatalf("expected function to panic, but it returned")
+	h.T.Fatalf("expected function to panic, but %s", h.Outcome())
 }
 
 // PanicShould verifies the function panics with a value matching the given matcher.
@@ -47,14 +64,14 @@ func (h *StartPanicFuncCallHandle) PanicShould(matcher any) {
 	h.WaitForResponse()
 
 	if h.Panicked != nil {
-		ok, msg := _imptest.MatchValue(h.Panicked, matcher)
+		ok, msg := _imptest.MatchPanic(h.Panicked, h.PanicStack, matcher)
 		if !ok {
 			h.T.Fatalf("panic value: %s", msg)
 		}
 		return
 	}
 
-	h.T.Fatalf("expected function to panic, but it returned")
+	h.T.Fatalf("expected function to panic, but %s", h.Outcome())
 }
 
 type StartPanicFuncCallHandleEventually struct {
@@ -76,7 +93,7 @@ func (e *StartPanicFuncCallHandleEventually) ensureStarted() *_imptest.PendingCo
 		e.h.pendingCompletion = e.h.controller.RegisterPendingCompletion()
 		go func() {
 			e.h.WaitForResponse()
-			e.h.pendingCompletion.SetCompleted(e.h.Returned, e.h.Panicked)
+			e.h.pendingCompletion.SetCompleted(e.h.Completion())
 		}()
 	}
 	return e.h.pendingCompletion
@@ -93,12 +110,16 @@ func StartPanicFunc(t _imptest.TestReporter, fn func()) *StartPanicFuncCallHandl
 	}
 	handle.Eventually = &StartPanicFuncCallHandleEventually{h: handle}
 	go func() {
+		_completed := false
 		defer func() {
 			if r := recover(); r != nil {
-				handle.PanicChan <- r
+				handle.RecordPanic(r)
+			} else if !_completed {
+				handle.RecordGoexit()
 			}
 		}()
 		fn()
+		_completed = true
 		handle.ReturnChan <- StartPanicFuncReturnsReturn{}
 	}()
 	return handle
@@ -1,5 +1,5 @@
 // Code generated by impgen. DO NOT EDIT.
-// impgen:hash:919a3b79555940ac
+// impgen:hash:1720cfac692edfa8
 
 package handlers_test
 
@@ -7,7 +7,6 @@ import (
 	context "context"
 	_imptest "github.com/toejough/imptest"
 	handlers "github.com/toejough/imptest/UAT/core/wrapper-interface"
-	_reflect "reflect"
 	"testing"
 )
 
@@ -26,9 +25,26 @@ func (h *StartLoggerWrapperLogCallHandle) Completes() {
 	h.T.Helper()
 	h.WaitForResponse()
 
-	if h.Panicked != nil {
-		h.T.Fatalf("expected method to complete, but it panicked with: %v", h.Panicked)
+	if h.Panicked != nil || h.Goexited {
+		h.T.Fatalf("expected method to complete, but %s", h.Outcome())
+	}
+}
+
+// GoexitedShould verifies the method exited via runtime.Goexit (e.g. t.FailNow) without
+// returning or panicking. The matcher is applied to the goroutine's stack trace as a string.
+func (h *StartLoggerWrapperLogCallHandle) GoexitedShould(matcher any) {
+	h.T.Helper()
+	h.WaitForResponse()
+
+	if h.Goexited {
+		ok, msg := _imptest.MatchValue(string(h.GoexitStack), matcher)
+		if !ok {
+			h.T.Fatalf("goexit stack: %s", msg)
+		}
+		return
 	}
+
+	h.T.Fatalf("expected method to exit via runtime.Goexit, but %s", h.Outcome())
 }
 
 // PanicEquals verifies the method panics with the expected value.
@@ -44,7 +60,7 @@ func (h *StartLoggerWrapperLogCallHandle) PanicEquals(expected any) {
 		return
 	}
 
-	h.T.Fatalf("expected method to panic, but it returned")
+	h.T.Fatalf("expected method to panic, but %s", h.Outcome())
 }
 
 // PanicShould verifies the method panics with a value matching the given matcher.
@@ -53,14 +69,14 @@ func (h *StartLoggerWrapperLogCallHandle) PanicShould(matcher any) {
 	h.WaitForResponse()
 
 	if h.Panicked != nil {
-		ok, msg := _imptest.MatchValue(h.Panicked, matcher)
+		ok, msg := _imptest.MatchPanic(h.Panicked, h.PanicStack, matcher)
 		if !ok {
 			h.T.Fatalf("panic value: %s", msg)
 		}
 		return
 	}
 
-	h.T.Fatalf("expected method to panic, but it returned")
+	h.T.Fatalf("expected method to panic, but %s", h.Outcome())
 }
 
 // ReturnsEqual verifies the method returned the expected values.
@@ -69,13 +85,13 @@ func (h *StartLoggerWrapperLogCallHandle) ReturnsEqual(v0 error) {
 	h.WaitForResponse()
 
 	if h.Returned != nil {
-		if !_reflect.DeepEqual(h.Returned.Result0, v0) {
-			h.T.Fatalf("expected return value 0 to be %v, got %v", v0, h.Returned.Result0)
+		if ok, msg := _imptest.EqualValue(h.T, h.Returned.Result0, v0); !ok {
+			h.T.Fatalf("return value 0: %s", msg)
 		}
 		return
 	}
 
-	h.T.Fatalf("expected method to return, but it panicked with: %v", h.Panicked)
+	h.T.Fatalf("expected method to return, but %s", h.Outcome())
 }
 
 // ReturnsShould verifies the return values match the given matchers.
@@ -93,7 +109,7 @@ func (h *StartLoggerWrapperLogCallHandle) ReturnsShould(v0 any) {
 		return
 	}
 
-	h.T.Fatalf("expected method to return, but it panicked with: %v", h.Panicked)
+	h.T.Fatalf("expected method to return, but %s", h.Outcome())
 }
 
 type StartLoggerWrapperLogReturns struct {
@@ -109,9 +125,26 @@ func (h *StartLoggerWrapperLogWithContextCallHandle) Completes() {
 	h.T.Helper()
 	h.WaitForResponse()
 
-	if h.Panicked != nil {
-		h.T.Fatalf("expected method to complete, but it panicked with: %v", h.Panicked)
+	if h.Panicked != nil || h.Goexited {
+		h.T.Fatalf("expected method to complete, but %s", h.Outcome())
+	}
+}
+
+// GoexitedShould verifies the method exited via runtime.Goexit (e.g. t.FailNow) without
+// returning or panicking. The matcher is applied to the goroutine's stack trace as a string.
+func (h *StartLoggerWrapperLogWithContextCallHandle) GoexitedShould(matcher any) {
+	h.T.Helper()
+	h.WaitForResponse()
+
+	if h.Goexited {
+		ok, msg := _imptest.MatchValue(string(h.GoexitStack), matcher)
+		if !ok {
+			h.T.Fatalf("goexit stack: %s", msg)
+		}
+		return
 	}
+
+	h.T.Fatalf("expected method to exit via runtime.Goexit, but %s", h.Outcome())
 }
 
 // PanicEquals verifies the method panics with the expected value.
@@ -127,7 +160,7 @@ func (h *StartLoggerWrapperLogWithContextCallHandle) PanicEquals(expected any) {
 		return
 	}
 
-	h.T.Fatalf("expected method to panic, but it returned")
+	h.T.Fatalf("expected method to panic, but %s", h.Outcome())
 }
 
 // PanicShould verifies the method panics with a value matching the given matcher.
@@ -136,14 +169,14 @@ func (h *StartLoggerWrapperLogWithContextCallHandle) PanicShould(matcher any) {
 	h.WaitForResponse()
 
 	if h.Panicked != nil {
-		ok, msg := _imptest.MatchValue(h.Panicked, matcher)
+		ok, msg := _imptest.MatchPanic(h.Panicked, h.PanicStack, matcher)
 		if !ok {
 			h.T.Fatalf("panic value: %s", msg)
 		}
 		return
 	}
 
-	h.T.Fatalf("expected method to panic, but it returned")
+	h.T.Fatalf("expected method to panic, but %s", h.Outcome())
 }
 
 // ReturnsEqual verifies the method returned the expected values.
@@ -152,13 +185,13 @@ func (h *StartLoggerWrapperLogWithContextCallHandle) ReturnsEqual(v0 error) {
 	h.WaitForResponse()
 
 	if h.Returned != nil {
-		if !_reflect.DeepEqual(h.Returned.Result0, v0) {
-			h.T.Fatalf("expected return value 0 to be %v, got %v", v0, h.Returned.Result0)
+		if ok, msg := _imptest.EqualValue(h.T, h.Returned.Result0, v0); !ok {
+			h.T.Fatalf("return value 0: %s", msg)
 		}
 		return
 	}
 
-	h.T.Fatalf("expected method to return, but it panicked with: %v", h.Panicked)
+	h.T.Fatalf("expected method to return, but %s", h.Outcome())
 }
 
 // ReturnsShould verifies the return values match the given matchers.
@@ -176,7 +209,7 @@ func (h *StartLoggerWrapperLogWithContextCallHandle) ReturnsShould(v0 any) {
 		return
 	}
 
-	h.T.Fatalf("expected method to return, but it panicked with: %v", h.Panicked)
+	h.T.Fatalf("expected method to return, but %s", h.Outcome())
 }
 
 type StartLoggerWrapperLogWithContextReturns struct {
@@ -194,12 +227,16 @@ func (w *StartLoggerWrapperLogWithContextWrapper) Start(ctx context.Context, msg
 		CallableController: _imptest.NewCallableController[StartLoggerWrapperLogWithContextReturns](w.t),
 	}
 	go func() {
+		_completed := false
 		defer func() {
 			if r := recover(); r != nil {
-				handle.PanicChan <- r
+				handle.RecordPanic(r)
+			} else if !_completed {
+				handle.RecordGoexit()
 			}
 		}()
 		returns := w.fn(ctx, msg)
+		_completed = true
 		handle.ReturnChan <- returns
 	}()
 	return handle
@@ -216,12 +253,16 @@ func (w *StartLoggerWrapperLogWrapper) Start(msg string) *StartLoggerWrapperLogC
 		CallableController: _imptest.NewCallableController[StartLoggerWrapperLogReturns](w.t),
 	}
 	go func() {
+		_completed := false
 		defer func() {
 			if r := recover(); r != nil {
-				handle.PanicChan <- r
+				handle.RecordPanic(r)
+			} else if !_completed {
+				handle.RecordGoexit()
 			}
 		}()
 		returns := w.fn(msg)
+		_completed = true
 		handle.ReturnChan <- returns
 	}()
 	return handle
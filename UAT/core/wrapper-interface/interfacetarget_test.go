@@ -14,6 +14,8 @@ import (
 	"testing"
 )
 
+//go:generate impgen Logger --target
+
 type ConsoleLogger struct{}
 
 func (c *ConsoleLogger) Log(msg string) error {
@@ -6,7 +6,6 @@ package calculator_test
 import (
 	_imptest "github.com/toejough/imptest"
 	calculator "github.com/toejough/imptest/UAT/core/wrapper-struct"
-	_reflect "reflect"
 	"testing"
 )
 
@@ -19,11 +18,28 @@ func (h *StartCalculatorWrapperAddCallHandle) Completes() {
 	h.T.Helper()
 	h.WaitForResponse()
 
-	if h.Panicked != nil {
-		h.T.Fatalf("expected method to complete, but it panicked with: %v", h.Panicked)
+	if h.Panicked != nil || h.Goexited {
+		h.T.Fatalf("expected method to complete, but %s", h.Outcome())
 	}
 }
 
+// GoexitedShould verifies the method exited via runtime.Goexit (e.g. t.FailNow) without
+// returning or panicking. The matcher is applied to the goroutine's stack trace as a string.
+func (h *StartCalculatorWrapperAddCallHandle) GoexitedShould(matcher any) {
+	h.T.Helper()
+	h.WaitForResponse()
+
+	if h.Goexited {
+		ok, msg := _imptest.MatchValue(string(h.GoexitStack), matcher)
+		if !ok {
+			h.T.Fatalf("goexit stack: %s", msg)
+		}
+		return
+	}
+
+	h.T.Fatalf("expected method to exit via runtime.Goexit, but %s", h.Outcome())
+}
+
 // PanicEquals verifies the method panics with the expected value.
 func (h *StartCalculatorWrapperAddCallHandle) PanicEquals(expected any) {
 	h.T.Helper()
@@ -37,7 +53,7 @@ func (h *StartCalculatorWrapperAddCallHandle) PanicEquals(expected any) {
 		return
 	}
 
-	h.T.Fatalf("expected method to panic, but it returned")
+	h.T.Fatalf("expected method to panic, but %s", h.Outcome())
 }
 
 // PanicShould verifies the method panics with a value matching the given matcher.
@@ -46,14 +62,14 @@ func (h *StartCalculatorWrapperAddCallHandle) PanicShould(matcher any) {
 	h.WaitForResponse()
 
 	if h.Panicked != nil {
-		ok, msg := _imptest.MatchValue(h.Panicked, matcher)
+		ok, msg := _imptest.MatchPanic(h.Panicked, h.PanicStack, matcher)
 		if !ok {
 			h.T.Fatalf("panic value: %s", msg)
 		}
 		return
 	}
 
-	h.T.Fatalf("expected method to panic, but it returned")
+	h.T.Fatalf("expected method to panic, but %s", h.Outcome())
 }
 
 // ReturnsEqual verifies the method returned the expected values.
@@ -62,13 +78,13 @@ func (h *StartCalculatorWrapperAddCallHandle) ReturnsEqual(v0 int) {
 	h.WaitForResponse()
 
 	if h.Returned != nil {
-		if !_reflect.DeepEqual(h.Returned.Result0, v0) {
-			h.T.Fatalf("expected return value 0 to be %v, got %v", v0, h.Returned.Result0)
+		if ok, msg := _imptest.EqualValue(h.T, h.Returned.Result0, v0); !ok {
+			h.T.Fatalf("return value 0: %s", msg)
 		}
 		return
 	}
 
-	h.T.Fatalf("expected method to return, but it panicked with: %v", h.Panicked)
+	h.T.Fatalf("expected method to return, but %s", h.Outcome())
 }
 
 // ReturnsShould verifies the return values match the given matchers.
@@ -86,7 +102,7 @@ func (h *StartCalculatorWrapperAddCallHandle) ReturnsShould(v0 any) {
 		return
 	}
 
-	h.T.Fatalf("expected method to return, but it panicked with: %v", h.Panicked)
+	h.T.Fatalf("expected method to return, but %s", h.Outcome())
 }
 
 type StartCalculatorWrapperAddReturns struct {
@@ -104,12 +120,16 @@ func (w *StartCalculatorWrapperAddWrapper) Start(a int, b int) *StartCalculatorW
 		CallableController: _imptest.NewCallableController[StartCalculatorWrapperAddReturns](w.t),
 	}
 	go func() {
+		_completed := false
 		defer func() {
 			if r := recover(); r != nil {
-				handle.PanicChan <- r
+				handle.RecordPanic(r)
+			} else if !_completed {
+				handle.RecordGoexit()
 			}
 		}()
 		returns := w.fn(a, b)
+		_completed = true
 		handle.ReturnChan <- returns
 	}()
 	return handle
@@ -124,11 +144,28 @@ func (h *StartCalculatorWrapperDivideCallHandle) Completes() {
 	h.T.Helper()
 	h.WaitForResponse()
 
-	if h.Panicked != nil {
-		h.T.Fatalf("expected method to complete, but it panicked with: %v", h.Panicked)
+	if h.Panicked != nil || h.Goexited {
+		h.T.Fatalf("expected method to complete, but %s", h.Outcome())
 	}
 }
 
+// GoexitedShould verifies the method exited via runtime.Goexit (e.g. t.FailNow) without
+// returning or panicking. The matcher is applied to the goroutine's stack trace as a string.
+func (h *StartCalculatorWrapperDivideCallHandle) GoexitedShould(matcher any) {
+	h.T.Helper()
+	h.WaitForResponse()
+
+	if h.Goexited {
+		ok, msg := _imptest.MatchValue(string(h.GoexitStack), matcher)
+		if !ok {
+			h.T.Fatalf("goexit stack: %s", msg)
+		}
+		return
+	}
+
+	h.T.Fatalf("expected method to exit via runtime.Goexit, but %s", h.Outcome())
+}
+
 // PanicEquals verifies the method panics with the expected value.
 func (h *StartCalculatorWrapperDivideCallHandle) PanicEquals(expected any) {
 	h.T.Helper()
@@ -142,7 +179,7 @@ func (h *StartCalculatorWrapperDivideCallHandle) PanicEquals(expected any) {
 		return
 	}
 
-	h.T.Fatalf("expected method to panic, but it returned")
+	h.T.Fatalf("expected method to panic, but %s", h.Outcome())
 }
 
 // PanicShould verifies the method panics with a value matching the given matcher.
@@ -151,14 +188,14 @@ func (h *StartCalculatorWrapperDivideCallHandle) PanicShould(matcher any) {
 	h.WaitForResponse()
 
 	if h.Panicked != nil {
-		ok, msg := _imptest.MatchValue(h.Panicked, matcher)
+		ok, msg := _imptest.MatchPanic(h.Panicked, h.PanicStack, matcher)
 		if !ok {
 			h.T.Fatalf("panic value: %s", msg)
 		}
 		return
 	}
 
-	h.T.Fatalf("expected method to panic, but it returned")
+	h.T.Fatalf("expected method to panic, but %s", h.Outcome())
 }
 
 // ReturnsEqual verifies the method returned the expected values.
@@ -167,16 +204,16 @@ func (h *StartCalculatorWrapperDivideCallHandle) ReturnsEqual(v0 int, v1 bool) {
 	h.WaitForResponse()
 
 	if h.Returned != nil {
-		if !_reflect.DeepEqual(h.Returned.Result0, v0) {
-			h.T.Fatalf("expected return value 0 to be %v, got %v", v0, h.Returned.Result0)
+		if ok, msg := _imptest.EqualValue(h.T, h.Returned.Result0, v0); !ok {
+			h.T.Fatalf("return value 0: %s", msg)
 		}
-		if !_reflect.DeepEqual(h.Returned.Result1, v1) {
-			h.T.Fatalf("expected return value 1 to be %v, got %v", v1, h.Returned.Result1)
+		if ok, msg := _imptest.EqualValue(h.T, h.Returned.Result1, v1); !ok {
+			h.T.Fatalf("return value 1: %s", msg)
 		}
 		return
 	}
 
-	h.T.Fatalf("expected method to return, but it panicked with: %v", h.Panicked)
+	h.T.Fatalf("expected method to return, but %s", h.Outcome())
 }
 
 // ReturnsShould verifies the return values match the given matchers.
@@ -198,7 +235,7 @@ func (h *StartCalculatorWrapperDivideCallHandle) ReturnsShould(v0 any, v1 any) {
 		return
 	}
 
-	h.T.Fatalf("expected method to return, but it panicked with: %v", h.Panicked)
+	h.T.Fatalf("expected method to return, but %s", h.Outcome())
 }
 
 type StartCalculatorWrapperDivideReturns struct {
@@ -217,12 +254,16 @@ func (w *StartCalculatorWrapperDivideWrapper) Start(numerator int, denominator i
 		CallableController: _imptest.NewCallableController[StartCalculatorWrapperDivideReturns](w.t),
 	}
 	go func() {
+		_completed := false
 		defer func() {
 			if r := recover(); r != nil {
-				handle.PanicChan <- r
+				handle.RecordPanic(r)
+			} else if !_completed {
+				handle.RecordGoexit()
 			}
 		}()
 		returns := w.fn(numerator, denominator)
+		_completed = true
 		handle.ReturnChan <- returns
 	}()
 	return handle
@@ -245,11 +286,28 @@ func (h *StartCalculatorWrapperMultiplyCallHandle) Completes() {
 	h.T.Helper()
 	h.WaitForResponse()
 
-	if h.Panicked != nil {
-		h.T.Fatalf("expected method to complete, but it panicked with: %v", h.Panicked)
+	if h.Panicked != nil || h.Goexited {
+		h.T.Fatalf("expected method to complete, but %s", h.Outcome())
 	}
 }
 
+// GoexitedShould verifies the method exited via runtime.Goexit (e.g. t.FailNow) without
+// returning or panicking. The matcher is applied to the goroutine's stack trace as a string.
+func (h *StartCalculatorWrapperMultiplyCallHandle) GoexitedShould(matcher any) {
+	h.T.Helper()
+	h.WaitForResponse()
+
+	if h.Goexited {
+		ok, msg := _imptest.MatchValue(string(h.GoexitStack), matcher)
+		if !ok {
+			h.T.Fatalf("goexit stack: %s", msg)
+		}
+		return
+	}
+
+	h.T.Fatalf("expected method to exit via runtime.Goexit, but %s", h.Outcome())
+}
+
 // PanicEquals verifies the method panics with the expected value.
 func (h *StartCalculatorWrapperMultiplyCallHandle) PanicEquals(expected any) {
 	h.T.Helper()
@@ -263,7 +321,7 @@ func (h *StartCalculatorWrapperMultiplyCallHandle) PanicEquals(expected any) {
 		return
 	}
 
-	h.T.Fatalf("expected method to panic, but it returned")
+	h.T.Fatalf("expected method to panic, but %s", h.Outcome())
 }
 
 // PanicShould verifies the method panics with a value matching the given matcher.
@@ -272,14 +330,14 @@ func (h *StartCalculatorWrapperMultiplyCallHandle) PanicShould(matcher any) {
 	h.WaitForResponse()
 
 	if h.Panicked != nil {
-		ok, msg := _imptest.MatchValue(h.Panicked, matcher)
+		ok, msg := _imptest.MatchPanic(h.Panicked, h.PanicStack, matcher)
 		if !ok {
 			h.T.Fatalf("panic value: %s", msg)
 		}
 		return
 	}
 
-	h.T.Fatalf("expected method to panic, but it returned")
+	h.T.Fatalf("expected method to panic, but %s", h.Outcome())
 }
 
 // ReturnsEqual verifies the method returned the expected values.
@@ -288,13 +346,13 @@ func (h *StartCalculatorWrapperMultiplyCallHandle) ReturnsEqual(v0 int) {
 	h.WaitForResponse()
 
 	if h.Returned != nil {
-		if !_reflect.DeepEqual(h.Returned.Result0, v0) {
-			h.T.Fatalf("expected return value 0 to be %v, got %v", v0, h.Returned.Result0)
+		if ok, msg := _imptest.EqualValue(h.T, h.Returned.Result0, v0); !ok {
+			h.T.Fatalf("return value 0: %s", msg)
 		}
 		return
 	}
 
-	h.T.Fatalf("expected method to return, but it panicked with: %v", h.Panicked)
+	h.T.Fatalf("expected method to return, but %s", h.Outcome())
 }
 
 // ReturnsShould verifies the return values match the given matchers.
@@ -312,7 +370,7 @@ func (h *StartCalculatorWrapperMultiplyCallHandle) ReturnsShould(v0 any) {
 		return
 	}
 
-	h.T.Fatalf("expected method to return, but it panicked with: %v", h.Panicked)
+	h.T.Fatalf("expected method to return, but %s", h.Outcome())
 }
 
 type StartCalculatorWrapperMultiplyReturns struct {
@@ -330,12 +388,16 @@ func (w *StartCalculatorWrapperMultiplyWrapper) Start(value int) *StartCalculato
 		CallableController: _imptest.NewCallableController[StartCalculatorWrapperMultiplyReturns](w.t),
 	}
 	go func() {
+		_completed := false
 		defer func() {
 			if r := recover(); r != nil {
-				handle.PanicChan <- r
+				handle.RecordPanic(r)
+			} else if !_completed {
+				handle.RecordGoexit()
 			}
 		}()
 		returns := w.fn(value)
+		_completed = true
 		handle.ReturnChan <- returns
 	}()
 	return handle
@@ -350,11 +412,28 @@ func (h *StartCalculatorWrapperProcessCallHandle) Completes() {
 	h.T.Helper()
 	h.WaitForResponse()
 
-	if h.Panicked != nil {
-		h.T.Fatalf("expected method to complete, but it panicked with: %v", h.Panicked)
+	if h.Panicked != nil || h.Goexited {
+		h.T.Fatalf("expected method to complete, but %s", h.Outcome())
 	}
 }
 
+// GoexitedShould verifies the method exited via runtime.Goexit (e.g. t.FailNow) without
+// returning or panicking. The matcher is applied to the goroutine's stack trace as a string.
+func (h *StartCalculatorWrapperProcessCallHandle) GoexitedShould(matcher any) {
+	h.T.Helper()
+	h.WaitForResponse()
+
+	if h.Goexited {
+		ok, msg := _imptest.MatchValue(string(h.GoexitStack), matcher)
+		if !ok {
+			h.T.Fatalf("goexit stack: %s", msg)
+		}
+		return
+	}
+
+	h.T.Fatalf("expected method to exit via runtime.Goexit, but %s", h.Outcome())
+}
+
 // PanicEquals verifies the method panics with the expected value.
 func (h *StartCalculatorWrapperProcessCallHandle) PanicEquals(expected any) {
 	h.T.Helper()
@@ -368,7 +447,7 @@ func (h *StartCalculatorWrapperProcessCallHandle) PanicEquals(expected any) {
 		return
 	}
 
-	h.T.Fatalf("expected method to panic, but it returned")
+	h.T.Fatalf("expected method to panic, but %s", h.Outcome())
 }
 
 // PanicShould verifies the method panics with a value matching the given matcher.
@@ -377,14 +456,14 @@ func (h *StartCalculatorWrapperProcessCallHandle) PanicShould(matcher any) {
 	h.WaitForResponse()
 
 	if h.Panicked != nil {
-		ok, msg := _imptest.MatchValue(h.Panicked, matcher)
+		ok, msg := _imptest.MatchPanic(h.Panicked, h.PanicStack, matcher)
 		if !ok {
 			h.T.Fatalf("panic value: %s", msg)
 		}
 		return
 	}
 
-	h.T.Fatalf("expected method to panic, but it returned")
+	h.T.Fatalf("expected method to panic, but %s", h.Outcome())
 }
 
 // ReturnsEqual verifies the method returned the expected values.
@@ -393,16 +472,16 @@ func (h *StartCalculatorWrapperProcessCallHandle) ReturnsEqual(v0 string, v1 err
 	h.WaitForResponse()
 
 	if h.Returned != nil {
-		if !_reflect.DeepEqual(h.Returned.Result0, v0) {
-			h.T.Fatalf("expected return value 0 to be %v, got %v", v0, h.Returned.Result0)
+		if ok, msg := _imptest.EqualValue(h.T, h.Returned.Result0, v0); !ok {
+			h.T.Fatalf("return value 0: %s", msg)
 		}
-		if !_reflect.DeepEqual(h.Returned.Result1, v1) {
-			h.T.Fatalf("expected return value 1 to be %v, got %v", v1, h.Returned.Result1)
+		if ok, msg := _imptest.EqualValue(h.T, h.Returned.Result1, v1); !ok {
+			h.T.Fatalf("return value 1: %s", msg)
 		}
 		return
 	}
 
-	h.T.Fatalf("expected method to return, but it panicked with: %v", h.Panicked)
+	h.T.Fatalf("expected method to return, but %s", h.Outcome())
 }
 
 // ReturnsShould verifies the return values match the given matchers.
@@ -424,7 +503,7 @@ func (h *StartCalculatorWrapperProcessCallHandle) ReturnsShould(v0 any, v1 any)
 		return
 	}
 
-	h.T.Fatalf("expected method to return, but it panicked with: %v", h.Panicked)
+	h.T.Fatalf("expected method to return, but %s", h.Outcome())
 }
 
 type StartCalculatorWrapperProcessReturns struct {
@@ -443,12 +522,16 @@ func (w *StartCalculatorWrapperProcessWrapper) Start(input int) *StartCalculator
 		CallableController: _imptest.NewCallableController[StartCalculatorWrapperProcessReturns](w.t),
 	}
 	go func() {
+		_completed := false
 		defer func() {
 			if r := recover(); r != nil {
-				handle.PanicChan <- r
+				handle.RecordPanic(r)
+			} else if !_completed {
+				handle.RecordGoexit()
 			}
 		}()
 		returns := w.fn(input)
+		_completed = true
 		handle.ReturnChan <- returns
 	}()
 	return handle
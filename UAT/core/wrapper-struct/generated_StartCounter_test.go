@@ -6,7 +6,6 @@ package calculator_test
 import (
 	_imptest "github.com/toejough/imptest"
 	calculator "github.com/toejough/imptest/UAT/core/wrapper-struct"
-	_reflect "reflect"
 	"testing"
 )
 
@@ -19,9 +18,26 @@ func (h *StartCounterWrapperAddAmountCallHandle) Completes() {
 	h.T.Helper()
 	h.WaitForResponse()
 
-	if h.Panicked != nil {
-		h.T.Fatalf("expected method to complete, but it panicked with: %v", h.Panicked)
+	if h.Panicked != nil || h.Goexited {
+		h.T.Fatalf("expected method to complete, but %s", h.Outcome())
+	}
+}
+
+// GoexitedShould verifies the method exited via runtime.Goexit (e.g. t.FailNow) without
+// returning or panicking. The matcher is applied to the goroutine's stack trace as a string.
+func (h *StartCounterWrapperAddAmountCallHandle) GoexitedShould(matcher any) {
+	h.T.Helper()
+	h.WaitForResponse()
+
+	if h.Goexited {
+		ok, msg := _imptest.MatchValue(string(h.GoexitStack), matcher)
+		if !ok {
+			h.T.Fatalf("goexit stack: %s", msg)
+		}
+		return
 	}
+
+	h.T.Fatalf("expected method to exit via runtime.Goexit, but %s", h.Outcome())
 }
 
 // PanicEquals verifies the method panics with the expected value.
@@ -37,7 +53,7 @@ func (h *StartCounterWrapperAddAmountCallHandle) PanicEquals(expected any) {
 		return
 	}
 
-	h.T.Fatalf("expected method to panic, but it returned")
+	h.T.Fatalf("expected method to panic, but %s", h.Outcome())
 }
 
 // PanicShould verifies the method panics with a value matching the given matcher.
@@ -46,14 +62,14 @@ func (h *StartCounterWrapperAddAmountCallHandle) PanicShould(matcher any) {
 	h.WaitForResponse()
 
 	if h.Panicked != nil {
-		ok, msg := _imptest.MatchValue(h.Panicked, matcher)
+		ok, msg := _imptest.MatchPanic(h.Panicked, h.PanicStack, matcher)
 		if !ok {
 			h.T.Fatalf("panic value: %s", msg)
 		}
 		return
 	}
 
-	h.T.Fatalf("expected method to panic, but it returned")
+	h.T.Fatalf("expected method to panic, but %s", h.Outcome())
 }
 
 // ReturnsEqual verifies the method returned the expected values.
@@ -62,13 +78,13 @@ func (h *StartCounterWrapperAddAmountCallHandle) ReturnsEqual(v0 int) {
 	h.WaitForResponse()
 
 	if h.Returned != nil {
-		if !_reflect.DeepEqual(h.Returned.Result0, v0) {
-			h.T.Fatalf("expected return value 0 to be %v, got %v", v0, h.Returned.Result0)
+		if ok, msg := _imptest.EqualValue(h.T, h.Returned.Result0, v0); !ok {
+			h.T.Fatalf("return value 0: %s", msg)
 		}
 		return
 	}
 
-	h.T.Fatalf("expected method to return, but it panicked with: %v", h.Panicked)
+	h.T.Fatalf("expected method to return, but %s", h.Outcome())
 }
 
 // ReturnsShould verifies the return values match the given matchers.
@@ -86,7 +102,7 @@ func (h *StartCounterWrapperAddAmountCallHandle) ReturnsShould(v0 any) {
 		return
 	}
 
-	h.T.Fatalf("expected method to return, but it panicked with: %v", h.Panicked)
+	h.T.Fatalf("expected method to return, but %s", h.Outcome())
 }
 
 type StartCounterWrapperAddAmountReturns struct {
@@ -104,12 +120,16 @@ func (w *StartCounterWrapperAddAmountWrapper) Start(amount int) *StartCounterWra
 		CallableController: _imptest.NewCallableController[StartCounterWrapperAddAmountReturns](w.t),
 	}
 	go func() {
+		_completed := false
 		defer func() {
 			if r := recover(); r != nil {
-				handle.PanicChan <- r
+				handle.RecordPanic(r)
+			} else if !_completed {
+				handle.RecordGoexit()
 			}
 		}()
 		returns := w.fn(amount)
+		_completed = true
 		handle.ReturnChan <- returns
 	}()
 	return handle
@@ -124,9 +144,26 @@ func (h *StartCounterWrapperGetValueCallHandle) Completes() {
 	h.T.Helper()
 	h.WaitForResponse()
 
-	if h.Panicked != nil {
-		h.T.Fatalf("expected method to complete, but it panicked with: %v", h.Panicked)
+	if h.Panicked != nil || h.Goexited {
+		h.T.Fatalf("expected method to complete, but %s", h.Outcome())
+	}
+}
+
+// GoexitedShould verifies the method exited via runtime.Goexit (e.g. t.FailNow) without
+// returning or panicking. The matcher is applied to the goroutine's stack trace as a string.
+func (h *StartCounterWrapperGetValueCallHandle) GoexitedShould(matcher any) {
+	h.T.Helper()
+	h.WaitForResponse()
+
+	if h.Goexited {
+		ok, msg := _imptest.MatchValue(string(h.GoexitStack), matcher)
+		if !ok {
+			h.T.Fatalf("goexit stack: %s", msg)
+		}
+		return
 	}
+
+	h.T.Fatalf("expected method to exit via runtime.Goexit, but %s", h.Outcome())
 }
 
 // PanicEquals verifies the method panics with the expected value.
@@ -142,7 +179,7 @@ func (h *StartCounterWrapperGetValueCallHandle) PanicEquals(expected any) {
 		return
 	}
 
-	h.T.Fatalf("expected method to panic, but it returned")
+	h.T.Fatalf("expected method to panic, but %s", h.Outcome())
 }
 
 // PanicShould verifies the method panics with a value matching the given matcher.
@@ -151,14 +188,14 @@ func (h *StartCounterWrapperGetValueCallHandle) PanicShould(matcher any) {
 	h.WaitForResponse()
 
 	if h.Panicked != nil {
-		ok, msg := _imptest.MatchValue(h.Panicked, matcher)
+		ok, msg := _imptest.MatchPanic(h.Panicked, h.PanicStack, matcher)
 		if !ok {
 			h.T.Fatalf("panic value: %s", msg)
 		}
 		return
 	}
 
-	h.T.Fatalf("expected method to panic, but it returned")
+	h.T.Fatalf("expected method to panic, but %s", h.Outcome())
 }
 
 // ReturnsEqual verifies the method returned the expected values.
@@ -167,13 +204,13 @@ func (h *StartCounterWrapperGetValueCallHandle) ReturnsEqual(v0 int) {
 	h.WaitForResponse()
 
 	if h.Returned != nil {
-		if !_reflect.DeepEqual(h.Returned.Result0, v0) {
-			h.T.Fatalf("expected return value 0 to be %v, got %v", v0, h.Returned.Result0)
+		if ok, msg := _imptest.EqualValue(h.T, h.Returned.Result0, v0); !ok {
+			h.T.Fatalf("return value 0: %s", msg)
 		}
 		return
 	}
 
-	h.T.Fatalf("expected method to return, but it panicked with: %v", h.Panicked)
+	h.T.Fatalf("expected method to return, but %s", h.Outcome())
 }
 
 // ReturnsShould verifies the return values match the given matchers.
@@ -191,7 +228,7 @@ func (h *StartCounterWrapperGetValueCallHandle) ReturnsShould(v0 any) {
 		return
 	}
 
-	h.T.Fatalf("expected method to return, but it panicked with: %v", h.Panicked)
+	h.T.Fatalf("expected method to return, but %s", h.Outcome())
 }
 
 type StartCounterWrapperGetValueReturns struct {
@@ -209,12 +246,16 @@ func (w *StartCounterWrapperGetValueWrapper) Start() *StartCounterWrapperGetValu
 		CallableController: _imptest.NewCallableController[StartCounterWrapperGetValueReturns](w.t),
 	}
 	go func() {
+		_completed := false
 		defer func() {
 			if r := recover(); r != nil {
-				handle.PanicChan <- r
+				handle.RecordPanic(r)
+			} else if !_completed {
+				handle.RecordGoexit()
 			}
 		}()
 		returns := w.fn()
+		_completed = true
 		handle.ReturnChan <- returns
 	}()
 	return handle
@@ -236,9 +277,26 @@ func (h *StartCounterWrapperIncrementCallHandle) Completes() {
 	h.T.Helper()
 	h.WaitForResponse()
 
-	if h.Panicked != nil {
-		h.T.Fatalf("expected method to complete, but it panicked with: %v", h.Panicked)
+	if h.Panicked != nil || h.Goexited {
+		h.T.Fatalf("expected method to complete, but %s", h.Outcome())
+	}
+}
+
+// GoexitedShould verifies the method exited via runtime.Goexit (e.g. t.FailNow) without
+// returning or panicking. The matcher is applied to the goroutine's stack trace as a string.
+func (h *StartCounterWrapperIncrementCallHandle) GoexitedShould(matcher any) {
+	h.T.Helper()
+	h.WaitForResponse()
+
+	if h.Goexited {
+		ok, msg := _imptest.MatchValue(string(h.GoexitStack), matcher)
+		if !ok {
+			h.T.Fatalf("goexit stack: %s", msg)
+		}
+		return
 	}
+
+	h.T.Fatalf("expected method to exit via runtime.Goexit, but %s", h.Outcome())
 }
 
 // PanicEquals verifies the method panics with the expected value.
@@ -254,7 +312,7 @@ func (h *StartCounterWrapperIncrementCallHandle) PanicEquals(expected any) {
 		return
 	}
 
-	h.T.Fatalf("expected method to panic, but it returned")
+	h.T.Fatalf("expected method to panic, but %s", h.Outcome())
 }
 
 // PanicShould verifies the method panics with a value matching the given matcher.
@@ -263,14 +321,14 @@ func (h *StartCounterWrapperIncrementCallHandle) PanicShould(matcher any) {
 	h.WaitForResponse()
 
 	if h.Panicked != nil {
-		ok, msg := _imptest.MatchValue(h.Panicked, matcher)
+		ok, msg := _imptest.MatchPanic(h.Panicked, h.PanicStack, matcher)
 		if !ok {
 			h.T.Fatalf("panic value: %s", msg)
 		}
 		return
 	}
 
-	h.T.Fatalf("expected method to panic, but it returned")
+	h.T.Fatalf("expected method to panic, but %s", h.Outcome())
 }
 
 // ReturnsEqual verifies the method returned the expected values.
@@ -279,13 +337,13 @@ func (h *StartCounterWrapperIncrementCallHandle) ReturnsEqual(v0 int) {
 	h.WaitForResponse()
 
 	if h.Returned != nil {
-		if !_reflect.DeepEqual(h.Returned.Result0, v0) {
-			h.T.Fatalf("expected return value 0 to be %v, got %v", v0, h.Returned.Result0)
+		if ok, msg := _imptest.EqualValue(h.T, h.Returned.Result0, v0); !ok {
+			h.T.Fatalf("return value 0: %s", msg)
 		}
 		return
 	}
 
-	h.T.Fatalf("expected method to return, but it panicked with: %v", h.Panicked)
+	h.T.Fatalf("expected method to return, but %s", h.Outcome())
 }
 
 // ReturnsShould verifies the return values match the given matchers.
@@ -303,7 +361,7 @@ func (h *StartCounterWrapperIncrementCallHandle) ReturnsShould(v0 any) {
 		return
 	}
 
-	h.T.Fatalf("expected method to return, but it panicked with: %v", h.Panicked)
+	h.T.Fatalf("expected method to return, but %s", h.Outcome())
 }
 
 type StartCounterWrapperIncrementReturns struct {
@@ -321,12 +379,16 @@ func (w *StartCounterWrapperIncrementWrapper) Start() *StartCounterWrapperIncrem
 		CallableController: _imptest.NewCallableController[StartCounterWrapperIncrementReturns](w.t),
 	}
 	go func() {
+		_completed := false
 		defer func() {
 			if r := recover(); r != nil {
-				handle.PanicChan <- r
+				handle.RecordPanic(r)
+			} else if !_completed {
+				handle.RecordGoexit()
 			}
 		}()
 		returns := w.fn()
+		_completed = true
 		handle.ReturnChan <- returns
 	}()
 	return handle
@@ -0,0 +1,55 @@
+// Package failing provides a TestReporter for UATs that demonstrate failure messages: it
+// records the first fatal failure instead of failing the test that uses it.
+package failing
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Reporter records the message of the first Fatalf call and then, like testing.T.FailNow,
+// exits the calling goroutine.
+type Reporter struct {
+	fatal chan string
+}
+
+// NewReporter returns a Reporter that has not failed yet.
+func NewReporter() *Reporter {
+	return &Reporter{fatal: make(chan string, 1)}
+}
+
+// AssertFailure waits for the reporter to fail and checks that the message contains want.
+func (r *Reporter) AssertFailure(t *testing.T, want string) {
+	t.Helper()
+
+	if msg := r.Wait(t); !strings.Contains(msg, want) {
+		t.Errorf("expected failure containing %q, got %q", want, msg)
+	}
+}
+
+// Fatalf records the failure message and exits the calling goroutine.
+func (r *Reporter) Fatalf(format string, args ...any) {
+	r.fatal <- fmt.Sprintf(format, args...)
+
+	runtime.Goexit()
+}
+
+// Helper is a no-op, satisfying imptest.TestReporter.
+func (r *Reporter) Helper() {}
+
+// Wait returns the failure message, failing t if the reporter does not fail within five seconds.
+func (r *Reporter) Wait(t *testing.T) string {
+	t.Helper()
+
+	select {
+	case msg := <-r.fatal:
+		return msg
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the test to fail")
+
+		return ""
+	}
+}
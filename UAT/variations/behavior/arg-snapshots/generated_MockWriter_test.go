@@ -0,0 +1,131 @@
+// Code generated by impgen. DO NOT EDIT.
+// impgen:hash:166c983d5ac5d5a5
+
+package snapshots_test
+
+import (
+	_imptest "github.com/toejough/imptest"
+	snapshots "github.com/toejough/imptest/UAT/variations/behavior/arg-snapshots"
+	_reflect "reflect"
+	_time "time"
+)
+
+type WriterImp struct {
+	Write *WriterMockWriteMethod
+	// Eventually provides async versions of all methods for concurrent code.
+	Eventually *WriterImpEventually
+}
+
+type WriterImpEventually struct {
+	Write *WriterMockWriteMethod
+}
+
+type WriterMockWriteArgs struct {
+	P []byte
+}
+
+type WriterMockWriteCall struct {
+	*_imptest.DependencyCall
+}
+
+// FillP copies values into the caller's P slice before the mock returns.
+func (c *WriterMockWriteCall) FillP(values []byte) *WriterMockWriteCall {
+	c.FillArg(0, values)
+	return c
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *WriterMockWriteCall) GetArgs() WriterMockWriteArgs {
+	raw := c.RawArgs()
+	return WriterMockWriteArgs{
+		P: raw[0].([]byte),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *WriterMockWriteCall) Return(result0 int, result1 error) {
+	c.DependencyCall.Return(result0, result1)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *WriterMockWriteCall) ReturnAfter(d _time.Duration, result0 int, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
+type WriterMockWriteMethod struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *WriterMockWriteMethod) ArgsEqual(p []byte) *WriterMockWriteCall {
+	call := m.DependencyMethod.ArgsEqual(p)
+	return &WriterMockWriteCall{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *WriterMockWriteMethod) ArgsShould(p any) *WriterMockWriteCall {
+	_call := m.DependencyMethod.ArgsShould(p)
+	return &WriterMockWriteCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *WriterMockWriteMethod) ArgsWhere(predicate func(WriterMockWriteArgs) error) *WriterMockWriteCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args WriterMockWriteArgs
+		args.P, _ = raw[0].([]byte)
+		return predicate(args)
+	})
+	return &WriterMockWriteCall{DependencyCall: call}
+}
+
+// MockWriter creates a mock Writer and returns (mock, expectation handle).
+func MockWriter(t _imptest.TestReporter) (snapshots.Writer, *WriterImp) {
+	ctrl := _imptest.GetOrCreateImp(t)
+	imp := &WriterImp{
+		Write: newWriterMockWriteMethod(_imptest.NewDependencyMethod(ctrl, "Write").Results(_reflect.TypeFor[int](), _reflect.TypeFor[error]())),
+	}
+	imp.Eventually = &WriterImpEventually{
+		Write: newWriterMockWriteMethod(_imptest.NewDependencyMethod(ctrl, "Write").Results(_reflect.TypeFor[int](), _reflect.TypeFor[error]()).AsEventually()),
+	}
+	mock := &mockWriterImpl{ctrl: ctrl}
+	return mock, imp
+}
+
+type mockWriterImpl struct {
+	ctrl *_imptest.Imp
+}
+
+// Write implements snapshots.Writer.Write.
+func (impl *mockWriterImpl) Write(p []byte) (int, error) {
+	call := &_imptest.GenericCall{
+		MethodName:   "Write",
+		Args:         []any{p},
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 int
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(int); ok {
+			result1 = value
+		}
+	}
+
+	var result2 error
+	if len(resp.ReturnValues) > 1 {
+		if value, ok := resp.ReturnValues[1].(error); ok {
+			result2 = value
+		}
+	}
+
+	return result1, result2
+}
+
+// newWriterMockWriteMethod creates a typed method wrapper.
+func newWriterMockWriteMethod(dm *_imptest.DependencyMethod) *WriterMockWriteMethod {
+	return &WriterMockWriteMethod{DependencyMethod: dm}
+}
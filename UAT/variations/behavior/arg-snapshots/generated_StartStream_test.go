@@ -0,0 +1,151 @@
+// Code generated by impgen. DO NOT EDIT.
+// impgen:hash:0cae138e02809220
+
+package snapshots_test
+
+import (
+	_imptest "github.com/toejough/imptest"
+	snapshots "github.com/toejough/imptest/UAT/variations/behavior/arg-snapshots"
+)
+
+type StartStreamCallHandle struct {
+	*_imptest.CallableController[StartStreamReturnsReturn]
+	controller        *_imptest.TargetController
+	pendingCompletion *_imptest.PendingCompletion
+	// Eventually is the async version of this call handle for registering non-blocking expectations.
+	Eventually *StartStreamCallHandleEventually
+}
+
+// GoexitedShould verifies the function exited via runtime.Goexit (e.g. t.FailNow) without
+// returning or panicking. The matcher is applied to the goroutine's stack trace as a string.
+func (h *StartStreamCallHandle) GoexitedShould(matcher any) {
+	h.T.Helper()
+	h.WaitForResponse()
+
+	if h.Goexited {
+		ok, msg := _imptest.MatchValue(string(h.GoexitStack), matcher)
+		if !ok {
+			h.T.Fatalf("goexit stack: %s", msg)
+		}
+		return
+	}
+
+	h.T.Fatalf("expected function to exit via runtime.Goexit, but %s", h.Outcome())
+}
+
+// PanicEquals verifies the function panics with the expected value.
+func (h *StartStreamCallHandle) PanicEquals(expected any) {
+	h.T.Helper()
+	h.WaitForResponse()
+
+	if h.Panicked != nil {
+		ok, msg := _imptest.MatchValue(h.Panicked, expected)
+		if !ok {
+			h.T.Fatalf("panic value: %s", msg)
+		}
+		return
+	}
+
+	h.T.Fatalf("expected function to panic, but %s", h.Outcome())
+}
+
+// PanicShould verifies the function panics with a value matching the given matcher.
+func (h *StartStreamCallHandle) PanicShould(matcher any) {
+	h.T.Helper()
+	h.WaitForResponse()
+
+	if h.Panicked != nil {
+		ok, msg := _imptest.MatchPanic(h.Panicked, h.PanicStack, matcher)
+		if !ok {
+			h.T.Fatalf("panic value: %s", msg)
+		}
+		return
+	}
+
+	h.T.Fatalf("expected function to panic, but %s", h.Outcome())
+}
+
+// ReturnsEqual verifies the function returned the expected values.
+func (h *StartStreamCallHandle) ReturnsEqual(v0 error) {
+	h.T.Helper()
+	h.WaitForResponse()
+
+	if h.Returned != nil {
+		if ok, msg := _imptest.EqualValue(h.T, h.Returned.Result0, v0); !ok {
+			h.T.Fatalf("return value 0: %s", msg)
+		}
+		return
+	}
+
+	h.T.Fatalf("expected function to return, but %s", h.Outcome())
+}
+
+// ReturnsShould verifies the return values match the given matchers.
+func (h *StartStreamCallHandle) ReturnsShould(v0 any) {
+	h.T.Helper()
+	h.WaitForResponse()
+
+	if h.Returned != nil {
+		var ok bool
+		var msg string
+		ok, msg = _imptest.MatchValue(h.Returned.Result0, v0)
+		if !ok {
+			h.T.Fatalf("return value 0: %s", msg)
+		}
+		return
+	}
+
+	h.T.Fatalf("expected function to return, but %s", h.Outcome())
+}
+
+type StartStreamCallHandleEventually struct {
+	h *StartStreamCallHandle
+}
+
+// PanicEquals registers an async expectation for a panic value.
+func (e *StartStreamCallHandleEventually) PanicEquals(value any) {
+	e.ensureStarted().ExpectPanic(value)
+}
+
+// ReturnsEqual registers an async expectation for return values.
+func (e *StartStreamCallHandleEventually) ReturnsEqual(values ...any) {
+	e.ensureStarted().ExpectReturn(values...)
+}
+
+func (e *StartStreamCallHandleEventually) ensureStarted() *_imptest.PendingCompletion {
+	if e.h.pendingCompletion == nil {
+		e.h.pendingCompletion = e.h.controller.RegisterPendingCompletion()
+		go func() {
+			e.h.WaitForResponse()
+			e.h.pendingCompletion.SetCompleted(e.h.Completion())
+		}()
+	}
+	return e.h.pendingCompletion
+}
+
+type StartStreamReturnsReturn struct {
+	Result0 error
+}
+
+// StartStream starts the wrapped function in a goroutine for testing.
+func StartStream(t _imptest.TestReporter, fn func(snapshots.Writer, []string) error, w snapshots.Writer, chunks []string) *StartStreamCallHandle {
+	handle := &StartStreamCallHandle{
+		CallableController: _imptest.NewCallableController[StartStreamReturnsReturn](t),
+		controller:         _imptest.NewTargetController(t),
+	}
+	handle.Eventually = &StartStreamCallHandleEventually{h: handle}
+	go func() {
+		_completed := false
+		defer func() {
+			if r := recover(); r != nil {
+				handle.RecordPanic(r)
+			} else if !_completed {
+				handle.RecordGoexit()
+			}
+		}()
+		ret0 := fn(w, chunks)
+		_completed = true
+		handle.ReturnChan <- StartStreamReturnsReturn{Result0: ret0}
+	}()
+	return handle
+}
@@ -0,0 +1,37 @@
+package snapshots_test
+
+import (
+	"testing"
+
+	"github.com/toejough/imptest"
+	snapshots "github.com/toejough/imptest/UAT/variations/behavior/arg-snapshots"
+	"github.com/toejough/imptest/match"
+)
+
+//go:generate impgen snapshots.Writer --dependency
+//go:generate impgen snapshots.Stream --target
+
+// TestArgSnapshots_GetArgs demonstrates that GetArgs returns the bytes as written,
+// even though the caller reuses its buffer for the next write.
+//
+// Key Requirements Met:
+//  1. Buffer Reuse: io.Writer-style callers may overwrite p after Write returns.
+//  2. Call-Time Values: the recorded arguments are unaffected by that mutation.
+func TestArgSnapshots_GetArgs(t *testing.T) {
+	t.Parallel()
+	imptest.SetArgSnapshots(t, true)
+
+	mock, expect := MockWriter(t)
+
+	call := StartStream(t, snapshots.Stream, mock, []string{"ab", "cd"})
+
+	first := expect.Write.ArgsShould(match.BeAny)
+	first.Return(2, nil)
+	expect.Write.Called().Return(2, nil)
+	call.ReturnsEqual(nil)
+
+	// Requirement: the first write still reads "ab", not the reused buffer's "cd".
+	if got := string(first.GetArgs().P); got != "ab" {
+		t.Fatalf("expected first write to record %q, got %q", "ab", got)
+	}
+}
@@ -0,0 +1,22 @@
+// Package snapshots demonstrates snapshotting mock arguments for callers that reuse buffers.
+package snapshots
+
+type Writer interface {
+	Write(p []byte) (int, error)
+}
+
+// Stream writes each chunk to w, reusing a single buffer between writes.
+func Stream(w Writer, chunks []string) error {
+	buf := make([]byte, 0, 64)
+
+	for _, chunk := range chunks {
+		buf = append(buf[:0], chunk...)
+
+		_, err := w.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -7,6 +7,8 @@ import (
 	_imptest "github.com/toejough/imptest"
 	visitor "github.com/toejough/imptest/UAT/variations/behavior/callbacks"
 	fs "io/fs"
+	_reflect "reflect"
+	_time "time"
 )
 
 type TreeWalkerImp struct {
@@ -44,6 +46,11 @@ func (c *TreeWalkerMockWalkCall) Return(result0 error) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *TreeWalkerMockWalkCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type TreeWalkerMockWalkMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -54,9 +61,22 @@ func (m *TreeWalkerMockWalkMethod) ArgsEqual(root string, fn func(string, fs.Dir
 	return &TreeWalkerMockWalkCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *TreeWalkerMockWalkMethod) ArgsShould(matchers ...any) *TreeWalkerMockWalkCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *TreeWalkerMockWalkMethod) ArgsShould(root any, fn any) *TreeWalkerMockWalkCall {
+	_call := m.DependencyMethod.ArgsShould(root, fn)
+	return &TreeWalkerMockWalkCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *TreeWalkerMockWalkMethod) ArgsWhere(predicate func(TreeWalkerMockWalkArgs) error) *TreeWalkerMockWalkCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args TreeWalkerMockWalkArgs
+		args.Root, _ = raw[0].(string)
+		args.Fn, _ = raw[1].(func(string, fs.DirEntry, error) error)
+		return predicate(args)
+	})
 	return &TreeWalkerMockWalkCall{DependencyCall: call}
 }
 
@@ -83,6 +103,11 @@ func (c *TreeWalkerMockWalkWithNamedTypeCall) Return(result0 error) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *TreeWalkerMockWalkWithNamedTypeCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type TreeWalkerMockWalkWithNamedTypeMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -93,9 +118,22 @@ func (m *TreeWalkerMockWalkWithNamedTypeMethod) ArgsEqual(root string, fn visito
 	return &TreeWalkerMockWalkWithNamedTypeCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *TreeWalkerMockWalkWithNamedTypeMethod) ArgsShould(matchers ...any) *TreeWalkerMockWalkWithNamedTypeCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *TreeWalkerMockWalkWithNamedTypeMethod) ArgsShould(root any, fn any) *TreeWalkerMockWalkWithNamedTypeCall {
+	_call := m.DependencyMethod.ArgsShould(root, fn)
+	return &TreeWalkerMockWalkWithNamedTypeCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *TreeWalkerMockWalkWithNamedTypeMethod) ArgsWhere(predicate func(TreeWalkerMockWalkWithNamedTypeArgs) error) *TreeWalkerMockWalkWithNamedTypeCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args TreeWalkerMockWalkWithNamedTypeArgs
+		args.Root, _ = raw[0].(string)
+		args.Fn, _ = raw[1].(visitor.WalkFunc)
+		return predicate(args)
+	})
 	return &TreeWalkerMockWalkWithNamedTypeCall{DependencyCall: call}
 }
 
@@ -103,12 +141,12 @@ func (m *TreeWalkerMockWalkWithNamedTypeMethod) ArgsShould(matchers ...any) *Tre
 func MockTreeWalker(t _imptest.TestReporter) (visitor.TreeWalker, *TreeWalkerImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &TreeWalkerImp{
-		Walk:              newTreeWalkerMockWalkMethod(_imptest.NewDependencyMethod(ctrl, "Walk")),
-		WalkWithNamedType: newTreeWalkerMockWalkWithNamedTypeMethod(_imptest.NewDependencyMethod(ctrl, "WalkWithNamedType")),
+		Walk:              newTreeWalkerMockWalkMethod(_imptest.NewDependencyMethod(ctrl, "Walk").Results(_reflect.TypeFor[error]())),
+		WalkWithNamedType: newTreeWalkerMockWalkWithNamedTypeMethod(_imptest.NewDependencyMethod(ctrl, "WalkWithNamedType").Results(_reflect.TypeFor[error]())),
 	}
 	imp.Eventually = &TreeWalkerImpEventually{
-		Walk:              newTreeWalkerMockWalkMethod(_imptest.NewDependencyMethod(ctrl, "Walk").AsEventually()),
-		WalkWithNamedType: newTreeWalkerMockWalkWithNamedTypeMethod(_imptest.NewDependencyMethod(ctrl, "WalkWithNamedType").AsEventually()),
+		Walk:              newTreeWalkerMockWalkMethod(_imptest.NewDependencyMethod(ctrl, "Walk").Results(_reflect.TypeFor[error]()).AsEventually()),
+		WalkWithNamedType: newTreeWalkerMockWalkWithNamedTypeMethod(_imptest.NewDependencyMethod(ctrl, "WalkWithNamedType").Results(_reflect.TypeFor[error]()).AsEventually()),
 	}
 	mock := &mockTreeWalkerImpl{ctrl: ctrl}
 	return mock, imp
@@ -127,9 +165,7 @@ func (impl *mockTreeWalkerImpl) Walk(root string, fn func(string, fs.DirEntry, e
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 error
 	if len(resp.ReturnValues) > 0 {
@@ -150,9 +186,7 @@ func (impl *mockTreeWalkerImpl) WalkWithNamedType(root string, fn visitor.WalkFu
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 error
 	if len(resp.ReturnValues) > 0 {
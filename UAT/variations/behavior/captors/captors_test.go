@@ -0,0 +1,152 @@
+package captors_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/toejough/imptest"
+	"github.com/toejough/imptest/UAT/internal/failing"
+	captors "github.com/toejough/imptest/UAT/variations/behavior/captors"
+	. "github.com/toejough/imptest/match" //nolint:revive // Dot import for matcher DSL
+)
+
+//go:generate impgen captors.Scheduler --dependency
+
+// TestCaptureAll_Eventually demonstrates collecting an argument from several calls
+// matched in Eventually mode.
+//
+// Key Requirements Met:
+//  1. Accumulation: each matched call appends its argument to the slice.
+//  2. Async Support: captures happen as Eventually expectations match.
+func TestCaptureAll_Eventually(t *testing.T) {
+	t.Parallel()
+
+	mock, expect := MockScheduler(t)
+	runner := &captors.Runner{}
+
+	var jobs []func() error
+
+	for range 3 {
+		expect.Eventually.Schedule.ArgsShould(BeAny, 1, CaptureAll(&jobs)).Return("id")
+	}
+
+	go runner.ScheduleAll(mock, 1, "a", "b", "c")
+
+	imptest.Wait(t)
+
+	for _, job := range jobs {
+		_ = job()
+	}
+
+	if ran := runner.Ran(); !slices.Equal(ran, []string{"a", "b", "c"}) {
+		t.Fatalf("expected all captured jobs to run, got %v", ran)
+	}
+}
+
+// TestCapture_Callback demonstrates capturing a callback argument and invoking it later
+// to drive the code under test.
+//
+// Key Requirements Met:
+//  1. Capture: the callback is available without a separate GetArgs call.
+//  2. Typed Target: the argument is stored as func() error, ready to call.
+func TestCapture_Callback(t *testing.T) {
+	t.Parallel()
+
+	mock, expect := MockScheduler(t)
+	runner := &captors.Runner{}
+
+	var job func() error
+
+	go runner.ScheduleAll(mock, 1, "cleanup")
+
+	expect.Schedule.ArgsShould("cleanup", 1, Capture(&job)).Return("id-1")
+
+	if err := job(); err != nil {
+		t.Fatalf("expected the job to succeed, got %v", err)
+	}
+
+	if ran := runner.Ran(); !slices.Equal(ran, []string{"cleanup"}) {
+		t.Fatalf("expected the captured job to run, got %v", ran)
+	}
+}
+
+// TestCapture_InnerMatcher demonstrates wrapping a matcher, so the argument is both
+// checked and captured.
+//
+// Key Requirements Met:
+//  1. Wrapping: the inner matcher's failure message is reported on mismatch.
+func TestCapture_InnerMatcher(t *testing.T) {
+	t.Parallel()
+
+	reporter := failing.NewReporter()
+	mock, expect := MockScheduler(reporter)
+
+	var name string
+
+	go (&captors.Runner{}).ScheduleAll(mock, 1, "backup")
+
+	go func() { expect.Schedule.ArgsShould(Capture(&name, HavePrefix("job-")), 1, BeAny).Return("id") }()
+
+	reporter.AssertFailure(t, `arg 0: expected "backup" to have prefix "job-"`)
+}
+
+// TestCapture_Nested demonstrates captors nested inside combinators.
+//
+// Key Requirements Met:
+//  1. And: a captor alongside other conditions stores the matched argument.
+//  2. Or: only the captor in the condition that matched stores a value.
+func TestCapture_Nested(t *testing.T) {
+	t.Parallel()
+
+	mock, expect := MockScheduler(t)
+
+	var (
+		name     string
+		urgent   int
+		priority int
+	)
+
+	go (&captors.Runner{}).ScheduleAll(mock, 3, "job-backup")
+
+	expect.Schedule.ArgsShould(
+		And(Capture(&name), HavePrefix("job-")),
+		Or(And(BeNumerically(">", 5), Capture(&urgent)), Capture(&priority)),
+		BeAny,
+	).Return("id")
+
+	if name != "job-backup" {
+		t.Fatalf("expected the nested captor to store the name, got %q", name)
+	}
+
+	if urgent != 0 || priority != 3 {
+		t.Fatalf("expected only the matching branch to capture, got urgent=%d priority=%d", urgent, priority)
+	}
+}
+
+// TestCapture_OnlyMatchingCalls verifies that a call which fails on another argument
+// does not overwrite the capture target.
+//
+// Key Requirements Met:
+//  1. Commit on Match: values are stored only for the call that actually matched.
+func TestCapture_OnlyMatchingCalls(t *testing.T) {
+	t.Parallel()
+
+	mock, expect := MockScheduler(t)
+	runner := &captors.Runner{}
+
+	var urgent string
+
+	expect.Eventually.Schedule.ArgsShould(Capture(&urgent), 1, BeAny).Return("urgent")
+	expect.Eventually.Schedule.ArgsShould(BeAny, 5, BeAny).Return("routine")
+
+	go func() {
+		runner.ScheduleAll(mock, 5, "routine")
+		runner.ScheduleAll(mock, 1, "urgent")
+	}()
+
+	imptest.Wait(t)
+
+	if urgent != "urgent" {
+		t.Fatalf("expected only the matching call to be captured, got %q", urgent)
+	}
+}
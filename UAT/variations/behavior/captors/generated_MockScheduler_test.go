@@ -0,0 +1,124 @@
+// Code generated by impgen. DO NOT EDIT.
+// impgen:hash:d62102bdda5e626b
+
+package captors_test
+
+import (
+	_imptest "github.com/toejough/imptest"
+	captors "github.com/toejough/imptest/UAT/variations/behavior/captors"
+	_reflect "reflect"
+	_time "time"
+)
+
+type SchedulerImp struct {
+	Schedule *SchedulerMockScheduleMethod
+	// Eventually provides async versions of all methods for concurrent code.
+	Eventually *SchedulerImpEventually
+}
+
+type SchedulerImpEventually struct {
+	Schedule *SchedulerMockScheduleMethod
+}
+
+type SchedulerMockScheduleArgs struct {
+	Name     string
+	Priority int
+	Job      func() error
+}
+
+type SchedulerMockScheduleCall struct {
+	*_imptest.DependencyCall
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *SchedulerMockScheduleCall) GetArgs() SchedulerMockScheduleArgs {
+	raw := c.RawArgs()
+	return SchedulerMockScheduleArgs{
+		Name:     raw[0].(string),
+		Priority: raw[1].(int),
+		Job:      raw[2].(func() error),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *SchedulerMockScheduleCall) Return(result0 string) {
+	c.DependencyCall.Return(result0)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *SchedulerMockScheduleCall) ReturnAfter(d _time.Duration, result0 string) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
+type SchedulerMockScheduleMethod struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *SchedulerMockScheduleMethod) ArgsEqual(name string, priority int, job func() error) *SchedulerMockScheduleCall {
+	call := m.DependencyMethod.ArgsEqual(name, priority, job)
+	return &SchedulerMockScheduleCall{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *SchedulerMockScheduleMethod) ArgsShould(name any, priority any, job any) *SchedulerMockScheduleCall {
+	_call := m.DependencyMethod.ArgsShould(name, priority, job)
+	return &SchedulerMockScheduleCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *SchedulerMockScheduleMethod) ArgsWhere(predicate func(SchedulerMockScheduleArgs) error) *SchedulerMockScheduleCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args SchedulerMockScheduleArgs
+		args.Name, _ = raw[0].(string)
+		args.Priority, _ = raw[1].(int)
+		args.Job, _ = raw[2].(func() error)
+		return predicate(args)
+	})
+	return &SchedulerMockScheduleCall{DependencyCall: call}
+}
+
+// MockScheduler creates a mock Scheduler and returns (mock, expectation handle).
+func MockScheduler(t _imptest.TestReporter) (captors.Scheduler, *SchedulerImp) {
+	ctrl := _imptest.GetOrCreateImp(t)
+	imp := &SchedulerImp{
+		Schedule: newSchedulerMockScheduleMethod(_imptest.NewDependencyMethod(ctrl, "Schedule").Results(_reflect.TypeFor[string]())),
+	}
+	imp.Eventually = &SchedulerImpEventually{
+		Schedule: newSchedulerMockScheduleMethod(_imptest.NewDependencyMethod(ctrl, "Schedule").Results(_reflect.TypeFor[string]()).AsEventually()),
+	}
+	mock := &mockSchedulerImpl{ctrl: ctrl}
+	return mock, imp
+}
+
+type mockSchedulerImpl struct {
+	ctrl *_imptest.Imp
+}
+
+// Schedule implements captors.Scheduler.Schedule.
+func (impl *mockSchedulerImpl) Schedule(name string, priority int, job func() error) string {
+	call := &_imptest.GenericCall{
+		MethodName:   "Schedule",
+		Args:         []any{name, priority, job},
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 string
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(string); ok {
+			result1 = value
+		}
+	}
+
+	return result1
+}
+
+// newSchedulerMockScheduleMethod creates a typed method wrapper.
+func newSchedulerMockScheduleMethod(dm *_imptest.DependencyMethod) *SchedulerMockScheduleMethod {
+	return &SchedulerMockScheduleMethod{DependencyMethod: dm}
+}
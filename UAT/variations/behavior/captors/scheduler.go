@@ -0,0 +1,42 @@
+// Package captors demonstrates capturing mock arguments, such as callbacks, for later use.
+package captors
+
+import "sync"
+
+// Runner schedules jobs and records which of them have run.
+type Runner struct {
+	mu  sync.Mutex
+	ran []string
+}
+
+// Ran returns the names of the jobs that have run, in order.
+func (r *Runner) Ran() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]string(nil), r.ran...)
+}
+
+// ScheduleAll schedules one job per name at the given priority and returns the job IDs.
+// Each job records its name when the scheduler runs it.
+func (r *Runner) ScheduleAll(scheduler Scheduler, priority int, names ...string) []string {
+	ids := make([]string, 0, len(names))
+
+	for _, name := range names {
+		ids = append(ids, scheduler.Schedule(name, priority, func() error {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+
+			r.ran = append(r.ran, name)
+
+			return nil
+		}))
+	}
+
+	return ids
+}
+
+// Scheduler runs jobs at some later time.
+type Scheduler interface {
+	Schedule(name string, priority int, job func() error) string
+}
@@ -0,0 +1,137 @@
+package cmpmatching_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/toejough/imptest"
+	"github.com/toejough/imptest/UAT/internal/failing"
+	cmpmatching "github.com/toejough/imptest/UAT/variations/behavior/cmp-matching"
+	"github.com/toejough/imptest/match/cmpmatch"
+)
+
+//go:generate impgen cmpmatching.Repository --dependency
+//go:generate impgen cmpmatching.PlaceOrder --target
+
+// TestCmp_Diff demonstrates that a Cmp mismatch is reported as a go-cmp diff.
+//
+// Key Requirements Met:
+//  1. Diffs: the failure shows what differs instead of two full values.
+func TestCmp_Diff(t *testing.T) {
+	t.Parallel()
+
+	reporter := failing.NewReporter()
+	mock, expect := MockRepository(reporter)
+
+	go func() { _, _ = cmpmatching.PlaceOrder(mock, "o-1", map[string]int{"pear": 2}, 0) }()
+
+	go func() { expect.Save.ArgsShould(cmpmatch.Cmp(wantOrder("o-1", 3), orderOpts()...)).Return(nil) }()
+
+	reporter.AssertFailure(t, "arg 0: mismatch (-want +got):")
+
+	matcher := cmpmatch.Cmp(cmpmatching.Line{SKU: "pear", Qty: 3})
+	if msg := matcher.FailureMessage(cmpmatching.Line{SKU: "pear", Qty: 2}); !strings.Contains(msg, "Qty") {
+		t.Fatalf("expected the diff to name the Qty field, got %q", msg)
+	}
+}
+
+// TestCmp_Options demonstrates matching an argument with go-cmp options.
+//
+// Key Requirements Met:
+//  1. Ignored Fields: cmpopts.IgnoreFields skips the creation timestamp.
+//  2. Order Independence: cmpopts.SortSlices compares lines regardless of order.
+//  3. Approximate Numbers: cmpopts.EquateApprox tolerates float rounding in prices.
+func TestCmp_Options(t *testing.T) {
+	t.Parallel()
+
+	mock, expect := MockRepository(t)
+
+	go func() { _, _ = cmpmatching.PlaceOrder(mock, "o-1", map[string]int{"apple": 4, "pear": 2}, 0.1) }()
+
+	expect.Save.ArgsShould(cmpmatch.Cmp(cmpmatching.Order{
+		ID: "o-1",
+		Lines: []cmpmatching.Line{
+			{SKU: "apple", Qty: 4, Price: 0.27},
+			{SKU: "pear", Qty: 2, Price: 0.405},
+		},
+	}, orderOpts()...)).Return(nil)
+}
+
+// TestCmp_UnexportedFields verifies that comparing structs with unexported fields and
+// no option for them reports an error instead of panicking.
+//
+// Key Requirements Met:
+//  1. Safety: go-cmp's panic is turned into a match error.
+//  2. Opt-In: cmp.AllowUnexported makes the same comparison succeed.
+func TestCmp_UnexportedFields(t *testing.T) {
+	t.Parallel()
+
+	type secret struct{ value int }
+
+	_, err := cmpmatch.Cmp(secret{value: 1}).Match(secret{value: 1})
+	if err == nil || !strings.Contains(err.Error(), "cmp cannot compare values") {
+		t.Fatalf("expected an unexported field error, got %v", err)
+	}
+
+	matched, err := cmpmatch.Cmp(secret{value: 1}, cmp.AllowUnexported(secret{})).Match(secret{value: 1})
+	if err != nil || !matched {
+		t.Fatalf("expected AllowUnexported to compare the values, got (%v, %v)", matched, err)
+	}
+}
+
+// TestSetEquality_ArgsAndReturns demonstrates making Cmp the comparison behind
+// ArgsEqual and ReturnsEqual for a whole test.
+//
+// Key Requirements Met:
+//  1. Test-Wide Default: SetEquality applies to every ArgsEqual and ReturnsEqual in t.
+//  2. Options: the configured go-cmp options apply to plain expected values.
+func TestSetEquality_ArgsAndReturns(t *testing.T) {
+	t.Parallel()
+
+	imptest.SetEquality(t, cmpmatch.CmpEquality(orderOpts()...))
+
+	mock, expect := MockRepository(t)
+	want := wantOrder("o-2", 5)
+
+	call := StartPlaceOrder(t, cmpmatching.PlaceOrder, mock, "o-2", map[string]int{"pear": 5}, 0)
+
+	expect.Save.ArgsEqual(want).Return(nil)
+	call.ReturnsEqual(want, nil)
+}
+
+// TestSetEquality_Failure demonstrates that ArgsEqual reports the configured
+// comparison's diff on mismatch.
+//
+// Key Requirements Met:
+//  1. Readable Failures: the go-cmp diff replaces the default "expected X, got Y".
+func TestSetEquality_Failure(t *testing.T) {
+	t.Parallel()
+
+	reporter := failing.NewReporter()
+	imptest.SetEquality(reporter, cmpmatch.CmpEquality(orderOpts()...))
+
+	mock, expect := MockRepository(reporter)
+
+	go func() { _, _ = cmpmatching.PlaceOrder(mock, "o-3", map[string]int{"pear": 1}, 0) }()
+
+	go func() { expect.Save.ArgsEqual(wantOrder("o-4", 1)).Return(nil) }()
+
+	reporter.AssertFailure(t, "arg 0: mismatch (-want +got):")
+}
+
+// orderOpts ignores creation times, line order, and float rounding in prices.
+func orderOpts() []cmp.Option {
+	return []cmp.Option{
+		cmpopts.IgnoreFields(cmpmatching.Order{}, "Created"),
+		cmpopts.SortSlices(func(a, b cmpmatching.Line) bool { return a.SKU < b.SKU }),
+		cmpopts.EquateApprox(0, 1e-9),
+	}
+}
+
+// wantOrder is the expected order for qty pears at list price.
+func wantOrder(id string, qty int) cmpmatching.Order {
+	return cmpmatching.Order{ID: id, Lines: []cmpmatching.Line{{SKU: "pear", Qty: qty, Price: 0.45}}}
+}
@@ -0,0 +1,118 @@
+// Code generated by impgen. DO NOT EDIT.
+// impgen:hash:7192c1aa6ddb790a
+
+package cmpmatching_test
+
+import (
+	_imptest "github.com/toejough/imptest"
+	cmpmatching "github.com/toejough/imptest/UAT/variations/behavior/cmp-matching"
+	_reflect "reflect"
+	_time "time"
+)
+
+type RepositoryImp struct {
+	Save *RepositoryMockSaveMethod
+	// Eventually provides async versions of all methods for concurrent code.
+	Eventually *RepositoryImpEventually
+}
+
+type RepositoryImpEventually struct {
+	Save *RepositoryMockSaveMethod
+}
+
+type RepositoryMockSaveArgs struct {
+	Order cmpmatching.Order
+}
+
+type RepositoryMockSaveCall struct {
+	*_imptest.DependencyCall
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *RepositoryMockSaveCall) GetArgs() RepositoryMockSaveArgs {
+	raw := c.RawArgs()
+	return RepositoryMockSaveArgs{
+		Order: raw[0].(cmpmatching.Order),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *RepositoryMockSaveCall) Return(result0 error) {
+	c.DependencyCall.Return(result0)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *RepositoryMockSaveCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
+type RepositoryMockSaveMethod struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *RepositoryMockSaveMethod) ArgsEqual(order cmpmatching.Order) *RepositoryMockSaveCall {
+	call := m.DependencyMethod.ArgsEqual(order)
+	return &RepositoryMockSaveCall{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *RepositoryMockSaveMethod) ArgsShould(order any) *RepositoryMockSaveCall {
+	_call := m.DependencyMethod.ArgsShould(order)
+	return &RepositoryMockSaveCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *RepositoryMockSaveMethod) ArgsWhere(predicate func(RepositoryMockSaveArgs) error) *RepositoryMockSaveCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args RepositoryMockSaveArgs
+		args.Order, _ = raw[0].(cmpmatching.Order)
+		return predicate(args)
+	})
+	return &RepositoryMockSaveCall{DependencyCall: call}
+}
+
+// MockRepository creates a mock Repository and returns (mock, expectation handle).
+func MockRepository(t _imptest.TestReporter) (cmpmatching.Repository, *RepositoryImp) {
+	ctrl := _imptest.GetOrCreateImp(t)
+	imp := &RepositoryImp{
+		Save: newRepositoryMockSaveMethod(_imptest.NewDependencyMethod(ctrl, "Save").Results(_reflect.TypeFor[error]())),
+	}
+	imp.Eventually = &RepositoryImpEventually{
+		Save: newRepositoryMockSaveMethod(_imptest.NewDependencyMethod(ctrl, "Save").Results(_reflect.TypeFor[error]()).AsEventually()),
+	}
+	mock := &mockRepositoryImpl{ctrl: ctrl}
+	return mock, imp
+}
+
+type mockRepositoryImpl struct {
+	ctrl *_imptest.Imp
+}
+
+// Save implements cmpmatching.Repository.Save.
+func (impl *mockRepositoryImpl) Save(order cmpmatching.Order) error {
+	call := &_imptest.GenericCall{
+		MethodName:   "Save",
+		Args:         []any{order},
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 error
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(error); ok {
+			result1 = value
+		}
+	}
+
+	return result1
+}
+
+// newRepositoryMockSaveMethod creates a typed method wrapper.
+func newRepositoryMockSaveMethod(dm *_imptest.DependencyMethod) *RepositoryMockSaveMethod {
+	return &RepositoryMockSaveMethod{DependencyMethod: dm}
+}
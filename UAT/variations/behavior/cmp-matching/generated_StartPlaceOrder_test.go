@@ -0,0 +1,159 @@
+// Code generated by impgen. DO NOT EDIT.
+// impgen:hash:e4f1586720633675
+
+package cmpmatching_test
+
+import (
+	_imptest "github.com/toejough/imptest"
+	cmpmatching "github.com/toejough/imptest/UAT/variations/behavior/cmp-matching"
+)
+
+type StartPlaceOrderCallHandle struct {
+	*_imptest.CallableController[StartPlaceOrderReturnsReturn]
+	controller        *_imptest.TargetController
+	pendingCompletion *_imptest.PendingCompletion
+	// Eventually is the async version of this call handle for registering non-blocking expectations.
+	Eventually *StartPlaceOrderCallHandleEventually
+}
+
+// GoexitedShould verifies the function exited via runtime.Goexit (e.g. t.FailNow) without
+// returning or panicking. The matcher is applied to the goroutine's stack trace as a string.
+func (h *StartPlaceOrderCallHandle) GoexitedShould(matcher any) {
+	h.T.Helper()
+	h.WaitForResponse()
+
+	if h.Goexited {
+		ok, msg := _imptest.MatchValue(string(h.GoexitStack), matcher)
+		if !ok {
+			h.T.Fatalf("goexit stack: %s", msg)
+		}
+		return
+	}
+
+	h.T.Fatalf("expected function to exit via runtime.Goexit, but %s", h.Outcome())
+}
+
+// PanicEquals verifies the function panics with the expected value.
+func (h *StartPlaceOrderCallHandle) PanicEquals(expected any) {
+	h.T.Helper()
+	h.WaitForResponse()
+
+	if h.Panicked != nil {
+		ok, msg := _imptest.MatchValue(h.Panicked, expected)
+		if !ok {
+			h.T.Fatalf("panic value: %s", msg)
+		}
+		return
+	}
+
+	h.T.Fatalf("expected function to panic, but %s", h.Outcome())
+}
+
+// PanicShould verifies the function panics with a value matching the given matcher.
+func (h *StartPlaceOrderCallHandle) PanicShould(matcher any) {
+	h.T.Helper()
+	h.WaitForResponse()
+
+	if h.Panicked != nil {
+		ok, msg := _imptest.MatchPanic(h.Panicked, h.PanicStack, matcher)
+		if !ok {
+			h.T.Fatalf("panic value: %s", msg)
+		}
+		return
+	}
+
+	h.T.Fatalf("expected function to panic, but %s", h.Outcome())
+}
+
+// ReturnsEqual verifies the function returned the expected values.
+func (h *StartPlaceOrderCallHandle) ReturnsEqual(v0 cmpmatching.Order, v1 error) {
+	h.T.Helper()
+	h.WaitForResponse()
+
+	if h.Returned != nil {
+		if ok, msg := _imptest.EqualValue(h.T, h.Returned.Result0, v0); !ok {
+			h.T.Fatalf("return value 0: %s", msg)
+		}
+		if ok, msg := _imptest.EqualValue(h.T, h.Returned.Result1, v1); !ok {
+			h.T.Fatalf("return value 1: %s", msg)
+		}
+		return
+	}
+
+	h.T.Fatalf("expected function to return, but %s", h.Outcome())
+}
+
+// ReturnsShould verifies the return values match the given matchers.
+func (h *StartPlaceOrderCallHandle) ReturnsShould(v0 any, v1 any) {
+	h.T.Helper()
+	h.WaitForResponse()
+
+	if h.Returned != nil {
+		var ok bool
+		var msg string
+		ok, msg = _imptest.MatchValue(h.Returned.Result0, v0)
+		if !ok {
+			h.T.Fatalf("return value 0: %s", msg)
+		}
+		ok, msg = _imptest.MatchValue(h.Returned.Result1, v1)
+		if !ok {
+			h.T.Fatalf("return value 1: %s", msg)
+		}
+		return
+	}
+
+	h.T.Fatalf("expected function to return, but %s", h.Outcome())
+}
+
+type StartPlaceOrderCallHandleEventually struct {
+	h *StartPlaceOrderCallHandle
+}
+
+// PanicEquals registers an async expectation for a panic value.
+func (e *StartPlaceOrderCallHandleEventually) PanicEquals(value any) {
+	e.ensureStarted().ExpectPanic(value)
+}
+
+// ReturnsEqual registers an async expectation for return values.
+func (e *StartPlaceOrderCallHandleEventually) ReturnsEqual(values ...any) {
+	e.ensureStarted().ExpectReturn(values...)
+}
+
+func (e *StartPlaceOrderCallHandleEventually) ensureStarted() *_imptest.PendingCompletion {
+	if e.h.pendingCompletion == nil {
+		e.h.pendingCompletion = e.h.controller.RegisterPendingCompletion()
+		go func() {
+			e.h.WaitForResponse()
+			e.h.pendingCompletion.SetCompleted(e.h.Completion())
+		}()
+	}
+	return e.h.pendingCompletion
+}
+
+type StartPlaceOrderReturnsReturn struct {
+	Result0 cmpmatching.Order
+	Result1 error
+}
+
+// StartPlaceOrder starts the wrapped function in a goroutine for testing.
+func StartPlaceOrder(t _imptest.TestReporter, fn func(cmpmatching.Repository, string, map[string]int, float64) (cmpmatching.Order, error), repo cmpmatching.Repository, id string, cart map[string]int, discount float64) *StartPlaceOrderCallHandle {
+	handle := &StartPlaceOrderCallHandle{
+		CallableController: _imptest.NewCallableController[StartPlaceOrderReturnsReturn](t),
+		controller:         _imptest.NewTargetController(t),
+	}
+	handle.Eventually = &StartPlaceOrderCallHandleEventually{h: handle}
+	go func() {
+		_completed := false
+		defer func() {
+			if r := recover(); r != nil {
+				handle.RecordPanic(r)
+			} else if !_completed {
+				handle.RecordGoexit()
+			}
+		}()
+		ret0, ret1 := fn(repo, id, cart, discount)
+		_completed = true
+		handle.ReturnChan <- StartPlaceOrderReturnsReturn{Result0: ret0, Result1: ret1}
+	}()
+	return handle
+}
@@ -0,0 +1,49 @@
+// Package cmpmatching demonstrates comparing values with go-cmp options and diffs.
+package cmpmatching
+
+import "time"
+
+// Line is one priced item in an order.
+type Line struct {
+	SKU   string
+	Qty   int
+	Price float64
+}
+
+// Order is a placed order.
+type Order struct {
+	ID      string
+	Lines   []Line
+	Created time.Time
+}
+
+// Repository persists orders.
+type Repository interface {
+	Save(order Order) error
+}
+
+// PlaceOrder prices cart with the given discount, stamps the order, and saves it.
+// Lines come out in map iteration order, and discounted prices are not exact decimals.
+func PlaceOrder(repo Repository, id string, cart map[string]int, discount float64) (Order, error) {
+	order := Order{ID: id, Created: time.Now()}
+
+	for sku, qty := range cart {
+		order.Lines = append(order.Lines, Line{SKU: sku, Qty: qty, Price: unitPrice(sku) * (1 - discount)})
+	}
+
+	err := repo.Save(order)
+
+	return order, err
+}
+
+// unitPrice returns the list price of sku.
+func unitPrice(sku string) float64 {
+	switch sku {
+	case "apple":
+		return 0.3
+	case "pear":
+		return 0.45
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,12 @@
+// Package notify demonstrates generating mocks from user-supplied templates.
+package notify
+
+// Mailer sends email.
+type Mailer interface {
+	Send(to, subject string) error
+}
+
+// Welcome greets a new user by email.
+func Welcome(mailer Mailer, user string) error {
+	return mailer.Send(user, "Welcome, "+user+"!")
+}
@@ -0,0 +1,76 @@
+package notify_test
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	notify "github.com/toejough/imptest/UAT/variations/behavior/custom-templates"
+)
+
+// The templates directory overrides depImplMethod, so every mock method also reports its calls to traceMockCall.
+//go:generate impgen notify.Mailer --dependency --templates templates
+
+// TestCustomTemplates_TracingHook demonstrates mocks generated from an overridden template.
+//
+// Key Requirements Met:
+//  1. --templates replaces a built-in template by name (depImplMethod.tmpl), with the same data.
+//  2. The override adds a house-style hook without forking the generator: each mock call is traced.
+func TestCustomTemplates_TracingHook(t *testing.T) {
+	t.Parallel()
+
+	mock, imp := MockMailer(t)
+
+	errChan := make(chan error, 1)
+
+	go func() {
+		errChan <- notify.Welcome(mock, "ada")
+	}()
+
+	imp.Send.ArgsEqual("ada", "Welcome, ada!").Return(nil)
+
+	err := <-errChan
+	if err != nil {
+		t.Fatalf("Welcome() error = %v", err)
+	}
+
+	want := []tracedCall{{iface: "notify.Mailer", method: "Send", args: []any{"ada", "Welcome, ada!"}}}
+	if got := traced.calls(); !reflect.DeepEqual(got, want) {
+		t.Errorf("traced calls = %v, want %v", got, want)
+	}
+}
+
+// unexported variables.
+var (
+	traced = &tracer{} // the generated mocks report to this through traceMockCall
+)
+
+type tracedCall struct {
+	iface  string
+	method string
+	args   []any
+}
+
+type tracer struct {
+	mu     sync.Mutex
+	traced []tracedCall
+}
+
+func (tr *tracer) calls() []tracedCall {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+
+	return append([]tracedCall(nil), tr.traced...)
+}
+
+func (tr *tracer) record(call tracedCall) {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+
+	tr.traced = append(tr.traced, call)
+}
+
+// traceMockCall is the hook the overridden depImplMethod template calls before each mock call.
+func traceMockCall(iface, method string, args []any) {
+	traced.record(tracedCall{iface: iface, method: method, args: args})
+}
@@ -6,6 +6,8 @@ package embedded_test
 import (
 	_imptest "github.com/toejough/imptest"
 	embedded "github.com/toejough/imptest/UAT/variations/behavior/embedded-interfaces"
+	_reflect "reflect"
+	_time "time"
 )
 
 type ReadCloserImp struct {
@@ -29,6 +31,11 @@ func (c *ReadCloserMockCloseCall) Return(result0 error) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *ReadCloserMockCloseCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type ReadCloserMockReadArgs struct {
 	P []byte
 }
@@ -37,6 +44,12 @@ type ReadCloserMockReadCall struct {
 	*_imptest.DependencyCall
 }
 
+// FillP copies values into the caller's P slice before the mock returns.
+func (c *ReadCloserMockReadCall) FillP(values []byte) *ReadCloserMockReadCall {
+	c.FillArg(0, values)
+	return c
+}
+
 // GetArgs returns the typed arguments for this call.
 func (c *ReadCloserMockReadCall) GetArgs() ReadCloserMockReadArgs {
 	raw := c.RawArgs()
@@ -50,6 +63,11 @@ func (c *ReadCloserMockReadCall) Return(result0 int, result1 error) {
 	c.DependencyCall.Return(result0, result1)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *ReadCloserMockReadCall) ReturnAfter(d _time.Duration, result0 int, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
 type ReadCloserMockReadMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -60,9 +78,21 @@ func (m *ReadCloserMockReadMethod) ArgsEqual(p []byte) *ReadCloserMockReadCall {
 	return &ReadCloserMockReadCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *ReadCloserMockReadMethod) ArgsShould(matchers ...any) *ReadCloserMockReadCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *ReadCloserMockReadMethod) ArgsShould(p any) *ReadCloserMockReadCall {
+	_call := m.DependencyMethod.ArgsShould(p)
+	return &ReadCloserMockReadCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *ReadCloserMockReadMethod) ArgsWhere(predicate func(ReadCloserMockReadArgs) error) *ReadCloserMockReadCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args ReadCloserMockReadArgs
+		args.P, _ = raw[0].([]byte)
+		return predicate(args)
+	})
 	return &ReadCloserMockReadCall{DependencyCall: call}
 }
 
@@ -70,12 +100,12 @@ func (m *ReadCloserMockReadMethod) ArgsShould(matchers ...any) *ReadCloserMockRe
 func MockReadCloser(t _imptest.TestReporter) (embedded.ReadCloser, *ReadCloserImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &ReadCloserImp{
-		Read:  newReadCloserMockReadMethod(_imptest.NewDependencyMethod(ctrl, "Read")),
-		Close: _imptest.NewDependencyMethod(ctrl, "Close"),
+		Read:  newReadCloserMockReadMethod(_imptest.NewDependencyMethod(ctrl, "Read").Results(_reflect.TypeFor[int](), _reflect.TypeFor[error]())),
+		Close: _imptest.NewDependencyMethod(ctrl, "Close").Results(_reflect.TypeFor[error]()),
 	}
 	imp.Eventually = &ReadCloserImpEventually{
-		Read:  newReadCloserMockReadMethod(_imptest.NewDependencyMethod(ctrl, "Read").AsEventually()),
-		Close: _imptest.NewDependencyMethod(ctrl, "Close").AsEventually(),
+		Read:  newReadCloserMockReadMethod(_imptest.NewDependencyMethod(ctrl, "Read").Results(_reflect.TypeFor[int](), _reflect.TypeFor[error]()).AsEventually()),
+		Close: _imptest.NewDependencyMethod(ctrl, "Close").Results(_reflect.TypeFor[error]()).AsEventually(),
 	}
 	mock := &mockReadCloserImpl{ctrl: ctrl}
 	return mock, imp
@@ -94,9 +124,7 @@ func (impl *mockReadCloserImpl) Close() error {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 error
 	if len(resp.ReturnValues) > 0 {
@@ -117,9 +145,7 @@ func (impl *mockReadCloserImpl) Read(p []byte) (int, error) {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 int
 	if len(resp.ReturnValues) > 0 {
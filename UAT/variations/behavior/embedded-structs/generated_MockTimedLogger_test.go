@@ -5,6 +5,8 @@ package embeddedstructs_test
 
 import (
 	_imptest "github.com/toejough/imptest"
+	_reflect "reflect"
+	_time "time"
 )
 
 type TimedLoggerImp struct {
@@ -34,6 +36,11 @@ func (c *TimedLoggerMockIncCall) Return(result0 int) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *TimedLoggerMockIncCall) ReturnAfter(d _time.Duration, result0 int) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type TimedLoggerMockInterface interface {
 	Inc() int
 	Log(msg string) string
@@ -63,6 +70,11 @@ func (c *TimedLoggerMockLogCall) Return(result0 string) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *TimedLoggerMockLogCall) ReturnAfter(d _time.Duration, result0 string) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type TimedLoggerMockLogMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -73,9 +85,21 @@ func (m *TimedLoggerMockLogMethod) ArgsEqual(msg string) *TimedLoggerMockLogCall
 	return &TimedLoggerMockLogCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *TimedLoggerMockLogMethod) ArgsShould(matchers ...any) *TimedLoggerMockLogCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *TimedLoggerMockLogMethod) ArgsShould(msg any) *TimedLoggerMockLogCall {
+	_call := m.DependencyMethod.ArgsShould(msg)
+	return &TimedLoggerMockLogCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *TimedLoggerMockLogMethod) ArgsWhere(predicate func(TimedLoggerMockLogArgs) error) *TimedLoggerMockLogCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args TimedLoggerMockLogArgs
+		args.Msg, _ = raw[0].(string)
+		return predicate(args)
+	})
 	return &TimedLoggerMockLogCall{DependencyCall: call}
 }
 
@@ -100,6 +124,11 @@ func (c *TimedLoggerMockLogWithCountCall) Return(result0 string) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *TimedLoggerMockLogWithCountCall) ReturnAfter(d _time.Duration, result0 string) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type TimedLoggerMockLogWithCountMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -110,9 +139,21 @@ func (m *TimedLoggerMockLogWithCountMethod) ArgsEqual(msg string) *TimedLoggerMo
 	return &TimedLoggerMockLogWithCountCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *TimedLoggerMockLogWithCountMethod) ArgsShould(matchers ...any) *TimedLoggerMockLogWithCountCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *TimedLoggerMockLogWithCountMethod) ArgsShould(msg any) *TimedLoggerMockLogWithCountCall {
+	_call := m.DependencyMethod.ArgsShould(msg)
+	return &TimedLoggerMockLogWithCountCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *TimedLoggerMockLogWithCountMethod) ArgsWhere(predicate func(TimedLoggerMockLogWithCountArgs) error) *TimedLoggerMockLogWithCountCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args TimedLoggerMockLogWithCountArgs
+		args.Msg, _ = raw[0].(string)
+		return predicate(args)
+	})
 	return &TimedLoggerMockLogWithCountCall{DependencyCall: call}
 }
 
@@ -142,9 +183,21 @@ func (m *TimedLoggerMockSetPrefixMethod) ArgsEqual(prefix string) *TimedLoggerMo
 	return &TimedLoggerMockSetPrefixCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *TimedLoggerMockSetPrefixMethod) ArgsShould(matchers ...any) *TimedLoggerMockSetPrefixCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *TimedLoggerMockSetPrefixMethod) ArgsShould(prefix any) *TimedLoggerMockSetPrefixCall {
+	_call := m.DependencyMethod.ArgsShould(prefix)
+	return &TimedLoggerMockSetPrefixCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *TimedLoggerMockSetPrefixMethod) ArgsWhere(predicate func(TimedLoggerMockSetPrefixArgs) error) *TimedLoggerMockSetPrefixCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args TimedLoggerMockSetPrefixArgs
+		args.Prefix, _ = raw[0].(string)
+		return predicate(args)
+	})
 	return &TimedLoggerMockSetPrefixCall{DependencyCall: call}
 }
 
@@ -157,22 +210,27 @@ func (c *TimedLoggerMockValueCall) Return(result0 int) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *TimedLoggerMockValueCall) ReturnAfter(d _time.Duration, result0 int) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 // MockTimedLogger creates a mock TimedLogger and returns (mock, expectation handle).
 func MockTimedLogger(t _imptest.TestReporter) (TimedLoggerMockInterface, *TimedLoggerImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &TimedLoggerImp{
-		Inc:          _imptest.NewDependencyMethod(ctrl, "Inc"),
-		Log:          newTimedLoggerMockLogMethod(_imptest.NewDependencyMethod(ctrl, "Log")),
-		LogWithCount: newTimedLoggerMockLogWithCountMethod(_imptest.NewDependencyMethod(ctrl, "LogWithCount")),
-		SetPrefix:    newTimedLoggerMockSetPrefixMethod(_imptest.NewDependencyMethod(ctrl, "SetPrefix")),
-		Value:        _imptest.NewDependencyMethod(ctrl, "Value"),
+		Inc:          _imptest.NewDependencyMethod(ctrl, "Inc").Results(_reflect.TypeFor[int]()),
+		Log:          newTimedLoggerMockLogMethod(_imptest.NewDependencyMethod(ctrl, "Log").Results(_reflect.TypeFor[string]())),
+		LogWithCount: newTimedLoggerMockLogWithCountMethod(_imptest.NewDependencyMethod(ctrl, "LogWithCount").Results(_reflect.TypeFor[string]())),
+		SetPrefix:    newTimedLoggerMockSetPrefixMethod(_imptest.NewDependencyMethod(ctrl, "SetPrefix").Results()),
+		Value:        _imptest.NewDependencyMethod(ctrl, "Value").Results(_reflect.TypeFor[int]()),
 	}
 	imp.Eventually = &TimedLoggerImpEventually{
-		Inc:          _imptest.NewDependencyMethod(ctrl, "Inc").AsEventually(),
-		Log:          newTimedLoggerMockLogMethod(_imptest.NewDependencyMethod(ctrl, "Log").AsEventually()),
-		LogWithCount: newTimedLoggerMockLogWithCountMethod(_imptest.NewDependencyMethod(ctrl, "LogWithCount").AsEventually()),
-		SetPrefix:    newTimedLoggerMockSetPrefixMethod(_imptest.NewDependencyMethod(ctrl, "SetPrefix").AsEventually()),
-		Value:        _imptest.NewDependencyMethod(ctrl, "Value").AsEventually(),
+		Inc:          _imptest.NewDependencyMethod(ctrl, "Inc").Results(_reflect.TypeFor[int]()).AsEventually(),
+		Log:          newTimedLoggerMockLogMethod(_imptest.NewDependencyMethod(ctrl, "Log").Results(_reflect.TypeFor[string]()).AsEventually()),
+		LogWithCount: newTimedLoggerMockLogWithCountMethod(_imptest.NewDependencyMethod(ctrl, "LogWithCount").Results(_reflect.TypeFor[string]()).AsEventually()),
+		SetPrefix:    newTimedLoggerMockSetPrefixMethod(_imptest.NewDependencyMethod(ctrl, "SetPrefix").Results().AsEventually()),
+		Value:        _imptest.NewDependencyMethod(ctrl, "Value").Results(_reflect.TypeFor[int]()).AsEventually(),
 	}
 	mock := &mockTimedLoggerImpl{ctrl: ctrl}
 	return mock, imp
@@ -191,9 +249,7 @@ func (impl *mockTimedLoggerImpl) Inc() int {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 int
 	if len(resp.ReturnValues) > 0 {
@@ -214,9 +270,7 @@ func (impl *mockTimedLoggerImpl) Log(msg string) string {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 string
 	if len(resp.ReturnValues) > 0 {
@@ -237,9 +291,7 @@ func (impl *mockTimedLoggerImpl) LogWithCount(msg string) string {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 string
 	if len(resp.ReturnValues) > 0 {
@@ -260,9 +312,7 @@ func (impl *mockTimedLoggerImpl) SetPrefix(prefix string) {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 }
 
@@ -275,9 +325,7 @@ func (impl *mockTimedLoggerImpl) Value() int {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 int
 	if len(resp.ReturnValues) > 0 {
@@ -0,0 +1,90 @@
+package goexit_test
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/toejough/imptest/UAT/internal/failing"
+	goexit "github.com/toejough/imptest/UAT/variations/behavior/goexit-detection"
+	"github.com/toejough/imptest/match"
+)
+
+//go:generate impgen goexit.MustPositive --target
+//go:generate impgen goexit.Remaining --target
+
+// TestGoexit_GoexitedShould demonstrates asserting that a target exits via
+// runtime.Goexit instead of hanging the test.
+//
+// Key Requirements Met:
+//  1. Detection: a target that neither returns nor panics is reported as Goexited.
+//  2. Stack Access: the matcher receives the exiting goroutine's stack trace.
+func TestGoexit_GoexitedShould(t *testing.T) {
+	t.Parallel()
+
+	call := StartMustPositive(t, goexit.MustPositive, goexitFailer{}, -1)
+
+	// Requirement: the exit came from MustPositive's failure path.
+	call.GoexitedShould(match.Satisfy(func(stack string) error {
+		if !strings.Contains(stack, "MustPositive(") {
+			return fmt.Errorf("stack does not include MustPositive:\n%s", stack)
+		}
+
+		return nil
+	}))
+}
+
+// TestGoexit_UnexpectedExitFails demonstrates the failure message when a target
+// expected to return calls t.FailNow instead.
+//
+// Key Requirements Met:
+//  1. Clear Failure: ReturnsEqual reports the Goexit rather than blocking forever.
+func TestGoexit_UnexpectedExitFails(t *testing.T) {
+	t.Parallel()
+
+	reporter := failing.NewReporter()
+
+	call := StartMustPositive(reporter, goexit.MustPositive, goexitFailer{}, -1)
+
+	go call.ReturnsEqual(-1)
+
+	reporter.AssertFailure(t, "expected function to return, but it exited via runtime.Goexit")
+}
+
+// TestGoexit_ReturnIsNotGoexit verifies that a normal return fails GoexitedShould.
+//
+// Key Requirements Met:
+//  1. Distinct Outcome: returning is not mistaken for a Goexit.
+func TestGoexit_ReturnIsNotGoexit(t *testing.T) {
+	t.Parallel()
+
+	reporter := failing.NewReporter()
+
+	call := StartMustPositive(reporter, goexit.MustPositive, goexitFailer{}, 3)
+
+	go call.GoexitedShould(match.BeAny)
+
+	want := "expected function to exit via runtime.Goexit, but it returned"
+	if msg := reporter.Wait(t); msg != want {
+		t.Errorf("expected failure %q, got %q", want, msg)
+	}
+}
+
+// goexitFailer fails like testing.T.FailNow: by exiting the calling goroutine.
+type goexitFailer struct{}
+
+func (goexitFailer) FailNow() {
+	runtime.Goexit()
+}
+
+// TestGoexit_ParamNamedCompleted demonstrates that the wrapper's goexit bookkeeping
+// doesn't collide with a target parameter of the same name.
+//
+// Key Requirements Met:
+//  1. No Shadowing: the target receives its own "completed" argument.
+func TestGoexit_ParamNamedCompleted(t *testing.T) {
+	t.Parallel()
+
+	StartRemaining(t, goexit.Remaining, 5, 2).ReturnsEqual(3)
+}
@@ -0,0 +1,22 @@
+// Package goexit demonstrates detecting targets that exit their goroutine via
+// runtime.Goexit, as t.FailNow does, instead of returning or panicking.
+package goexit
+
+type Failer interface {
+	FailNow()
+}
+
+// MustPositive returns n, failing via f if n is negative.
+func MustPositive(f Failer, n int) int {
+	if n < 0 {
+		f.FailNow()
+	}
+
+	return n
+}
+
+// Remaining returns how many of total tasks are left once completed are done.
+// Its parameter shares a name with the wrappers' goexit bookkeeping.
+func Remaining(total, completed int) int {
+	return total - completed
+}
@@ -0,0 +1,134 @@
+// Code generated by impgen. DO NOT EDIT.
+// impgen:hash:e899e1d93ba1af79
+
+package golden_test
+
+import (
+	_imptest "github.com/toejough/imptest"
+	golden "github.com/toejough/imptest/UAT/variations/behavior/golden-files"
+	_reflect "reflect"
+	_time "time"
+)
+
+type DBImp struct {
+	Exec *DBMockExecMethod
+	// Eventually provides async versions of all methods for concurrent code.
+	Eventually *DBImpEventually
+}
+
+type DBImpEventually struct {
+	Exec *DBMockExecMethod
+}
+
+type DBMockExecArgs struct {
+	Query string
+	Args  []any
+}
+
+type DBMockExecCall struct {
+	*_imptest.DependencyCall
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *DBMockExecCall) GetArgs() DBMockExecArgs {
+	raw := c.RawArgs()
+	return DBMockExecArgs{
+		Query: raw[0].(string),
+		Args:  raw[1].([]any),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *DBMockExecCall) Return(result0 error) {
+	c.DependencyCall.Return(result0)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *DBMockExecCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
+type DBMockExecMethod struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *DBMockExecMethod) ArgsEqual(query string, args ...any) *DBMockExecCall {
+	callArgs := []any{query}
+	for _, v := range args {
+		callArgs = append(callArgs, v)
+	}
+	call := m.DependencyMethod.ArgsEqual(callArgs...)
+	return &DBMockExecCall{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *DBMockExecMethod) ArgsShould(query any, args ...any) *DBMockExecCall {
+	_matchers := []any{query}
+	_matchers = append(_matchers, args...)
+	_call := m.DependencyMethod.ArgsShould(_matchers...)
+	return &DBMockExecCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *DBMockExecMethod) ArgsWhere(predicate func(DBMockExecArgs) error) *DBMockExecCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args DBMockExecArgs
+		args.Query, _ = raw[0].(string)
+		for _, value := range raw[1:] {
+			elem, _ := value.(any)
+			args.Args = append(args.Args, elem)
+		}
+		return predicate(args)
+	})
+	return &DBMockExecCall{DependencyCall: call}
+}
+
+// MockDB creates a mock DB and returns (mock, expectation handle).
+func MockDB(t _imptest.TestReporter) (golden.DB, *DBImp) {
+	ctrl := _imptest.GetOrCreateImp(t)
+	imp := &DBImp{
+		Exec: newDBMockExecMethod(_imptest.NewDependencyMethod(ctrl, "Exec").Results(_reflect.TypeFor[error]())),
+	}
+	imp.Eventually = &DBImpEventually{
+		Exec: newDBMockExecMethod(_imptest.NewDependencyMethod(ctrl, "Exec").Results(_reflect.TypeFor[error]()).AsEventually()),
+	}
+	mock := &mockDBImpl{ctrl: ctrl}
+	return mock, imp
+}
+
+type mockDBImpl struct {
+	ctrl *_imptest.Imp
+}
+
+// Exec implements golden.DB.Exec.
+func (impl *mockDBImpl) Exec(query string, args ...any) error {
+	callArgs := []any{query}
+	for _, v := range args {
+		callArgs = append(callArgs, v)
+	}
+	call := &_imptest.GenericCall{
+		MethodName:   "Exec",
+		Args:         callArgs,
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 error
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(error); ok {
+			result1 = value
+		}
+	}
+
+	return result1
+}
+
+// newDBMockExecMethod creates a typed method wrapper.
+func newDBMockExecMethod(dm *_imptest.DependencyMethod) *DBMockExecMethod {
+	return &DBMockExecMethod{DependencyMethod: dm}
+}
@@ -0,0 +1,171 @@
+package golden_test
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/toejough/imptest"
+	"github.com/toejough/imptest/UAT/internal/failing"
+	golden "github.com/toejough/imptest/UAT/variations/behavior/golden-files"
+	. "github.com/toejough/imptest/match" //nolint:revive // Dot import for matcher DSL
+	"github.com/toejough/imptest/match/goldenmatch"
+)
+
+//go:generate impgen golden.DB --dependency
+//go:generate impgen golden.MonthlyReport --target
+
+// TestGolden_Args demonstrates comparing a large SQL argument with a golden file.
+//
+// Key Requirements Met:
+//  1. Large Payloads: the full query lives in testdata instead of the test.
+//  2. Composition: Golden sits alongside other matchers in ArgsShould.
+func TestGolden_Args(t *testing.T) {
+	t.Parallel()
+
+	mock, expect := MockDB(t)
+	call := StartMonthlyReport(t, golden.MonthlyReport, mock, 2024, 3, []string{"emea", "apac"})
+
+	expect.Exec.ArgsShould(goldenmatch.Golden("testdata/monthly_sales.sql.golden"), 2024, 3, "emea", "apac").Return(nil)
+	call.ReturnsShould(BeAny, BeNil())
+}
+
+// TestGolden_Mismatch demonstrates the diff reported when a value differs from its
+// golden file, and the hint for a missing file.
+//
+// Key Requirements Met:
+//  1. Readable Failures: mismatches are shown as a unified diff.
+//  2. Update Hint: the message names the environment variable that rewrites the file.
+func TestGolden_Mismatch(t *testing.T) {
+	t.Parallel()
+
+	reporter := failing.NewReporter()
+	mock, expect := MockDB(reporter)
+
+	go func() { _, _ = golden.MonthlyReport(mock, 2024, 3, nil) }()
+
+	go func() {
+		expect.Exec.ArgsShould(goldenmatch.Golden("testdata/monthly_sales.sql.golden"), BeAny, BeAny).Return(nil)
+	}()
+
+	reporter.AssertFailure(t, "arg 0: golden file testdata/monthly_sales.sql.golden differs; "+
+		"rerun with IMPTEST_UPDATE_GOLDEN=1 to update it")
+
+	matcher := goldenmatch.Golden("testdata/monthly_sales.sql.golden")
+
+	matched, err := matcher.Match("SELECT 1\n")
+	if err != nil || matched {
+		t.Fatalf("expected a mismatch, got (%v, %v)", matched, err)
+	}
+
+	if msg := matcher.FailureMessage(nil); !strings.Contains(msg, "+SELECT 1") {
+		t.Fatalf("expected a unified diff, got %q", msg)
+	}
+
+	missing := goldenmatch.Golden("testdata/missing.golden")
+
+	matched, err = missing.Match("x")
+	if err != nil || matched {
+		t.Fatalf("expected a missing file to fail, got (%v, %v)", matched, err)
+	}
+
+	if msg := missing.FailureMessage(nil); !strings.Contains(msg, "does not exist") {
+		t.Fatalf("expected a missing file message, got %q", msg)
+	}
+}
+
+// TestGolden_Panic demonstrates checking a panic message with a golden file.
+//
+// Key Requirements Met:
+//  1. PanicShould: Golden receives the panic value like any other matcher.
+func TestGolden_Panic(t *testing.T) {
+	t.Parallel()
+
+	mock, _ := MockDB(t)
+
+	call := StartMonthlyReport(t, golden.MonthlyReport, mock, 2024, 13, nil)
+	call.PanicShould(goldenmatch.Golden("testdata/bad_month.golden"))
+}
+
+// TestGolden_Returns demonstrates comparing a struct result with a golden file.
+//
+// Key Requirements Met:
+//  1. Serialization: non-string values are compared as indented JSON.
+func TestGolden_Returns(t *testing.T) {
+	t.Parallel()
+
+	mock, expect := MockDB(t)
+	call := StartMonthlyReport(t, golden.MonthlyReport, mock, 2024, 3, []string{"emea"})
+
+	expect.Exec.ArgsShould(BeAny, BeAny, BeAny, BeAny).Return(nil)
+	call.ReturnsShould(goldenmatch.Golden("testdata/summary.json.golden"), BeNil())
+}
+
+// TestGolden_Update demonstrates rewriting golden files from the actual values.
+//
+// Key Requirements Met:
+//  1. Update Mode: with IMPTEST_UPDATE_GOLDEN set, Golden matches and writes the file.
+//  2. Matched Calls Only: Match never writes; the file is written once the call matched.
+//  3. New Files: missing directories are created.
+//
+//nolint:paralleltest // t.Setenv is incompatible with t.Parallel
+func TestGolden_Update(t *testing.T) {
+	t.Setenv(goldenmatch.UpdateEnv, "1")
+
+	path := filepath.Join(t.TempDir(), "new", "value.golden")
+
+	matched, err := goldenmatch.Golden(path).Match(golden.Summary{Name: "unmatched"})
+	if err != nil || !matched {
+		t.Fatalf("expected update mode to match, got (%v, %v)", matched, err)
+	}
+
+	if _, err := os.Stat(path); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("expected Match alone not to write the golden file, got %v", err)
+	}
+
+	mock, expect := MockDB(t)
+	call := StartMonthlyReport(t, golden.MonthlyReport, mock, 2024, 3, nil)
+
+	expect.Exec.ArgsShould(BeAny, BeAny, BeAny).Return(nil)
+	call.ReturnsShould(goldenmatch.Golden(path), BeNil())
+
+	written, err := os.ReadFile(path)
+	if err != nil || !strings.Contains(string(written), `"name": "sales-2024-03"`) {
+		t.Fatalf("expected the golden file to be written, got %q (%v)", written, err)
+	}
+}
+
+// TestGolden_UpdateFailure demonstrates that a golden file that cannot be written fails
+// the test.
+//
+// Key Requirements Met:
+//  1. Reported Failures: the write error fails the test instead of panicking, even when
+//     the call was matched by an Eventually expectation on another goroutine.
+//  2. Unblocked Mocks: the matched call still receives its response.
+//
+//nolint:paralleltest // t.Setenv is incompatible with t.Parallel
+func TestGolden_UpdateFailure(t *testing.T) {
+	t.Setenv(goldenmatch.UpdateEnv, "1")
+
+	// A directory can't be overwritten with the golden text.
+	path := t.TempDir()
+	reporter := failing.NewReporter()
+	mock, expect := MockDB(reporter)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		_, _ = golden.MonthlyReport(mock, 2024, 3, nil)
+	}()
+
+	expect.Eventually.Exec.ArgsShould(goldenmatch.Golden(path), BeAny, BeAny).Return(nil)
+
+	go imptest.Wait(reporter)
+
+	reporter.AssertFailure(t, "Exec: arg 0: golden file: updating "+path)
+	<-done
+}
@@ -0,0 +1,54 @@
+// Package golden demonstrates comparing large arguments and results with golden files.
+package golden
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DB runs SQL statements.
+type DB interface {
+	Exec(query string, args ...any) error
+}
+
+// Summary describes a generated report.
+type Summary struct {
+	Name    string   `json:"name"`
+	Columns []string `json:"columns"`
+	Filters []string `json:"filters"`
+}
+
+// MonthlyReport refreshes the monthly sales table for year and month, and
+// describes the report it produced.
+func MonthlyReport(db DB, year, month int, regions []string) (Summary, error) {
+	if month < 1 || month > 12 {
+		panic(fmt.Sprintf("MonthlyReport: month %d out of range\nexpected 1 through 12", month))
+	}
+
+	columns := []string{"region", "total_cents", "order_count"}
+	filters := []string{"year = ?", "month = ?"}
+
+	if len(regions) > 0 {
+		filters = append(filters, "region IN ("+strings.TrimSuffix(strings.Repeat("?, ", len(regions)), ", ")+")")
+	}
+
+	query := fmt.Sprintf(`INSERT INTO monthly_sales (%s)
+SELECT region, SUM(amount_cents), COUNT(*)
+FROM orders
+WHERE %s
+GROUP BY region
+ORDER BY region
+`, strings.Join(columns, ", "), strings.Join(filters, "\n  AND "))
+
+	args := []any{year, month}
+	for _, region := range regions {
+		args = append(args, region)
+	}
+
+	err := db.Exec(query, args...)
+	if err != nil {
+		return Summary{}, err
+	}
+
+	return Summary{Name: fmt.Sprintf("sales-%04d-%02d", year, month), Columns: columns, Filters: filters}, nil
+}
@@ -0,0 +1,19 @@
+// Package combinators demonstrates composing matchers to describe mock arguments.
+package combinators
+
+// Backend serves forwarded requests.
+type Backend interface {
+	Serve(req Request) int
+}
+
+// Request is a simplified HTTP request.
+type Request struct {
+	Method string
+	Path   string
+	Body   []byte
+}
+
+// Forward sends a request to backend and returns its status code.
+func Forward(backend Backend, method, path string, body []byte) int {
+	return backend.Serve(Request{Method: method, Path: path, Body: body})
+}
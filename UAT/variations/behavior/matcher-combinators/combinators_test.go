@@ -0,0 +1,151 @@
+package combinators_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	"github.com/toejough/imptest/UAT/internal/failing"
+	combinators "github.com/toejough/imptest/UAT/variations/behavior/matcher-combinators"
+	. "github.com/toejough/imptest/match" //nolint:revive // Dot import for matcher DSL
+)
+
+//go:generate impgen combinators.Backend --dependency
+
+// TestCombinators_DescribeRequest demonstrates composing matchers to describe a request
+// whose path has prefix /api and whose body is non-empty, without a Satisfy closure.
+//
+// Key Requirements Met:
+//  1. Composition: And, AnyOf, and Not combine field matchers into one argument matcher.
+//  2. Plain Values: AnyOf accepts plain values alongside matchers.
+func TestCombinators_DescribeRequest(t *testing.T) {
+	t.Parallel()
+
+	mock, expect := MockBackend(t)
+
+	go combinators.Forward(mock, "POST", "/api/users", []byte(`{"name":"bob"}`))
+
+	expect.Serve.ArgsShould(And(
+		HaveField("Method", AnyOf("POST", "PUT")),
+		HaveField("Path", HavePrefix("/api")),
+		HaveField("Body", Not(HaveLen(0))),
+	)).Return(201)
+}
+
+// TestCombinators_GomegaMatchers demonstrates that combinators accept gomega matchers
+// through duck typing, and that Not uses their negated failure messages.
+//
+// Key Requirements Met:
+//  1. Interoperability: gomega matchers nest inside And, Or, and Not.
+//  2. Negated Messages: Not reports a gomega matcher's NegatedFailureMessage.
+func TestCombinators_GomegaMatchers(t *testing.T) {
+	t.Parallel()
+
+	mock, expect := MockBackend(t)
+
+	go combinators.Forward(mock, "GET", "/health", nil)
+
+	expect.Serve.ArgsShould(Or(
+		gomega.HaveField("Method", gomega.Equal("HEAD")),
+		And(gomega.HaveField("Method", gomega.Equal("GET")), gomega.HaveField("Body", gomega.BeEmpty())),
+	)).Return(200)
+
+	notEmpty := Not(gomega.BeEmpty())
+
+	matched, err := notEmpty.Match("")
+	if err != nil || matched {
+		t.Fatalf("expected Not(BeEmpty()) to reject an empty string, got (%v, %v)", matched, err)
+	}
+
+	if msg := notEmpty.FailureMessage(""); !strings.Contains(msg, "not to be empty") {
+		t.Fatalf("expected gomega's negated message, got %q", msg)
+	}
+}
+
+// TestCombinators_NestedFailure demonstrates how nested combinator failures read when a
+// mock argument does not match.
+//
+// Key Requirements Met:
+//  1. Readable Nesting: each level names the failing condition and indents its cause.
+func TestCombinators_NestedFailure(t *testing.T) {
+	t.Parallel()
+
+	reporter := failing.NewReporter()
+	mock, expect := MockBackend(reporter)
+
+	go combinators.Forward(mock, "DELETE", "/api/users/7", nil)
+
+	go func() {
+		expect.Serve.ArgsShould(And(
+			HaveField("Path", HavePrefix("/api")),
+			HaveField("Method", Or(Equal("GET"), Equal("POST"))),
+		)).Return(200)
+	}()
+
+	reporter.AssertFailure(t, `arg 0: failed condition 2 of 2 in And:
+  field Method: matched none of 2 conditions in Or:
+    - expected "DELETE" to equal "GET"
+    - expected "DELETE" to equal "POST"`)
+}
+
+// TestCombinators_Messages verifies each combinator's verdict and failure message.
+//
+// Key Requirements Met:
+//  1. Coverage: every combinator accepts and rejects the values it should.
+//  2. Descriptions: Not describes native matchers by their constructor call.
+//  3. Transforms: WithTransform reports the transformed value, and misuse is an error.
+func TestCombinators_Messages(t *testing.T) {
+	t.Parallel()
+
+	bodyLen := func(r combinators.Request) int { return len(r.Body) }
+
+	for _, tc := range []struct {
+		name    string
+		matcher Matcher
+		pass    any
+		fail    any
+		message string
+	}{
+		{
+			"AllOf", AllOf(HaveLen(2), []int{1, 2}), []int{1, 2}, []int{2, 1},
+			"failed condition 2 of 2 in AllOf:\n  expected []int{1, 2}, got []int{2, 1}",
+		},
+		{
+			"AnyOf", AnyOf(1, 2), 2, 3,
+			"matched none of 2 conditions in AnyOf:\n  - expected 1, got 3\n  - expected 2, got 3",
+		},
+		{
+			"Not", Not(HavePrefix("/internal")), "/api", "/internal/x",
+			`expected "/internal/x" not to satisfy HavePrefix("/internal")`,
+		},
+		{
+			"WithTransform", WithTransform(bodyLen, BeNumerically(">", 0)),
+			combinators.Request{Body: []byte("x")}, combinators.Request{},
+			"after transform: expected 0 to be > 0",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			matched, err := tc.matcher.Match(tc.pass)
+			if err != nil || !matched {
+				t.Fatalf("expected %#v to match, got (%v, %v)", tc.pass, matched, err)
+			}
+
+			matched, err = tc.matcher.Match(tc.fail)
+			if err != nil || matched {
+				t.Fatalf("expected %#v not to match, got (%v, %v)", tc.fail, matched, err)
+			}
+
+			if msg := tc.matcher.FailureMessage(tc.fail); !strings.Contains(msg, tc.message) {
+				t.Fatalf("expected failure message containing %q, got %q", tc.message, msg)
+			}
+		})
+	}
+
+	_, err := WithTransform(bodyLen, 1).Match("not a request")
+	if err == nil || !strings.Contains(err.Error(), "WithTransform expects combinators.Request, got string") {
+		t.Fatalf("expected a type mismatch error, got %v", err)
+	}
+}
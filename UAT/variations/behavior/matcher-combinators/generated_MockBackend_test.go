@@ -0,0 +1,118 @@
+// Code generated by impgen. DO NOT EDIT.
+// impgen:hash:362c245778646a3e
+
+package combinators_test
+
+import (
+	_imptest "github.com/toejough/imptest"
+	combinators "github.com/toejough/imptest/UAT/variations/behavior/matcher-combinators"
+	_reflect "reflect"
+	_time "time"
+)
+
+type BackendImp struct {
+	Serve *BackendMockServeMethod
+	// Eventually provides async versions of all methods for concurrent code.
+	Eventually *BackendImpEventually
+}
+
+type BackendImpEventually struct {
+	Serve *BackendMockServeMethod
+}
+
+type BackendMockServeArgs struct {
+	Req combinators.Request
+}
+
+type BackendMockServeCall struct {
+	*_imptest.DependencyCall
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *BackendMockServeCall) GetArgs() BackendMockServeArgs {
+	raw := c.RawArgs()
+	return BackendMockServeArgs{
+		Req: raw[0].(combinators.Request),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *BackendMockServeCall) Return(result0 int) {
+	c.DependencyCall.Return(result0)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *BackendMockServeCall) ReturnAfter(d _time.Duration, result0 int) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
+type BackendMockServeMethod struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *BackendMockServeMethod) ArgsEqual(req combinators.Request) *BackendMockServeCall {
+	call := m.DependencyMethod.ArgsEqual(req)
+	return &BackendMockServeCall{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *BackendMockServeMethod) ArgsShould(req any) *BackendMockServeCall {
+	_call := m.DependencyMethod.ArgsShould(req)
+	return &BackendMockServeCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *BackendMockServeMethod) ArgsWhere(predicate func(BackendMockServeArgs) error) *BackendMockServeCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args BackendMockServeArgs
+		args.Req, _ = raw[0].(combinators.Request)
+		return predicate(args)
+	})
+	return &BackendMockServeCall{DependencyCall: call}
+}
+
+// MockBackend creates a mock Backend and returns (mock, expectation handle).
+func MockBackend(t _imptest.TestReporter) (combinators.Backend, *BackendImp) {
+	ctrl := _imptest.GetOrCreateImp(t)
+	imp := &BackendImp{
+		Serve: newBackendMockServeMethod(_imptest.NewDependencyMethod(ctrl, "Serve").Results(_reflect.TypeFor[int]())),
+	}
+	imp.Eventually = &BackendImpEventually{
+		Serve: newBackendMockServeMethod(_imptest.NewDependencyMethod(ctrl, "Serve").Results(_reflect.TypeFor[int]()).AsEventually()),
+	}
+	mock := &mockBackendImpl{ctrl: ctrl}
+	return mock, imp
+}
+
+type mockBackendImpl struct {
+	ctrl *_imptest.Imp
+}
+
+// Serve implements combinators.Backend.Serve.
+func (impl *mockBackendImpl) Serve(req combinators.Request) int {
+	call := &_imptest.GenericCall{
+		MethodName:   "Serve",
+		Args:         []any{req},
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 int
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(int); ok {
+			result1 = value
+		}
+	}
+
+	return result1
+}
+
+// newBackendMockServeMethod creates a typed method wrapper.
+func newBackendMockServeMethod(dm *_imptest.DependencyMethod) *BackendMockServeMethod {
+	return &BackendMockServeMethod{DependencyMethod: dm}
+}
@@ -6,6 +6,8 @@ package matching_test
 import (
 	_imptest "github.com/toejough/imptest"
 	matching "github.com/toejough/imptest/UAT/variations/behavior/matching"
+	_reflect "reflect"
+	_time "time"
 )
 
 type ComplexServiceImp struct {
@@ -39,6 +41,11 @@ func (c *ComplexServiceMockProcessCall) Return(result0 bool) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *ComplexServiceMockProcessCall) ReturnAfter(d _time.Duration, result0 bool) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type ComplexServiceMockProcessMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -49,9 +56,21 @@ func (m *ComplexServiceMockProcessMethod) ArgsEqual(d matching.Data) *ComplexSer
 	return &ComplexServiceMockProcessCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *ComplexServiceMockProcessMethod) ArgsShould(matchers ...any) *ComplexServiceMockProcessCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *ComplexServiceMockProcessMethod) ArgsShould(d any) *ComplexServiceMockProcessCall {
+	_call := m.DependencyMethod.ArgsShould(d)
+	return &ComplexServiceMockProcessCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *ComplexServiceMockProcessMethod) ArgsWhere(predicate func(ComplexServiceMockProcessArgs) error) *ComplexServiceMockProcessCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args ComplexServiceMockProcessArgs
+		args.D, _ = raw[0].(matching.Data)
+		return predicate(args)
+	})
 	return &ComplexServiceMockProcessCall{DependencyCall: call}
 }
 
@@ -59,10 +78,10 @@ func (m *ComplexServiceMockProcessMethod) ArgsShould(matchers ...any) *ComplexSe
 func MockComplexService(t _imptest.TestReporter) (matching.ComplexService, *ComplexServiceImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &ComplexServiceImp{
-		Process: newComplexServiceMockProcessMethod(_imptest.NewDependencyMethod(ctrl, "Process")),
+		Process: newComplexServiceMockProcessMethod(_imptest.NewDependencyMethod(ctrl, "Process").Results(_reflect.TypeFor[bool]())),
 	}
 	imp.Eventually = &ComplexServiceImpEventually{
-		Process: newComplexServiceMockProcessMethod(_imptest.NewDependencyMethod(ctrl, "Process").AsEventually()),
+		Process: newComplexServiceMockProcessMethod(_imptest.NewDependencyMethod(ctrl, "Process").Results(_reflect.TypeFor[bool]()).AsEventually()),
 	}
 	mock := &mockComplexServiceImpl{ctrl: ctrl}
 	return mock, imp
@@ -81,9 +100,7 @@ func (impl *mockComplexServiceImpl) Process(d matching.Data) bool {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 bool
 	if len(resp.ReturnValues) > 0 {
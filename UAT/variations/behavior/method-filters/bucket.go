@@ -0,0 +1,21 @@
+// Package bucket demonstrates mocking only some of an interface's methods.
+package bucket
+
+// Client is a large storage SDK client, of which a test usually needs only a few methods.
+type Client interface {
+	Get(key string) ([]byte, error)
+	Put(key string, data []byte) error
+	Delete(key string) error
+	ListObjects(prefix string) ([]string, error)
+	ListVersions(key string) ([]string, error)
+}
+
+// Copy copies the object at from to to.
+func Copy(client Client, from, to string) error {
+	data, err := client.Get(from)
+	if err != nil {
+		return err
+	}
+
+	return client.Put(to, data)
+}
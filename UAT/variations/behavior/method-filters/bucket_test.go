@@ -0,0 +1,80 @@
+package bucket_test
+
+import (
+	"strings"
+	"testing"
+
+	bucket "github.com/toejough/imptest/UAT/variations/behavior/method-filters"
+)
+
+//go:generate impgen bucket.Client --dependency --methods Get,Put --name MockCopyClient
+//go:generate impgen bucket.Client --dependency --exclude-methods ^List --name MockNoListClient
+
+// TestMethodFilters_ExcludeMethods demonstrates leaving methods unmocked by pattern.
+//
+// Key Requirements Met:
+//  1. --exclude-methods leaves every method matching the pattern unmocked (here ListObjects and
+//     ListVersions), and mocks the rest.
+func TestMethodFilters_ExcludeMethods(t *testing.T) {
+	t.Parallel()
+
+	client, imp := MockNoListClient(t)
+
+	errChan := make(chan error, 1)
+
+	go func() {
+		errChan <- client.Delete("old")
+	}()
+
+	imp.Delete.ArgsEqual("old").Return(nil)
+
+	err := <-errChan
+	if err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	assertNotMocked(t, "bucket.Client.ListVersions", func() { _, _ = client.ListVersions("old") })
+}
+
+// TestMethodFilters_Methods demonstrates mocking only the methods a test needs.
+//
+// Key Requirements Met:
+//  1. --methods mocks only the listed methods: the expectation handle has no other fields, and
+//     far less code is generated for a large interface.
+//  2. The mock still implements the whole interface; unmocked methods panic with a clear
+//     "not mocked" message.
+func TestMethodFilters_Methods(t *testing.T) {
+	t.Parallel()
+
+	client, imp := MockCopyClient(t)
+
+	errChan := make(chan error, 1)
+
+	go func() {
+		errChan <- bucket.Copy(client, "a", "b")
+	}()
+
+	imp.Get.ArgsEqual("a").Return([]byte("data"), nil)
+	imp.Put.ArgsEqual("b", []byte("data")).Return(nil)
+
+	err := <-errChan
+	if err != nil {
+		t.Fatalf("Copy() error = %v", err)
+	}
+
+	assertNotMocked(t, "bucket.Client.Delete", func() { _ = client.Delete("a") })
+	assertNotMocked(t, "bucket.Client.ListObjects", func() { _, _ = client.ListObjects("") })
+}
+
+func assertNotMocked(t *testing.T, method string, call func()) {
+	t.Helper()
+
+	defer func() {
+		msg, _ := recover().(string)
+		if !strings.HasPrefix(msg, method+" is not mocked") {
+			t.Errorf("expected %s to panic as not mocked, got %q", method, msg)
+		}
+	}()
+
+	call()
+}
@@ -0,0 +1,33 @@
+// Package nativematchers demonstrates the dependency-free matchers in the match package
+// against mock arguments of several shapes.
+package nativematchers
+
+import (
+	"fmt"
+	"time"
+)
+
+// Actor identifies who performed an operation.
+type Actor struct {
+	Name string
+	ID   int
+}
+
+// Auditor records the outcome of an operation.
+type Auditor interface {
+	Record(entry Entry, tags []string, err error) bool
+}
+
+// Entry describes an audited operation.
+type Entry struct {
+	Op    string
+	At    time.Time
+	Actor *Actor
+}
+
+// AuditFailure records that op failed with cause.
+func AuditFailure(auditor Auditor, op string, actor *Actor, at time.Time, cause error) bool {
+	entry := Entry{Op: op, At: at, Actor: actor}
+
+	return auditor.Record(entry, []string{"op:" + op, "status:failed"}, fmt.Errorf("%s: %w", op, cause))
+}
@@ -0,0 +1,130 @@
+// Code generated by impgen. DO NOT EDIT.
+// impgen:hash:5411d0efbf0f7f69
+
+package nativematchers_test
+
+import (
+	_imptest "github.com/toejough/imptest"
+	nativematchers "github.com/toejough/imptest/UAT/variations/behavior/native-matchers"
+	_reflect "reflect"
+	_time "time"
+)
+
+type AuditorImp struct {
+	Record *AuditorMockRecordMethod
+	// Eventually provides async versions of all methods for concurrent code.
+	Eventually *AuditorImpEventually
+}
+
+type AuditorImpEventually struct {
+	Record *AuditorMockRecordMethod
+}
+
+type AuditorMockRecordArgs struct {
+	Entry nativematchers.Entry
+	Tags  []string
+	Err   error
+}
+
+type AuditorMockRecordCall struct {
+	*_imptest.DependencyCall
+}
+
+// FillTags copies values into the caller's Tags slice before the mock returns.
+func (c *AuditorMockRecordCall) FillTags(values []string) *AuditorMockRecordCall {
+	c.FillArg(1, values)
+	return c
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *AuditorMockRecordCall) GetArgs() AuditorMockRecordArgs {
+	raw := c.RawArgs()
+	return AuditorMockRecordArgs{
+		Entry: raw[0].(nativematchers.Entry),
+		Tags:  raw[1].([]string),
+		Err:   raw[2].(error),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *AuditorMockRecordCall) Return(result0 bool) {
+	c.DependencyCall.Return(result0)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *AuditorMockRecordCall) ReturnAfter(d _time.Duration, result0 bool) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
+type AuditorMockRecordMethod struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *AuditorMockRecordMethod) ArgsEqual(entry nativematchers.Entry, tags []string, err error) *AuditorMockRecordCall {
+	call := m.DependencyMethod.ArgsEqual(entry, tags, err)
+	return &AuditorMockRecordCall{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *AuditorMockRecordMethod) ArgsShould(entry any, tags any, err any) *AuditorMockRecordCall {
+	_call := m.DependencyMethod.ArgsShould(entry, tags, err)
+	return &AuditorMockRecordCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *AuditorMockRecordMethod) ArgsWhere(predicate func(AuditorMockRecordArgs) error) *AuditorMockRecordCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args AuditorMockRecordArgs
+		args.Entry, _ = raw[0].(nativematchers.Entry)
+		args.Tags, _ = raw[1].([]string)
+		args.Err, _ = raw[2].(error)
+		return predicate(args)
+	})
+	return &AuditorMockRecordCall{DependencyCall: call}
+}
+
+// MockAuditor creates a mock Auditor and returns (mock, expectation handle).
+func MockAuditor(t _imptest.TestReporter) (nativematchers.Auditor, *AuditorImp) {
+	ctrl := _imptest.GetOrCreateImp(t)
+	imp := &AuditorImp{
+		Record: newAuditorMockRecordMethod(_imptest.NewDependencyMethod(ctrl, "Record").Results(_reflect.TypeFor[bool]())),
+	}
+	imp.Eventually = &AuditorImpEventually{
+		Record: newAuditorMockRecordMethod(_imptest.NewDependencyMethod(ctrl, "Record").Results(_reflect.TypeFor[bool]()).AsEventually()),
+	}
+	mock := &mockAuditorImpl{ctrl: ctrl}
+	return mock, imp
+}
+
+type mockAuditorImpl struct {
+	ctrl *_imptest.Imp
+}
+
+// Record implements nativematchers.Auditor.Record.
+func (impl *mockAuditorImpl) Record(entry nativematchers.Entry, tags []string, err error) bool {
+	call := &_imptest.GenericCall{
+		MethodName:   "Record",
+		Args:         []any{entry, tags, err},
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 bool
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(bool); ok {
+			result1 = value
+		}
+	}
+
+	return result1
+}
+
+// newAuditorMockRecordMethod creates a typed method wrapper.
+func newAuditorMockRecordMethod(dm *_imptest.DependencyMethod) *AuditorMockRecordMethod {
+	return &AuditorMockRecordMethod{DependencyMethod: dm}
+}
@@ -0,0 +1,174 @@
+package nativematchers_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/toejough/imptest/UAT/internal/failing"
+	nativematchers "github.com/toejough/imptest/UAT/variations/behavior/native-matchers"
+	. "github.com/toejough/imptest/match" //nolint:revive // Dot import for matcher DSL
+)
+
+//go:generate impgen nativematchers.Auditor --dependency
+
+// TestNativeMatchers_ArgsShould demonstrates the match package's own matchers
+// verifying mock arguments without any third-party matcher library.
+//
+// Key Requirements Met:
+//  1. No Dependencies: every matcher here comes from the match package alone.
+//  2. Nesting: HaveField and ContainElement accept matchers for the nested value.
+func TestNativeMatchers_ArgsShould(t *testing.T) {
+	t.Parallel()
+
+	mock, expect := MockAuditor(t)
+	now := time.Now()
+
+	go nativematchers.AuditFailure(mock, "sync", &nativematchers.Actor{Name: "bob", ID: 7}, now, io.ErrUnexpectedEOF)
+
+	expect.Record.ArgsShould(
+		HaveField("Actor.Name", Equal("bob")),
+		ContainElement(HavePrefix("status:")),
+		ErrorIs(io.ErrUnexpectedEOF),
+	).Return(true)
+}
+
+// TestNativeMatchers_FailureNamesArgument demonstrates that a failing native matcher
+// reports the argument position along with a description of the mismatch.
+//
+// Key Requirements Met:
+//  1. Readable Failures: the message names the argument and what was expected of it.
+func TestNativeMatchers_FailureNamesArgument(t *testing.T) {
+	t.Parallel()
+
+	reporter := failing.NewReporter()
+	mock, expect := MockAuditor(reporter)
+
+	go nativematchers.AuditFailure(mock, "sync", nil, time.Now(), io.EOF)
+
+	go func() { expect.Record.ArgsShould(BeAny, HaveLen(3), BeAny).Return(true) }()
+
+	reporter.AssertFailure(t, `arg 1: expected length 3, got length 2: []string{"op:sync", "status:failed"}`)
+}
+
+// TestNativeMatchers_Messages verifies each matcher's verdict and failure message.
+//
+// Key Requirements Met:
+//  1. Coverage: every native matcher accepts and rejects the values it should.
+//  2. Mock-Oriented Messages: failures describe the actual value, not the call.
+//  3. Side Effects: ErrorAs fills its target, as errors.As does.
+func TestNativeMatchers_Messages(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	actor := &nativematchers.Actor{Name: "bob", ID: 7}
+	entry := nativematchers.Entry{Op: "sync", At: base, Actor: actor}
+	wrapped := fmt.Errorf("read config: %w", &fs.PathError{Op: "open", Path: "cfg", Err: fs.ErrNotExist})
+
+	var pathErr *fs.PathError
+
+	for _, tc := range []struct {
+		name    string
+		matcher Matcher
+		pass    any
+		fail    any
+		message string
+	}{
+		{"Equal", Equal(42), 42, 41, "expected 41 to equal 42"},
+		{"Equal type", Equal(42), 42, int64(42), "expected 42 (int64) to equal 42 (int)"},
+		{"DeepEqual", DeepEqual([]int{1, 2}), []int{1, 2}, []int{2, 1}, "expected []int{2, 1} to deeply equal []int{1, 2}"},
+		{"BeNil", BeNil(), (*nativematchers.Actor)(nil), actor, "expected nil, got &nativematchers.Actor{"},
+		{"BeZero", BeZero(), nativematchers.Entry{}, entry, "expected the zero value, got nativematchers.Entry{"},
+		{"HaveLen", HaveLen(2), map[string]int{"a": 1, "b": 2}, "abc", `expected length 2, got length 3: "abc"`},
+		{"ContainElement", ContainElement("b"), []string{"a", "b"}, []string{"a"}, `expected []string{"a"} to contain "b"`},
+		{
+			"ContainElement matcher", ContainElement(BeNumerically(">", 5)), []int{1, 9}, []int{1, 2},
+			`expected []int{1, 2} to contain an element matching BeNumerically(">", 5)`,
+		},
+		{"ContainSubstring", ContainSubstring("ell"), []byte("hello"), "help", `expected "help" to contain substring "ell"`},
+		{"HavePrefix", HavePrefix("/api"), "/api/users", "/web", `expected "/web" to have prefix "/api"`},
+		{"MatchRegexp", MatchRegexp(`^\d+$`), "123", "12a", `expected "12a" to match regexp "^\\d+$"`},
+		{"BeNumerically", BeNumerically(">=", 10), uint8(10), 9.5, "expected 9.5 to be >= 10"},
+		{"BeNumerically ~", BeNumerically("~", 1.0, 0.01), 1.005, 1.1, "expected 1.1 to be ~ 1 (within 0.01)"},
+		{
+			"BeTemporally", BeTemporally("<", base), base.Add(-time.Second), base,
+			"expected 2026-01-02 03:04:05 +0000 UTC to be < 2026-01-02 03:04:05 +0000 UTC",
+		},
+		{
+			"BeTemporally ~", BeTemporally("~", base, time.Second), base.Add(time.Millisecond), base.Add(time.Minute),
+			"(within 1s)",
+		},
+		{"ErrorIs", ErrorIs(fs.ErrNotExist), wrapped, io.EOF, `expected error "EOF" to wrap "file does not exist"`},
+		{"ErrorIs nil", ErrorIs(io.EOF), io.EOF, nil, `expected an error wrapping "EOF", got nil`},
+		{"ErrorAs", ErrorAs(&pathErr), wrapped, io.EOF, `expected error "EOF" to have a *fs.PathError in its chain`},
+		{"HaveField", HaveField("Actor.ID", 7), entry, nativematchers.Entry{Actor: &nativematchers.Actor{ID: 8}},
+			"field Actor.ID: expected 7, got 8"},
+		{
+			"HaveField matcher", HaveField("Op", HavePrefix("sy")), entry, nativematchers.Entry{Op: "async"},
+			`field Op: expected "async" to have prefix "sy"`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			matched, err := tc.matcher.Match(tc.pass)
+			if err != nil || !matched {
+				t.Fatalf("expected %#v to match, got (%v, %v)", tc.pass, matched, err)
+			}
+
+			matched, err = tc.matcher.Match(tc.fail)
+			if err != nil || matched {
+				t.Fatalf("expected %#v not to match, got (%v, %v)", tc.fail, matched, err)
+			}
+
+			if msg := tc.matcher.FailureMessage(tc.fail); !strings.Contains(msg, tc.message) {
+				t.Fatalf("expected failure message containing %q, got %q", tc.message, msg)
+			}
+		})
+	}
+
+	if pathErr == nil || pathErr.Path != "cfg" {
+		t.Fatalf("expected ErrorAs to fill its target, got %#v", pathErr)
+	}
+}
+
+// TestNativeMatchers_MisuseErrors verifies that applying a matcher to a value it cannot
+// inspect is reported as an error instead of a silent mismatch.
+//
+// Key Requirements Met:
+//  1. Clear Misuse: the error names the matcher and the offending type.
+func TestNativeMatchers_MisuseErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name    string
+		matcher Matcher
+		actual  any
+		message string
+	}{
+		{"Equal uncomparable", Equal([]int{1}), []int{1}, "Equal cannot compare []int values, use DeepEqual"},
+		{"HaveLen", HaveLen(1), 5, "HaveLen expects a string, slice, array, map, or channel, got int"},
+		{"ContainElement", ContainElement(1), "abc", "ContainElement expects a slice, array, or map, got string"},
+		{"HavePrefix", HavePrefix("a"), 5, "HavePrefix expects a string, []byte, or fmt.Stringer, got int"},
+		{"MatchRegexp", MatchRegexp("("), "x", "MatchRegexp: error parsing regexp"},
+		{"BeNumerically comparator", BeNumerically("=>", 1), 1, `BeNumerically does not support "=>"`},
+		{"BeNumerically type", BeNumerically(">", 1), "2", "BeNumerically expects a number, got string"},
+		{"BeTemporally", BeTemporally("<", time.Now()), 5, "BeTemporally expects a time.Time, got int"},
+		{"ErrorIs", ErrorIs(io.EOF), "EOF", "ErrorIs expects an error, got string"},
+		{"ErrorAs target", ErrorAs(errors.New("x")), io.EOF, "errors.errorString does not implement error"},
+		{"ErrorAs pointer", ErrorAs(42), io.EOF, "expected a non-nil pointer, got int"},
+		{"HaveField missing", HaveField("Nope", 1), nativematchers.Entry{}, "nativematchers.Entry has no field Nope"},
+		{"HaveField nil", HaveField("Actor.Name", "bob"), nativematchers.Entry{}, "cannot read Name through nil field Actor"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := tc.matcher.Match(tc.actual)
+			if err == nil || !strings.Contains(err.Error(), tc.message) {
+				t.Fatalf("expected error containing %q, got %v", tc.message, err)
+			}
+		})
+	}
+}
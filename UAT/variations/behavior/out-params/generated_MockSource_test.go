@@ -0,0 +1,414 @@
+// Code generated by impgen. DO NOT EDIT.
+// impgen:hash:1f89956aad429666
+
+package outparams_test
+
+import (
+	_imptest "github.com/toejough/imptest"
+	outparams "github.com/toejough/imptest/UAT/variations/behavior/out-params"
+	_reflect "reflect"
+	_time "time"
+)
+
+type SourceImp struct {
+	Read   *SourceMockReadMethod
+	Decode *SourceMockDecodeMethod
+	Load   *SourceMockLoadMethod
+	Scan   *SourceMockScanMethod
+	// Eventually provides async versions of all methods for concurrent code.
+	Eventually *SourceImpEventually
+}
+
+type SourceImpEventually struct {
+	Read   *SourceMockReadMethod
+	Decode *SourceMockDecodeMethod
+	Load   *SourceMockLoadMethod
+	Scan   *SourceMockScanMethod
+}
+
+type SourceMockDecodeArgs struct {
+	Data []byte
+	V    any
+}
+
+type SourceMockDecodeCall struct {
+	*_imptest.DependencyCall
+}
+
+// FillData copies values into the caller's Data slice before the mock returns.
+func (c *SourceMockDecodeCall) FillData(values []byte) *SourceMockDecodeCall {
+	c.FillArg(0, values)
+	return c
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *SourceMockDecodeCall) GetArgs() SourceMockDecodeArgs {
+	raw := c.RawArgs()
+	return SourceMockDecodeArgs{
+		Data: raw[0].([]byte),
+		V:    raw[1].(any),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *SourceMockDecodeCall) Return(result0 error) {
+	c.DependencyCall.Return(result0)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *SourceMockDecodeCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
+type SourceMockDecodeMethod struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *SourceMockDecodeMethod) ArgsEqual(data []byte, v any) *SourceMockDecodeCall {
+	call := m.DependencyMethod.ArgsEqual(data, v)
+	return &SourceMockDecodeCall{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *SourceMockDecodeMethod) ArgsShould(data any, v any) *SourceMockDecodeCall {
+	_call := m.DependencyMethod.ArgsShould(data, v)
+	return &SourceMockDecodeCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *SourceMockDecodeMethod) ArgsWhere(predicate func(SourceMockDecodeArgs) error) *SourceMockDecodeCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args SourceMockDecodeArgs
+		args.Data, _ = raw[0].([]byte)
+		args.V, _ = raw[1].(any)
+		return predicate(args)
+	})
+	return &SourceMockDecodeCall{DependencyCall: call}
+}
+
+type SourceMockLoadArgs struct {
+	Id   int
+	Into *outparams.Record
+}
+
+type SourceMockLoadCall struct {
+	*_imptest.DependencyCall
+}
+
+// FillInto stores value through the caller's Into pointer before the mock returns.
+func (c *SourceMockLoadCall) FillInto(value outparams.Record) *SourceMockLoadCall {
+	c.FillArg(1, value)
+	return c
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *SourceMockLoadCall) GetArgs() SourceMockLoadArgs {
+	raw := c.RawArgs()
+	return SourceMockLoadArgs{
+		Id:   raw[0].(int),
+		Into: raw[1].(*outparams.Record),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *SourceMockLoadCall) Return(result0 error) {
+	c.DependencyCall.Return(result0)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *SourceMockLoadCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
+type SourceMockLoadMethod struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *SourceMockLoadMethod) ArgsEqual(id int, into *outparams.Record) *SourceMockLoadCall {
+	call := m.DependencyMethod.ArgsEqual(id, into)
+	return &SourceMockLoadCall{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *SourceMockLoadMethod) ArgsShould(id any, into any) *SourceMockLoadCall {
+	_call := m.DependencyMethod.ArgsShould(id, into)
+	return &SourceMockLoadCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *SourceMockLoadMethod) ArgsWhere(predicate func(SourceMockLoadArgs) error) *SourceMockLoadCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args SourceMockLoadArgs
+		args.Id, _ = raw[0].(int)
+		args.Into, _ = raw[1].(*outparams.Record)
+		return predicate(args)
+	})
+	return &SourceMockLoadCall{DependencyCall: call}
+}
+
+type SourceMockReadArgs struct {
+	P []byte
+}
+
+type SourceMockReadCall struct {
+	*_imptest.DependencyCall
+}
+
+// FillP copies values into the caller's P slice before the mock returns.
+func (c *SourceMockReadCall) FillP(values []byte) *SourceMockReadCall {
+	c.FillArg(0, values)
+	return c
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *SourceMockReadCall) GetArgs() SourceMockReadArgs {
+	raw := c.RawArgs()
+	return SourceMockReadArgs{
+		P: raw[0].([]byte),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *SourceMockReadCall) Return(result0 int, result1 error) {
+	c.DependencyCall.Return(result0, result1)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *SourceMockReadCall) ReturnAfter(d _time.Duration, result0 int, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
+type SourceMockReadMethod struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *SourceMockReadMethod) ArgsEqual(p []byte) *SourceMockReadCall {
+	call := m.DependencyMethod.ArgsEqual(p)
+	return &SourceMockReadCall{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *SourceMockReadMethod) ArgsShould(p any) *SourceMockReadCall {
+	_call := m.DependencyMethod.ArgsShould(p)
+	return &SourceMockReadCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *SourceMockReadMethod) ArgsWhere(predicate func(SourceMockReadArgs) error) *SourceMockReadCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args SourceMockReadArgs
+		args.P, _ = raw[0].([]byte)
+		return predicate(args)
+	})
+	return &SourceMockReadCall{DependencyCall: call}
+}
+
+type SourceMockScanArgs struct {
+	Dest []any
+}
+
+type SourceMockScanCall struct {
+	*_imptest.DependencyCall
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *SourceMockScanCall) GetArgs() SourceMockScanArgs {
+	raw := c.RawArgs()
+	return SourceMockScanArgs{
+		Dest: raw[0].([]any),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *SourceMockScanCall) Return(result0 error) {
+	c.DependencyCall.Return(result0)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *SourceMockScanCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
+type SourceMockScanMethod struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *SourceMockScanMethod) ArgsEqual(dest ...any) *SourceMockScanCall {
+	callArgs := []any{}
+	for _, v := range dest {
+		callArgs = append(callArgs, v)
+	}
+	call := m.DependencyMethod.ArgsEqual(callArgs...)
+	return &SourceMockScanCall{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *SourceMockScanMethod) ArgsShould(dest ...any) *SourceMockScanCall {
+	_matchers := []any{}
+	_matchers = append(_matchers, dest...)
+	_call := m.DependencyMethod.ArgsShould(_matchers...)
+	return &SourceMockScanCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *SourceMockScanMethod) ArgsWhere(predicate func(SourceMockScanArgs) error) *SourceMockScanCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args SourceMockScanArgs
+		for _, value := range raw[0:] {
+			elem, _ := value.(any)
+			args.Dest = append(args.Dest, elem)
+		}
+		return predicate(args)
+	})
+	return &SourceMockScanCall{DependencyCall: call}
+}
+
+// MockSource creates a mock Source and returns (mock, expectation handle).
+func MockSource(t _imptest.TestReporter) (outparams.Source, *SourceImp) {
+	ctrl := _imptest.GetOrCreateImp(t)
+	imp := &SourceImp{
+		Read:   newSourceMockReadMethod(_imptest.NewDependencyMethod(ctrl, "Read").Results(_reflect.TypeFor[int](), _reflect.TypeFor[error]())),
+		Decode: newSourceMockDecodeMethod(_imptest.NewDependencyMethod(ctrl, "Decode").Results(_reflect.TypeFor[error]())),
+		Load:   newSourceMockLoadMethod(_imptest.NewDependencyMethod(ctrl, "Load").Results(_reflect.TypeFor[error]())),
+		Scan:   newSourceMockScanMethod(_imptest.NewDependencyMethod(ctrl, "Scan").Results(_reflect.TypeFor[error]())),
+	}
+	imp.Eventually = &SourceImpEventually{
+		Read:   newSourceMockReadMethod(_imptest.NewDependencyMethod(ctrl, "Read").Results(_reflect.TypeFor[int](), _reflect.TypeFor[error]()).AsEventually()),
+		Decode: newSourceMockDecodeMethod(_imptest.NewDependencyMethod(ctrl, "Decode").Results(_reflect.TypeFor[error]()).AsEventually()),
+		Load:   newSourceMockLoadMethod(_imptest.NewDependencyMethod(ctrl, "Load").Results(_reflect.TypeFor[error]()).AsEventually()),
+		Scan:   newSourceMockScanMethod(_imptest.NewDependencyMethod(ctrl, "Scan").Results(_reflect.TypeFor[error]()).AsEventually()),
+	}
+	mock := &mockSourceImpl{ctrl: ctrl}
+	return mock, imp
+}
+
+type mockSourceImpl struct {
+	ctrl *_imptest.Imp
+}
+
+// Decode implements outparams.Source.Decode.
+func (impl *mockSourceImpl) Decode(data []byte, v any) error {
+	call := &_imptest.GenericCall{
+		MethodName:   "Decode",
+		Args:         []any{data, v},
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 error
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(error); ok {
+			result1 = value
+		}
+	}
+
+	return result1
+}
+
+// Load implements outparams.Source.Load.
+func (impl *mockSourceImpl) Load(id int, into *outparams.Record) error {
+	call := &_imptest.GenericCall{
+		MethodName:   "Load",
+		Args:         []any{id, into},
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 error
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(error); ok {
+			result1 = value
+		}
+	}
+
+	return result1
+}
+
+// Read implements outparams.Source.Read.
+func (impl *mockSourceImpl) Read(p []byte) (int, error) {
+	call := &_imptest.GenericCall{
+		MethodName:   "Read",
+		Args:         []any{p},
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 int
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(int); ok {
+			result1 = value
+		}
+	}
+
+	var result2 error
+	if len(resp.ReturnValues) > 1 {
+		if value, ok := resp.ReturnValues[1].(error); ok {
+			result2 = value
+		}
+	}
+
+	return result1, result2
+}
+
+// Scan implements outparams.Source.Scan.
+func (impl *mockSourceImpl) Scan(dest ...any) error {
+	callArgs := []any{}
+	for _, v := range dest {
+		callArgs = append(callArgs, v)
+	}
+	call := &_imptest.GenericCall{
+		MethodName:   "Scan",
+		Args:         callArgs,
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 error
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(error); ok {
+			result1 = value
+		}
+	}
+
+	return result1
+}
+
+// newSourceMockDecodeMethod creates a typed method wrapper.
+func newSourceMockDecodeMethod(dm *_imptest.DependencyMethod) *SourceMockDecodeMethod {
+	return &SourceMockDecodeMethod{DependencyMethod: dm}
+}
+
+// newSourceMockLoadMethod creates a typed method wrapper.
+func newSourceMockLoadMethod(dm *_imptest.DependencyMethod) *SourceMockLoadMethod {
+	return &SourceMockLoadMethod{DependencyMethod: dm}
+}
+
+// newSourceMockReadMethod creates a typed method wrapper.
+func newSourceMockReadMethod(dm *_imptest.DependencyMethod) *SourceMockReadMethod {
+	return &SourceMockReadMethod{DependencyMethod: dm}
+}
+
+// newSourceMockScanMethod creates a typed method wrapper.
+func newSourceMockScanMethod(dm *_imptest.DependencyMethod) *SourceMockScanMethod {
+	return &SourceMockScanMethod{DependencyMethod: dm}
+}
@@ -0,0 +1,72 @@
+package outparams_test
+
+import (
+	"testing"
+
+	outparams "github.com/toejough/imptest/UAT/variations/behavior/out-params"
+	"github.com/toejough/imptest/match"
+)
+
+//go:generate impgen outparams.Source --dependency
+//go:generate impgen outparams.Summarize --target
+
+// TestFillOutParams demonstrates filling slice, pointer, any, and variadic
+// out-parameters before releasing the mock's response.
+//
+// Key Requirements Met:
+//  1. Slice Fill: FillP copies bytes into the caller's buffer (io.Reader.Read style).
+//  2. Pointer Fill: FillInto stores a typed value through a typed pointer.
+//  3. Any Fill: FillArg stores through the pointer passed as any (json.Unmarshal style).
+//  4. Variadic Fill: FillArg stores into each dest pointer by argument index, accepting
+//     a value or a pointer to one.
+func TestFillOutParams(t *testing.T) {
+	t.Parallel()
+
+	mock, expect := MockSource(t)
+
+	call := StartSummarize(t, outparams.Summarize, mock)
+
+	expect.Read.ArgsShould(match.BeAny).FillP([]byte("hi")).Return(2, nil)
+
+	decode := expect.Decode.ArgsShould([]byte("hi"), match.BeAny)
+	decode.FillArg(1, outparams.Record{ID: 7, Name: "decoded"})
+	decode.Return(nil)
+
+	expect.Load.ArgsShould(7, match.BeAny).FillInto(outparams.Record{ID: 7, Name: "loaded"}).Return(nil)
+
+	owner := "ada"
+	scan := expect.Scan.ArgsShould(match.BeAny, match.BeAny)
+	scan.FillArg(0, 3)
+	scan.FillArg(1, &owner)
+	scan.Return(nil)
+
+	call.ReturnsEqual("hi/decoded/loaded/3/ada", nil)
+}
+
+// TestFillOutParams_Eventually demonstrates filling out-parameters in async mode:
+// the fill waits for the call to be matched, and the response is released after it.
+//
+// Key Requirements Met:
+//  1. Async Fill: fills apply to the matched call before Return releases it.
+func TestFillOutParams_Eventually(t *testing.T) {
+	t.Parallel()
+
+	mock, expect := MockSource(t)
+
+	call := StartSummarize(t, outparams.Summarize, mock)
+
+	expect.Eventually.Read.ArgsShould(match.BeAny).FillP([]byte("hi")).Return(2, nil)
+
+	decode := expect.Decode.ArgsShould(match.BeAny, match.BeAny)
+	decode.FillArg(1, outparams.Record{ID: 1, Name: "a"})
+	decode.Return(nil)
+
+	expect.Load.ArgsShould(1, match.BeAny).FillInto(outparams.Record{Name: "b"}).Return(nil)
+
+	scan := expect.Scan.ArgsShould(match.BeAny, match.BeAny)
+	scan.FillArg(0, 0)
+	scan.FillArg(1, "c")
+	scan.Return(nil)
+
+	call.ReturnsEqual("hi/a/b/0/c", nil)
+}
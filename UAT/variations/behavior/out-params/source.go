@@ -0,0 +1,54 @@
+// Package outparams demonstrates filling out-parameters: methods that return
+// results by writing through pointer and slice arguments.
+package outparams
+
+import "fmt"
+
+type Record struct {
+	ID   int
+	Name string
+}
+
+type Source interface {
+	Read(p []byte) (int, error)
+	Decode(data []byte, v any) error
+	Load(id int, into *Record) error
+	Scan(dest ...any) error
+}
+
+// Summarize reads a header, decodes and loads a record, and scans a row,
+// describing what it got.
+func Summarize(src Source) (string, error) {
+	buf := make([]byte, 8)
+
+	n, err := src.Read(buf)
+	if err != nil {
+		return "", err
+	}
+
+	var decoded Record
+
+	err = src.Decode(buf[:n], &decoded)
+	if err != nil {
+		return "", err
+	}
+
+	var loaded Record
+
+	err = src.Load(decoded.ID, &loaded)
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		count int
+		owner string
+	)
+
+	err = src.Scan(&count, &owner)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s/%s/%s/%d/%s", buf[:n], decoded.Name, loaded.Name, count, owner), nil
+}
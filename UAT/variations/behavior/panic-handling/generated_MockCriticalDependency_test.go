@@ -22,10 +22,10 @@ type CriticalDependencyImpEventually struct {
 func MockCriticalDependency(t _imptest.TestReporter) (safety.CriticalDependency, *CriticalDependencyImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &CriticalDependencyImp{
-		DoWork: _imptest.NewDependencyMethod(ctrl, "DoWork"),
+		DoWork: _imptest.NewDependencyMethod(ctrl, "DoWork").Results(),
 	}
 	imp.Eventually = &CriticalDependencyImpEventually{
-		DoWork: _imptest.NewDependencyMethod(ctrl, "DoWork").AsEventually(),
+		DoWork: _imptest.NewDependencyMethod(ctrl, "DoWork").Results().AsEventually(),
 	}
 	mock := &mockCriticalDependencyImpl{ctrl: ctrl}
 	return mock, imp
@@ -44,8 +44,6 @@ func (impl *mockCriticalDependencyImpl) DoWork() {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 }
@@ -0,0 +1,95 @@
+package safety_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/toejough/imptest"
+	"github.com/toejough/imptest/UAT/internal/failing"
+	safety "github.com/toejough/imptest/UAT/variations/behavior/panic-handling"
+	"github.com/toejough/imptest/match"
+)
+
+// TestPanicStack_AvailableToPanicShould demonstrates asserting on where a panic
+// happened, not just what it was.
+//
+// Key Requirements Met:
+//  1. Stack Capture: the wrapper records the stack trace when it recovers a panic.
+//  2. Matcher Access: Satisfy matchers for imptest.PanicInfo receive value and stack.
+func TestPanicStack_AvailableToPanicShould(t *testing.T) {
+	t.Parallel()
+
+	depMock, depImp := MockCriticalDependency(t)
+
+	call := StartUnsafeRunner(t, safety.UnsafeRunner, depMock)
+
+	depImp.DoWork.Called().Panic("fatal error")
+
+	// Requirement: the panic came through UnsafeRunner.
+	call.PanicShould(match.Satisfy(func(p imptest.PanicInfo) error {
+		if p.Value != "fatal error" {
+			return fmt.Errorf("unexpected panic value %v", p.Value)
+		}
+
+		if !strings.Contains(string(p.Stack), "UnsafeRunner(") {
+			return fmt.Errorf("stack does not include UnsafeRunner:\n%s", p.Stack)
+		}
+
+		return nil
+	}))
+}
+
+// TestPanicStack_InPanicShouldFailure demonstrates that a panic which doesn't match
+// PanicShould is reported with its stack trace, whichever matcher was used.
+//
+// Key Requirements Met:
+//  1. Actionable Failures: "panic value" mismatches include where the panic happened.
+func TestPanicStack_InPanicShouldFailure(t *testing.T) {
+	t.Parallel()
+
+	reporter := failing.NewReporter()
+
+	depMock, depImp := MockCriticalDependency(reporter)
+
+	call := StartUnsafeRunner(reporter, safety.UnsafeRunner, depMock)
+
+	depImp.DoWork.Called().Panic("fatal error")
+
+	go call.PanicShould(match.HavePrefix("recoverable"))
+
+	msg := reporter.Wait(t)
+
+	for _, want := range []string{"panic value:", "panic stack:", "UnsafeRunner("} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("expected failure to contain %q, got:\n%s", want, msg)
+		}
+	}
+}
+
+// TestPanicStack_InUnexpectedPanicFailure demonstrates that an unexpected panic is
+// reported with the stack trace of the goroutine that panicked.
+//
+// Key Requirements Met:
+//  1. Actionable Failures: "expected function to complete, but it panicked" includes the stack.
+func TestPanicStack_InUnexpectedPanicFailure(t *testing.T) {
+	t.Parallel()
+
+	reporter := failing.NewReporter()
+
+	depMock, depImp := MockCriticalDependency(reporter)
+
+	call := StartUnsafeRunner(reporter, safety.UnsafeRunner, depMock)
+
+	depImp.DoWork.Called().Panic("fatal error")
+
+	go call.Completes()
+
+	msg := reporter.Wait(t)
+
+	for _, want := range []string{"panicked with: fatal error", "panic stack:", "UnsafeRunner("} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("expected failure to contain %q, got:\n%s", want, msg)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+// Code generated by impgen. DO NOT EDIT.
+// impgen:hash:1e4a7b4a345f39fe
+
+package partialstructs_test
+
+import (
+	_imptest "github.com/toejough/imptest"
+	partialstructs "github.com/toejough/imptest/UAT/variations/behavior/partial-structs"
+	_reflect "reflect"
+	_time "time"
+)
+
+type UserStoreImp struct {
+	Save *UserStoreMockSaveMethod
+	// Eventually provides async versions of all methods for concurrent code.
+	Eventually *UserStoreImpEventually
+}
+
+type UserStoreImpEventually struct {
+	Save *UserStoreMockSaveMethod
+}
+
+type UserStoreMockSaveArgs struct {
+	Tenant string
+	User   partialstructs.User
+}
+
+type UserStoreMockSaveCall struct {
+	*_imptest.DependencyCall
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *UserStoreMockSaveCall) GetArgs() UserStoreMockSaveArgs {
+	raw := c.RawArgs()
+	return UserStoreMockSaveArgs{
+		Tenant: raw[0].(string),
+		User:   raw[1].(partialstructs.User),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *UserStoreMockSaveCall) Return(result0 error) {
+	c.DependencyCall.Return(result0)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *UserStoreMockSaveCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
+type UserStoreMockSaveMethod struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *UserStoreMockSaveMethod) ArgsEqual(tenant string, user partialstructs.User) *UserStoreMockSaveCall {
+	call := m.DependencyMethod.ArgsEqual(tenant, user)
+	return &UserStoreMockSaveCall{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *UserStoreMockSaveMethod) ArgsShould(tenant any, user any) *UserStoreMockSaveCall {
+	_call := m.DependencyMethod.ArgsShould(tenant, user)
+	return &UserStoreMockSaveCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *UserStoreMockSaveMethod) ArgsWhere(predicate func(UserStoreMockSaveArgs) error) *UserStoreMockSaveCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args UserStoreMockSaveArgs
+		args.Tenant, _ = raw[0].(string)
+		args.User, _ = raw[1].(partialstructs.User)
+		return predicate(args)
+	})
+	return &UserStoreMockSaveCall{DependencyCall: call}
+}
+
+// MockUserStore creates a mock UserStore and returns (mock, expectation handle).
+func MockUserStore(t _imptest.TestReporter) (partialstructs.UserStore, *UserStoreImp) {
+	ctrl := _imptest.GetOrCreateImp(t)
+	imp := &UserStoreImp{
+		Save: newUserStoreMockSaveMethod(_imptest.NewDependencyMethod(ctrl, "Save").Results(_reflect.TypeFor[error]())),
+	}
+	imp.Eventually = &UserStoreImpEventually{
+		Save: newUserStoreMockSaveMethod(_imptest.NewDependencyMethod(ctrl, "Save").Results(_reflect.TypeFor[error]()).AsEventually()),
+	}
+	mock := &mockUserStoreImpl{ctrl: ctrl}
+	return mock, imp
+}
+
+type mockUserStoreImpl struct {
+	ctrl *_imptest.Imp
+}
+
+// Save implements partialstructs.UserStore.Save.
+func (impl *mockUserStoreImpl) Save(tenant string, user partialstructs.User) error {
+	call := &_imptest.GenericCall{
+		MethodName:   "Save",
+		Args:         []any{tenant, user},
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 error
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(error); ok {
+			result1 = value
+		}
+	}
+
+	return result1
+}
+
+// newUserStoreMockSaveMethod creates a typed method wrapper.
+func newUserStoreMockSaveMethod(dm *_imptest.DependencyMethod) *UserStoreMockSaveMethod {
+	return &UserStoreMockSaveMethod{DependencyMethod: dm}
+}
@@ -0,0 +1,135 @@
+package partialstructs_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/toejough/imptest"
+	"github.com/toejough/imptest/UAT/internal/failing"
+	partialstructs "github.com/toejough/imptest/UAT/variations/behavior/partial-structs"
+	. "github.com/toejough/imptest/match" //nolint:revive // Dot import for matcher DSL
+)
+
+//go:generate impgen partialstructs.UserStore --dependency
+
+// TestArgsWhere_Eventually demonstrates ArgsWhere in Eventually mode, where the
+// predicate is applied to calls as they arrive.
+//
+// Key Requirements Met:
+//  1. Async Support: ArgsWhere registers a pending expectation like ArgsShould.
+func TestArgsWhere_Eventually(t *testing.T) {
+	t.Parallel()
+
+	mock, expect := MockUserStore(t)
+
+	expect.Eventually.Save.ArgsWhere(func(args UserStoreMockSaveArgs) error {
+		if args.User.Name != "carol" {
+			return fmt.Errorf("expected carol, got %q", args.User.Name)
+		}
+
+		return nil
+	}).Return(nil)
+
+	go func() { _ = partialstructs.Register(mock, "acme", "carol", 40, "Oslo") }()
+
+	imptest.Wait(t)
+}
+
+// TestArgsWhere_TypedPredicate demonstrates checking all of a call's arguments at once
+// through the generated typed Args struct.
+//
+// Key Requirements Met:
+//  1. Type Safety: the predicate receives UserStoreMockSaveArgs, not []any.
+//  2. Cross-Argument Checks: conditions can relate one argument to another.
+//  3. Readable Failures: the predicate's error is reported as the mismatch.
+func TestArgsWhere_TypedPredicate(t *testing.T) {
+	t.Parallel()
+
+	tenantMatchesCity := func(args UserStoreMockSaveArgs) error {
+		if !strings.EqualFold(args.Tenant, args.User.Address.City) {
+			return fmt.Errorf("tenant %q does not match city %q", args.Tenant, args.User.Address.City)
+		}
+
+		return nil
+	}
+
+	mock, expect := MockUserStore(t)
+
+	go func() { _ = partialstructs.Register(mock, "paris", "bob", 30, "Paris") }()
+
+	expect.Save.ArgsWhere(tenantMatchesCity).Return(nil)
+
+	reporter := failing.NewReporter()
+	mock, expect = MockUserStore(reporter)
+
+	go func() { _ = partialstructs.Register(mock, "lyon", "bob", 30, "Paris") }()
+
+	go func() { expect.Save.ArgsWhere(tenantMatchesCity).Return(errors.New("unreachable")) }()
+
+	reporter.AssertFailure(t, `method "Save": tenant "lyon" does not match city "Paris"`)
+}
+
+// TestFields_PartialMatch demonstrates matching a struct argument on only the fields
+// that matter, including fields of a nested struct.
+//
+// Key Requirements Met:
+//  1. Partial Matching: unlisted fields (Zip, Created) are ignored.
+//  2. Nesting: a nested Fields matches the Address struct.
+//  3. Mixed Expectations: fields take matchers or plain values.
+func TestFields_PartialMatch(t *testing.T) {
+	t.Parallel()
+
+	mock, expect := MockUserStore(t)
+
+	go func() { _ = partialstructs.Register(mock, "acme", "bob", 30, "Paris") }()
+
+	expect.Save.ArgsShould("acme", Fields{
+		"Name":    Equal("bob"),
+		"Age":     BeNumerically(">", 18),
+		"Address": Fields{"City": "Paris"},
+	}).Return(nil)
+}
+
+// TestFields_ReportsFieldPaths demonstrates that a Fields mismatch lists every failing
+// field by its full path.
+//
+// Key Requirements Met:
+//  1. Field Paths: nested failures are named like Address.City.
+//  2. Completeness: all failing fields are reported, not just the first.
+func TestFields_ReportsFieldPaths(t *testing.T) {
+	t.Parallel()
+
+	reporter := failing.NewReporter()
+	mock, expect := MockUserStore(reporter)
+
+	go func() { _ = partialstructs.Register(mock, "acme", "bob", 16, "Lyon") }()
+
+	go func() {
+		expect.Save.ArgsShould(BeAny, Fields{
+			"Name":    "bob",
+			"Age":     BeNumerically(">", 18),
+			"Address": Fields{"City": "Paris"},
+		}).Return(nil)
+	}()
+
+	reporter.AssertFailure(t, `arg 1: field Address.City: expected "Paris", got "Lyon"
+field Age: expected 16 to be > 18`)
+}
+
+// TestFields_UnknownField verifies that naming a field the struct lacks is reported as
+// an error rather than a silent mismatch.
+//
+// Key Requirements Met:
+//  1. Typo Safety: a misspelled field name fails with the struct type and path.
+func TestFields_UnknownField(t *testing.T) {
+	t.Parallel()
+
+	want := "field Address.Town: no such field: partialstructs.Address has no field Town"
+
+	_, err := Fields{"Address": Fields{"Town": "Paris"}}.Match(partialstructs.User{})
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Fatalf("expected an unknown field error, got %v", err)
+	}
+}
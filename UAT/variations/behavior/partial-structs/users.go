@@ -0,0 +1,33 @@
+// Package partialstructs demonstrates matching structs by a subset of their fields.
+package partialstructs
+
+import "time"
+
+// Address is a postal address.
+type Address struct {
+	City string
+	Zip  string
+}
+
+// User is a stored user record.
+type User struct {
+	Name    string
+	Age     int
+	Address Address
+	Created time.Time
+}
+
+// UserStore persists users.
+type UserStore interface {
+	Save(tenant string, user User) error
+}
+
+// Register stores a new user for tenant, stamping its creation time.
+func Register(store UserStore, tenant, name string, age int, city string) error {
+	return store.Save(tenant, User{
+		Name:    name,
+		Age:     age,
+		Address: Address{City: city, Zip: "75001"},
+		Created: time.Now(),
+	})
+}
@@ -0,0 +1,55 @@
+// Package responsekinds demonstrates mock responses beyond return and panic:
+// hanging, delayed returns, goroutine exits, and closing previously returned channels.
+package responsekinds
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrTimeout is returned by FetchWithin when the feed does not answer in time.
+var ErrTimeout = errors.New("feed timed out")
+
+type Feed interface {
+	Fetch(id string) (string, error)
+	Subscribe(topic string) (chan string, error)
+	Watch(topic string) (<-chan string, error)
+	Close() error
+}
+
+// Collect drains a subscription until the feed closes it.
+func Collect(feed Feed, topic string) ([]string, error) {
+	updates, err := feed.Subscribe(topic)
+	if err != nil {
+		return nil, err
+	}
+
+	var got []string
+	for update := range updates {
+		got = append(got, update)
+	}
+
+	return got, nil
+}
+
+// FetchWithin fetches id, giving up with ErrTimeout after timeout.
+func FetchWithin(feed Feed, id string, timeout time.Duration) (string, error) {
+	type result struct {
+		value string
+		err   error
+	}
+
+	done := make(chan result, 1)
+
+	go func() {
+		value, err := feed.Fetch(id)
+		done <- result{value, err}
+	}()
+
+	select {
+	case res := <-done:
+		return res.value, res.err
+	case <-time.After(timeout):
+		return "", ErrTimeout
+	}
+}
@@ -0,0 +1,366 @@
+// Code generated by impgen. DO NOT EDIT.
+// impgen:hash:48a2d8d488a60463
+
+package responsekinds_test
+
+import (
+	_imptest "github.com/toejough/imptest"
+	responsekinds "github.com/toejough/imptest/UAT/variations/behavior/response-kinds"
+	_reflect "reflect"
+	_time "time"
+)
+
+type FeedImp struct {
+	Fetch     *FeedMockFetchMethod
+	Subscribe *FeedMockSubscribeMethod
+	Watch     *FeedMockWatchMethod
+	Close     *_imptest.DependencyMethod
+	// Eventually provides async versions of all methods for concurrent code.
+	Eventually *FeedImpEventually
+}
+
+type FeedImpEventually struct {
+	Fetch     *FeedMockFetchMethod
+	Subscribe *FeedMockSubscribeMethod
+	Watch     *FeedMockWatchMethod
+	Close     *_imptest.DependencyMethod
+}
+
+type FeedMockCloseCall struct {
+	*_imptest.DependencyCall
+}
+
+// CloseReturnedChannels closes the channels returned by earlier mock calls, then returns the typed values.
+func (c *FeedMockCloseCall) CloseReturnedChannels(result0 error) {
+	c.DependencyCall.CloseReturnedChannels(result0)
+}
+
+// Return specifies the typed values the mock should return.
+func (c *FeedMockCloseCall) Return(result0 error) {
+	c.DependencyCall.Return(result0)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *FeedMockCloseCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
+type FeedMockFetchArgs struct {
+	Id string
+}
+
+type FeedMockFetchCall struct {
+	*_imptest.DependencyCall
+}
+
+// CloseReturnedChannels closes the channels returned by earlier mock calls, then returns the typed values.
+func (c *FeedMockFetchCall) CloseReturnedChannels(result0 string, result1 error) {
+	c.DependencyCall.CloseReturnedChannels(result0, result1)
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *FeedMockFetchCall) GetArgs() FeedMockFetchArgs {
+	raw := c.RawArgs()
+	return FeedMockFetchArgs{
+		Id: raw[0].(string),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *FeedMockFetchCall) Return(result0 string, result1 error) {
+	c.DependencyCall.Return(result0, result1)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *FeedMockFetchCall) ReturnAfter(d _time.Duration, result0 string, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
+type FeedMockFetchMethod struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *FeedMockFetchMethod) ArgsEqual(id string) *FeedMockFetchCall {
+	call := m.DependencyMethod.ArgsEqual(id)
+	return &FeedMockFetchCall{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *FeedMockFetchMethod) ArgsShould(id any) *FeedMockFetchCall {
+	_call := m.DependencyMethod.ArgsShould(id)
+	return &FeedMockFetchCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *FeedMockFetchMethod) ArgsWhere(predicate func(FeedMockFetchArgs) error) *FeedMockFetchCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args FeedMockFetchArgs
+		args.Id, _ = raw[0].(string)
+		return predicate(args)
+	})
+	return &FeedMockFetchCall{DependencyCall: call}
+}
+
+type FeedMockSubscribeArgs struct {
+	Topic string
+}
+
+type FeedMockSubscribeCall struct {
+	*_imptest.DependencyCall
+}
+
+// CloseReturnedChannels closes the channels returned by earlier mock calls, then returns the typed values.
+func (c *FeedMockSubscribeCall) CloseReturnedChannels(result0 chan string, result1 error) {
+	c.DependencyCall.CloseReturnedChannels(result0, result1)
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *FeedMockSubscribeCall) GetArgs() FeedMockSubscribeArgs {
+	raw := c.RawArgs()
+	return FeedMockSubscribeArgs{
+		Topic: raw[0].(string),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *FeedMockSubscribeCall) Return(result0 chan string, result1 error) {
+	c.DependencyCall.Return(result0, result1)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *FeedMockSubscribeCall) ReturnAfter(d _time.Duration, result0 chan string, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
+type FeedMockSubscribeMethod struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *FeedMockSubscribeMethod) ArgsEqual(topic string) *FeedMockSubscribeCall {
+	call := m.DependencyMethod.ArgsEqual(topic)
+	return &FeedMockSubscribeCall{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *FeedMockSubscribeMethod) ArgsShould(topic any) *FeedMockSubscribeCall {
+	_call := m.DependencyMethod.ArgsShould(topic)
+	return &FeedMockSubscribeCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *FeedMockSubscribeMethod) ArgsWhere(predicate func(FeedMockSubscribeArgs) error) *FeedMockSubscribeCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args FeedMockSubscribeArgs
+		args.Topic, _ = raw[0].(string)
+		return predicate(args)
+	})
+	return &FeedMockSubscribeCall{DependencyCall: call}
+}
+
+type FeedMockWatchArgs struct {
+	Topic string
+}
+
+type FeedMockWatchCall struct {
+	*_imptest.DependencyCall
+}
+
+// CloseReturnedChannels closes the channels returned by earlier mock calls, then returns the typed values.
+func (c *FeedMockWatchCall) CloseReturnedChannels(result0 <-chan string, result1 error) {
+	c.DependencyCall.CloseReturnedChannels(result0, result1)
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *FeedMockWatchCall) GetArgs() FeedMockWatchArgs {
+	raw := c.RawArgs()
+	return FeedMockWatchArgs{
+		Topic: raw[0].(string),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *FeedMockWatchCall) Return(result0 <-chan string, result1 error) {
+	c.DependencyCall.Return(result0, result1)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *FeedMockWatchCall) ReturnAfter(d _time.Duration, result0 <-chan string, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
+type FeedMockWatchMethod struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *FeedMockWatchMethod) ArgsEqual(topic string) *FeedMockWatchCall {
+	call := m.DependencyMethod.ArgsEqual(topic)
+	return &FeedMockWatchCall{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *FeedMockWatchMethod) ArgsShould(topic any) *FeedMockWatchCall {
+	_call := m.DependencyMethod.ArgsShould(topic)
+	return &FeedMockWatchCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *FeedMockWatchMethod) ArgsWhere(predicate func(FeedMockWatchArgs) error) *FeedMockWatchCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args FeedMockWatchArgs
+		args.Topic, _ = raw[0].(string)
+		return predicate(args)
+	})
+	return &FeedMockWatchCall{DependencyCall: call}
+}
+
+// MockFeed creates a mock Feed and returns (mock, expectation handle).
+func MockFeed(t _imptest.TestReporter) (responsekinds.Feed, *FeedImp) {
+	ctrl := _imptest.GetOrCreateImp(t)
+	imp := &FeedImp{
+		Fetch:     newFeedMockFetchMethod(_imptest.NewDependencyMethod(ctrl, "Fetch").Results(_reflect.TypeFor[string](), _reflect.TypeFor[error]())),
+		Subscribe: newFeedMockSubscribeMethod(_imptest.NewDependencyMethod(ctrl, "Subscribe").Results(_reflect.TypeFor[chan string](), _reflect.TypeFor[error]())),
+		Watch:     newFeedMockWatchMethod(_imptest.NewDependencyMethod(ctrl, "Watch").Results(_reflect.TypeFor[<-chan string](), _reflect.TypeFor[error]())),
+		Close:     _imptest.NewDependencyMethod(ctrl, "Close").Results(_reflect.TypeFor[error]()),
+	}
+	imp.Eventually = &FeedImpEventually{
+		Fetch:     newFeedMockFetchMethod(_imptest.NewDependencyMethod(ctrl, "Fetch").Results(_reflect.TypeFor[string](), _reflect.TypeFor[error]()).AsEventually()),
+		Subscribe: newFeedMockSubscribeMethod(_imptest.NewDependencyMethod(ctrl, "Subscribe").Results(_reflect.TypeFor[chan string](), _reflect.TypeFor[error]()).AsEventually()),
+		Watch:     newFeedMockWatchMethod(_imptest.NewDependencyMethod(ctrl, "Watch").Results(_reflect.TypeFor[<-chan string](), _reflect.TypeFor[error]()).AsEventually()),
+		Close:     _imptest.NewDependencyMethod(ctrl, "Close").Results(_reflect.TypeFor[error]()).AsEventually(),
+	}
+	mock := &mockFeedImpl{ctrl: ctrl}
+	return mock, imp
+}
+
+type mockFeedImpl struct {
+	ctrl *_imptest.Imp
+}
+
+// Close implements responsekinds.Feed.Close.
+func (impl *mockFeedImpl) Close() error {
+	call := &_imptest.GenericCall{
+		MethodName:   "Close",
+		Args:         []any{},
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 error
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(error); ok {
+			result1 = value
+		}
+	}
+
+	return result1
+}
+
+// Fetch implements responsekinds.Feed.Fetch.
+func (impl *mockFeedImpl) Fetch(id string) (string, error) {
+	call := &_imptest.GenericCall{
+		MethodName:   "Fetch",
+		Args:         []any{id},
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 string
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(string); ok {
+			result1 = value
+		}
+	}
+
+	var result2 error
+	if len(resp.ReturnValues) > 1 {
+		if value, ok := resp.ReturnValues[1].(error); ok {
+			result2 = value
+		}
+	}
+
+	return result1, result2
+}
+
+// Subscribe implements responsekinds.Feed.Subscribe.
+func (impl *mockFeedImpl) Subscribe(topic string) (chan string, error) {
+	call := &_imptest.GenericCall{
+		MethodName:   "Subscribe",
+		Args:         []any{topic},
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 chan string
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(chan string); ok {
+			result1 = value
+		}
+	}
+
+	var result2 error
+	if len(resp.ReturnValues) > 1 {
+		if value, ok := resp.ReturnValues[1].(error); ok {
+			result2 = value
+		}
+	}
+
+	return result1, result2
+}
+
+// Watch implements responsekinds.Feed.Watch.
+func (impl *mockFeedImpl) Watch(topic string) (<-chan string, error) {
+	call := &_imptest.GenericCall{
+		MethodName:   "Watch",
+		Args:         []any{topic},
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 <-chan string
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(<-chan string); ok {
+			result1 = value
+		}
+	}
+
+	var result2 error
+	if len(resp.ReturnValues) > 1 {
+		if value, ok := resp.ReturnValues[1].(error); ok {
+			result2 = value
+		}
+	}
+
+	return result1, result2
+}
+
+// newFeedMockFetchMethod creates a typed method wrapper.
+func newFeedMockFetchMethod(dm *_imptest.DependencyMethod) *FeedMockFetchMethod {
+	return &FeedMockFetchMethod{DependencyMethod: dm}
+}
+
+// newFeedMockSubscribeMethod creates a typed method wrapper.
+func newFeedMockSubscribeMethod(dm *_imptest.DependencyMethod) *FeedMockSubscribeMethod {
+	return &FeedMockSubscribeMethod{DependencyMethod: dm}
+}
+
+// newFeedMockWatchMethod creates a typed method wrapper.
+func newFeedMockWatchMethod(dm *_imptest.DependencyMethod) *FeedMockWatchMethod {
+	return &FeedMockWatchMethod{DependencyMethod: dm}
+}
@@ -0,0 +1,160 @@
+// Code generated by impgen. DO NOT EDIT.
+// impgen:hash:6e42309289cfee94
+
+package responsekinds_test
+
+import (
+	_imptest "github.com/toejough/imptest"
+	responsekinds "github.com/toejough/imptest/UAT/variations/behavior/response-kinds"
+	time "time"
+)
+
+type StartFetchWithinCallHandle struct {
+	*_imptest.CallableController[StartFetchWithinReturnsReturn]
+	controller        *_imptest.TargetController
+	pendingCompletion *_imptest.PendingCompletion
+	// Eventually is the async version of this call handle for registering non-blocking expectations.
+	Eventually *StartFetchWithinCallHandleEventually
+}
+
+// GoexitedShould verifies the function exited via runtime.Goexit (e.g. t.FailNow) without
+// returning or panicking. The matcher is applied to the goroutine's stack trace as a string.
+func (h *StartFetchWithinCallHandle) GoexitedShould(matcher any) {
+	h.T.Helper()
+	h.WaitForResponse()
+
+	if h.Goexited {
+		ok, msg := _imptest.MatchValue(string(h.GoexitStack), matcher)
+		if !ok {
+			h.T.Fatalf("goexit stack: %s", msg)
+		}
+		return
+	}
+
+	h.T.Fatalf("expected function to exit via runtime.Goexit, but %s", h.Outcome())
+}
+
+// PanicEquals verifies the function panics with the expected value.
+func (h *StartFetchWithinCallHandle) PanicEquals(expected any) {
+	h.T.Helper()
+	h.WaitForResponse()
+
+	if h.Panicked != nil {
+		ok, msg := _imptest.MatchValue(h.Panicked, expected)
+		if !ok {
+			h.T.Fatalf("panic value: %s", msg)
+		}
+		return
+	}
+
+	h.T.Fatalf("expected function to panic, but %s", h.Outcome())
+}
+
+// PanicShould verifies the function panics with a value matching the given matcher.
+func (h *StartFetchWithinCallHandle) PanicShould(matcher any) {
+	h.T.Helper()
+	h.WaitForResponse()
+
+	if h.Panicked != nil {
+		ok, msg := _imptest.MatchPanic(h.Panicked, h.PanicStack, matcher)
+		if !ok {
+			h.T.Fatalf("panic value: %s", msg)
+		}
+		return
+	}
+
+	h.T.Fatalf("expected function to panic, but %s", h.Outcome())
+}
+
+// ReturnsEqual verifies the function returned the expected values.
+func (h *StartFetchWithinCallHandle) ReturnsEqual(v0 string, v1 error) {
+	h.T.Helper()
+	h.WaitForResponse()
+
+	if h.Returned != nil {
+		if ok, msg := _imptest.EqualValue(h.T, h.Returned.Result0, v0); !ok {
+			h.T.Fatalf("return value 0: %s", msg)
+		}
+		if ok, msg := _imptest.EqualValue(h.T, h.Returned.Result1, v1); !ok {
+			h.T.Fatalf("return value 1: %s", msg)
+		}
+		return
+	}
+
+	h.T.Fatalf("expected function to return, but %s", h.Outcome())
+}
+
+// ReturnsShould verifies the return values match the given matchers.
+func (h *StartFetchWithinCallHandle) ReturnsShould(v0 any, v1 any) {
+	h.T.Helper()
+	h.WaitForResponse()
+
+	if h.Returned != nil {
+		var ok bool
+		var msg string
+		ok, msg = _imptest.MatchValue(h.Returned.Result0, v0)
+		if !ok {
+			h.T.Fatalf("return value 0: %s", msg)
+		}
+		ok, msg = _imptest.MatchValue(h.Returned.Result1, v1)
+		if !ok {
+			h.T.Fatalf("return value 1: %s", msg)
+		}
+		return
+	}
+
+	h.T.Fatalf("expected function to return, but %s", h.Outcome())
+}
+
+type StartFetchWithinCallHandleEventually struct {
+	h *StartFetchWithinCallHandle
+}
+
+// PanicEquals registers an async expectation for a panic value.
+func (e *StartFetchWithinCallHandleEventually) PanicEquals(value any) {
+	e.ensureStarted().ExpectPanic(value)
+}
+
+// ReturnsEqual registers an async expectation for return values.
+func (e *StartFetchWithinCallHandleEventually) ReturnsEqual(values ...any) {
+	e.ensureStarted().ExpectReturn(values...)
+}
+
+func (e *StartFetchWithinCallHandleEventually) ensureStarted() *_imptest.PendingCompletion {
+	if e.h.pendingCompletion == nil {
+		e.h.pendingCompletion = e.h.controller.RegisterPendingCompletion()
+		go func() {
+			e.h.WaitForResponse()
+			e.h.pendingCompletion.SetCompleted(e.h.Completion())
+		}()
+	}
+	return e.h.pendingCompletion
+}
+
+type StartFetchWithinReturnsReturn struct {
+	Result0 string
+	Result1 error
+}
+
+// StartFetchWithin starts the wrapped function in a goroutine for testing.
+func StartFetchWithin(t _imptest.TestReporter, fn func(responsekinds.Feed, string, time.Duration) (string, error), feed responsekinds.Feed, id string, timeout time.Duration) *StartFetchWithinCallHandle {
+	handle := &StartFetchWithinCallHandle{
+		CallableController: _imptest.NewCallableController[StartFetchWithinReturnsReturn](t),
+		controller:         _imptest.NewTargetController(t),
+	}
+	handle.Eventually = &StartFetchWithinCallHandleEventually{h: handle}
+	go func() {
+		_completed := false
+		defer func() {
+			if r := recover(); r != nil {
+				handle.RecordPanic(r)
+			} else if !_completed {
+				handle.RecordGoexit()
+			}
+		}()
+		ret0, ret1 := fn(feed, id, timeout)
+		_completed = true
+		handle.ReturnChan <- StartFetchWithinReturnsReturn{Result0: ret0, Result1: ret1}
+	}()
+	return handle
+}
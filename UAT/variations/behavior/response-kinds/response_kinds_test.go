@@ -0,0 +1,211 @@
+package responsekinds_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/toejough/imptest"
+	"github.com/toejough/imptest/UAT/internal/failing"
+	responsekinds "github.com/toejough/imptest/UAT/variations/behavior/response-kinds"
+)
+
+//go:generate impgen responsekinds.Feed --dependency
+//go:generate impgen responsekinds.FetchWithin --target
+//go:generate impgen responsekinds.Collect --target
+
+// TestCloseReturnedChannels demonstrates simulating a dependency shutting down.
+//
+// Key Requirements Met:
+//  1. Channel Tracking: channels returned by earlier mock calls are remembered.
+//  2. Close on Demand: CloseReturnedChannels closes them all, ending consumers' range loops.
+func TestCloseReturnedChannels(t *testing.T) {
+	t.Parallel()
+
+	mock, expect := MockFeed(t)
+
+	updates := make(chan string, 2)
+	updates <- "a"
+	updates <- "b"
+
+	call := StartCollect(t, responsekinds.Collect, mock, "news")
+	expect.Subscribe.ArgsEqual("news").Return(updates, nil)
+
+	// Requirement: shutting the feed down closes the subscription it handed out.
+	go func() {
+		expect.Close.Called().CloseReturnedChannels(nil)
+	}()
+
+	if err := mock.Close(); err != nil {
+		t.Fatalf("Close returned %v", err)
+	}
+
+	call.ReturnsEqual([]string{"a", "b"}, nil)
+}
+
+// TestCloseReturnedChannels_ReceiveOnly demonstrates that only channels the mock
+// returned as bidirectional can be closed for the test.
+//
+// Key Requirements Met:
+//  1. Clear Failure: a receive-only channel fails the test instead of being closed unsafely.
+func TestCloseReturnedChannels_ReceiveOnly(t *testing.T) {
+	t.Parallel()
+
+	reporter := failing.NewReporter()
+
+	mock, expect := MockFeed(reporter)
+
+	go func() {
+		_, _ = mock.Watch("news")
+		_ = mock.Close()
+	}()
+
+	expect.Watch.ArgsEqual("news").Return(make(chan string), nil)
+
+	go expect.Close.Called().CloseReturnedChannels(nil)
+
+	reporter.AssertFailure(t, "cannot close a <-chan string returned by a mock")
+}
+
+// TestCloseReturnedChannels_ReceiveOnlyEventually demonstrates that the receive-only
+// check also applies to Eventually expectations, whose responses are delivered by the
+// controller rather than the test goroutine.
+//
+// Key Requirements Met:
+//  1. Test Goroutine: the failure is reported where CloseReturnedChannels is called.
+func TestCloseReturnedChannels_ReceiveOnlyEventually(t *testing.T) {
+	t.Parallel()
+
+	reporter := failing.NewReporter()
+
+	mock, expect := MockFeed(reporter)
+
+	go func() {
+		_, _ = mock.Watch("news")
+		_ = mock.Close()
+	}()
+
+	expect.Watch.ArgsEqual("news").Return(make(chan string), nil)
+
+	go expect.Eventually.Close.Called().CloseReturnedChannels(nil)
+
+	reporter.AssertFailure(t, "cannot close a <-chan string returned by a mock")
+}
+
+// TestGoexit demonstrates a dependency that exits the caller's goroutine, as
+// t.FailNow does, instead of returning.
+//
+// Key Requirements Met:
+//  1. Goroutine Exit: the mock calls runtime.Goexit in the calling goroutine.
+//  2. Deferred Cleanup: deferred functions in that goroutine still run.
+func TestGoexit(t *testing.T) {
+	t.Parallel()
+
+	mock, expect := MockFeed(t)
+
+	returned := make(chan bool, 1)
+
+	go func() {
+		exited := true
+		defer func() { returned <- !exited }()
+
+		_, _ = mock.Fetch("id")
+		exited = false
+	}()
+
+	expect.Fetch.ArgsEqual("id").Goexit()
+
+	// Requirement: the caller never resumed after Fetch.
+	if <-returned {
+		t.Fatal("expected Fetch to exit the calling goroutine")
+	}
+}
+
+// TestHang demonstrates a dependency that never answers, for exercising timeouts.
+//
+// Key Requirements Met:
+//  1. Hang: the mock blocks until the test ends instead of returning.
+//  2. Timeout Path: the code under test takes its timeout branch.
+func TestHang(t *testing.T) {
+	t.Parallel()
+
+	mock, expect := MockFeed(t)
+
+	call := StartFetchWithin(t, responsekinds.FetchWithin, mock, "id", 10*time.Millisecond)
+
+	expect.Fetch.ArgsEqual("id").Hang()
+
+	// Requirement: the target gave up waiting.
+	call.ReturnsEqual("", responsekinds.ErrTimeout)
+}
+
+// TestHang_ReleasedAtTestEnd demonstrates that a hung mock doesn't outlive its test.
+//
+// Key Requirements Met:
+//  1. No Leaks: when the test ends, the hung call's goroutine exits instead of blocking forever.
+func TestHang_ReleasedAtTestEnd(t *testing.T) {
+	t.Parallel()
+
+	exited := make(chan struct{})
+
+	t.Run("hang", func(t *testing.T) {
+		mock, expect := MockFeed(t)
+
+		go func() {
+			defer close(exited)
+
+			_, _ = mock.Fetch("id")
+		}()
+
+		expect.Fetch.ArgsEqual("id").Hang()
+	})
+
+	// Requirement: the subtest's cleanup released the hung call.
+	select {
+	case <-exited:
+	case <-time.After(time.Second):
+		t.Fatal("expected the hung Fetch to be released when the test ended")
+	}
+}
+
+// TestReturnAfter demonstrates a dependency that answers slowly.
+//
+// Key Requirements Met:
+//  1. Delayed Return: the mock returns the typed values only once the delay elapses.
+//  2. Timer Integration: the delay is measured with the test's Timer, so a fake timer
+//     decides when it has elapsed.
+func TestReturnAfter(t *testing.T) {
+	t.Parallel()
+
+	const delay = time.Second
+
+	timer := &manualTimer{requested: make(chan time.Duration, 1), fire: make(chan time.Time)}
+	imptest.GetOrCreateImp(t).Timer = timer
+
+	mock, expect := MockFeed(t)
+
+	call := StartFetchWithin(t, responsekinds.FetchWithin, mock, "id", time.Minute)
+
+	expect.Fetch.ArgsEqual("id").ReturnAfter(delay, "value", nil)
+
+	// Requirement: the response waits on the test's Timer for the delay.
+	if requested := <-timer.requested; requested != delay {
+		t.Fatalf("expected ReturnAfter to wait %v, waited %v", delay, requested)
+	}
+
+	timer.fire <- time.Now()
+
+	call.ReturnsEqual("value", nil)
+}
+
+// manualTimer is a fake Timer that reports each requested duration and elapses
+// only when the test sends on fire.
+type manualTimer struct {
+	requested chan time.Duration
+	fire      chan time.Time
+}
+
+func (m *manualTimer) After(d time.Duration) <-chan time.Time {
+	m.requested <- d
+
+	return m.fire
+}
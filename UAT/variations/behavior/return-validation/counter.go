@@ -0,0 +1,8 @@
+// Package returnvalidation demonstrates imptest rejecting injected return values
+// that don't fit the mocked method's signature.
+package returnvalidation
+
+type Counter interface {
+	Count(name string) (int, error)
+	Reset() bool
+}
@@ -0,0 +1,164 @@
+// Code generated by impgen. DO NOT EDIT.
+// impgen:hash:3dc0ee546e28b2d9
+
+package returnvalidation_test
+
+import (
+	_imptest "github.com/toejough/imptest"
+	returnvalidation "github.com/toejough/imptest/UAT/variations/behavior/return-validation"
+	_reflect "reflect"
+	_time "time"
+)
+
+type CounterImp struct {
+	Count *CounterMockCountMethod
+	Reset *_imptest.DependencyMethod
+	// Eventually provides async versions of all methods for concurrent code.
+	Eventually *CounterImpEventually
+}
+
+type CounterImpEventually struct {
+	Count *CounterMockCountMethod
+	Reset *_imptest.DependencyMethod
+}
+
+type CounterMockCountArgs struct {
+	Name string
+}
+
+type CounterMockCountCall struct {
+	*_imptest.DependencyCall
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *CounterMockCountCall) GetArgs() CounterMockCountArgs {
+	raw := c.RawArgs()
+	return CounterMockCountArgs{
+		Name: raw[0].(string),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *CounterMockCountCall) Return(result0 int, result1 error) {
+	c.DependencyCall.Return(result0, result1)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *CounterMockCountCall) ReturnAfter(d _time.Duration, result0 int, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
+type CounterMockCountMethod struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *CounterMockCountMethod) ArgsEqual(name string) *CounterMockCountCall {
+	call := m.DependencyMethod.ArgsEqual(name)
+	return &CounterMockCountCall{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *CounterMockCountMethod) ArgsShould(name any) *CounterMockCountCall {
+	_call := m.DependencyMethod.ArgsShould(name)
+	return &CounterMockCountCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *CounterMockCountMethod) ArgsWhere(predicate func(CounterMockCountArgs) error) *CounterMockCountCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args CounterMockCountArgs
+		args.Name, _ = raw[0].(string)
+		return predicate(args)
+	})
+	return &CounterMockCountCall{DependencyCall: call}
+}
+
+type CounterMockResetCall struct {
+	*_imptest.DependencyCall
+}
+
+// Return specifies the typed values the mock should return.
+func (c *CounterMockResetCall) Return(result0 bool) {
+	c.DependencyCall.Return(result0)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *CounterMockResetCall) ReturnAfter(d _time.Duration, result0 bool) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
+// MockCounter creates a mock Counter and returns (mock, expectation handle).
+func MockCounter(t _imptest.TestReporter) (returnvalidation.Counter, *CounterImp) {
+	ctrl := _imptest.GetOrCreateImp(t)
+	imp := &CounterImp{
+		Count: newCounterMockCountMethod(_imptest.NewDependencyMethod(ctrl, "Count").Results(_reflect.TypeFor[int](), _reflect.TypeFor[error]())),
+		Reset: _imptest.NewDependencyMethod(ctrl, "Reset").Results(_reflect.TypeFor[bool]()),
+	}
+	imp.Eventually = &CounterImpEventually{
+		Count: newCounterMockCountMethod(_imptest.NewDependencyMethod(ctrl, "Count").Results(_reflect.TypeFor[int](), _reflect.TypeFor[error]()).AsEventually()),
+		Reset: _imptest.NewDependencyMethod(ctrl, "Reset").Results(_reflect.TypeFor[bool]()).AsEventually(),
+	}
+	mock := &mockCounterImpl{ctrl: ctrl}
+	return mock, imp
+}
+
+type mockCounterImpl struct {
+	ctrl *_imptest.Imp
+}
+
+// Count implements returnvalidation.Counter.Count.
+func (impl *mockCounterImpl) Count(name string) (int, error) {
+	call := &_imptest.GenericCall{
+		MethodName:   "Count",
+		Args:         []any{name},
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 int
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(int); ok {
+			result1 = value
+		}
+	}
+
+	var result2 error
+	if len(resp.ReturnValues) > 1 {
+		if value, ok := resp.ReturnValues[1].(error); ok {
+			result2 = value
+		}
+	}
+
+	return result1, result2
+}
+
+// Reset implements returnvalidation.Counter.Reset.
+func (impl *mockCounterImpl) Reset() bool {
+	call := &_imptest.GenericCall{
+		MethodName:   "Reset",
+		Args:         []any{},
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 bool
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(bool); ok {
+			result1 = value
+		}
+	}
+
+	return result1
+}
+
+// newCounterMockCountMethod creates a typed method wrapper.
+func newCounterMockCountMethod(dm *_imptest.DependencyMethod) *CounterMockCountMethod {
+	return &CounterMockCountMethod{DependencyMethod: dm}
+}
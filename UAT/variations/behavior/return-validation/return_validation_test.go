@@ -0,0 +1,85 @@
+package returnvalidation_test
+
+import (
+	"testing"
+
+	"github.com/toejough/imptest/UAT/internal/failing"
+	returnvalidation "github.com/toejough/imptest/UAT/variations/behavior/return-validation"
+)
+
+//go:generate impgen returnvalidation.Counter --dependency
+
+// TestReturn_AcceptsNilForNillableResults verifies that untyped nil is a valid
+// value for interface, pointer, slice, map, chan, and func results.
+//
+// Key Requirements Met:
+//  1. Nil Results: nil is accepted for an error result.
+func TestReturn_AcceptsNilForNillableResults(t *testing.T) {
+	t.Parallel()
+
+	mock, expect := MockCounter(t)
+
+	go func() {
+		expect.Count.ArgsEqual("hits").DependencyCall.Return(3, nil)
+	}()
+
+	var counter returnvalidation.Counter = mock
+
+	count, err := counter.Count("hits")
+	if count != 3 || err != nil {
+		t.Fatalf("expected (3, nil), got (%d, %v)", count, err)
+	}
+}
+
+// TestReturn_RejectsWrongCount verifies that too few or too many return values
+// fail the test instead of silently zero-filling.
+//
+// Key Requirements Met:
+//  1. Count Validation: the failure names the method and both counts.
+func TestReturn_RejectsWrongCount(t *testing.T) {
+	t.Parallel()
+
+	reporter := failing.NewReporter()
+	mock, expect := MockCounter(reporter)
+
+	go mock.Reset()
+
+	go expect.Reset.Called().Return(true, false)
+
+	reporter.AssertFailure(t, "Reset: expected 1 return values, got 2")
+}
+
+// TestReturn_RejectsWrongType verifies that a value of the wrong type fails the test
+// instead of silently becoming the result type's zero value.
+//
+// Key Requirements Met:
+//  1. Type Validation: the failure names the method, the index, and the offending value.
+//  2. Untyped Paths: validation applies to the untyped DependencyCall.Return too.
+func TestReturn_RejectsWrongType(t *testing.T) {
+	t.Parallel()
+
+	reporter := failing.NewReporter()
+	mock, expect := MockCounter(reporter)
+
+	go func() { _, _ = mock.Count("hits") }()
+
+	go expect.Count.ArgsEqual("hits").DependencyCall.Return("42", nil)
+
+	reporter.AssertFailure(t, `Count: return value 0: "42" (string) is not assignable to int`)
+}
+
+// TestReturn_RejectsWrongTypeEventually verifies that Eventually expectations are
+// validated when the response is specified, before any call arrives.
+//
+// Key Requirements Met:
+//  1. Early Failure: the mismatch is reported at the Return call site.
+func TestReturn_RejectsWrongTypeEventually(t *testing.T) {
+	t.Parallel()
+
+	reporter := failing.NewReporter()
+	_, expect := MockCounter(reporter)
+
+	go expect.Eventually.Reset.Called().Return(1)
+
+	reporter.AssertFailure(t, "Reset: return value 0: 1 (int) is not assignable to bool")
+}
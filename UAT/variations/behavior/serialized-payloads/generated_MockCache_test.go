@@ -0,0 +1,121 @@
+// Code generated by impgen. DO NOT EDIT.
+// impgen:hash:9f5538e2f25bef53
+
+package payloads_test
+
+import (
+	_imptest "github.com/toejough/imptest"
+	payloads "github.com/toejough/imptest/UAT/variations/behavior/serialized-payloads"
+	_reflect "reflect"
+	_time "time"
+)
+
+type CacheImp struct {
+	Set *CacheMockSetMethod
+	// Eventually provides async versions of all methods for concurrent code.
+	Eventually *CacheImpEventually
+}
+
+type CacheImpEventually struct {
+	Set *CacheMockSetMethod
+}
+
+type CacheMockSetArgs struct {
+	Key   string
+	Value string
+}
+
+type CacheMockSetCall struct {
+	*_imptest.DependencyCall
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *CacheMockSetCall) GetArgs() CacheMockSetArgs {
+	raw := c.RawArgs()
+	return CacheMockSetArgs{
+		Key:   raw[0].(string),
+		Value: raw[1].(string),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *CacheMockSetCall) Return(result0 error) {
+	c.DependencyCall.Return(result0)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *CacheMockSetCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
+type CacheMockSetMethod struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *CacheMockSetMethod) ArgsEqual(key string, value string) *CacheMockSetCall {
+	call := m.DependencyMethod.ArgsEqual(key, value)
+	return &CacheMockSetCall{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *CacheMockSetMethod) ArgsShould(key any, value any) *CacheMockSetCall {
+	_call := m.DependencyMethod.ArgsShould(key, value)
+	return &CacheMockSetCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *CacheMockSetMethod) ArgsWhere(predicate func(CacheMockSetArgs) error) *CacheMockSetCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args CacheMockSetArgs
+		args.Key, _ = raw[0].(string)
+		args.Value, _ = raw[1].(string)
+		return predicate(args)
+	})
+	return &CacheMockSetCall{DependencyCall: call}
+}
+
+// MockCache creates a mock Cache and returns (mock, expectation handle).
+func MockCache(t _imptest.TestReporter) (payloads.Cache, *CacheImp) {
+	ctrl := _imptest.GetOrCreateImp(t)
+	imp := &CacheImp{
+		Set: newCacheMockSetMethod(_imptest.NewDependencyMethod(ctrl, "Set").Results(_reflect.TypeFor[error]())),
+	}
+	imp.Eventually = &CacheImpEventually{
+		Set: newCacheMockSetMethod(_imptest.NewDependencyMethod(ctrl, "Set").Results(_reflect.TypeFor[error]()).AsEventually()),
+	}
+	mock := &mockCacheImpl{ctrl: ctrl}
+	return mock, imp
+}
+
+type mockCacheImpl struct {
+	ctrl *_imptest.Imp
+}
+
+// Set implements payloads.Cache.Set.
+func (impl *mockCacheImpl) Set(key string, value string) error {
+	call := &_imptest.GenericCall{
+		MethodName:   "Set",
+		Args:         []any{key, value},
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 error
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(error); ok {
+			result1 = value
+		}
+	}
+
+	return result1
+}
+
+// newCacheMockSetMethod creates a typed method wrapper.
+func newCacheMockSetMethod(dm *_imptest.DependencyMethod) *CacheMockSetMethod {
+	return &CacheMockSetMethod{DependencyMethod: dm}
+}
@@ -0,0 +1,127 @@
+// Code generated by impgen. DO NOT EDIT.
+// impgen:hash:0ca08d9d09b14ab3
+
+package payloads_test
+
+import (
+	_imptest "github.com/toejough/imptest"
+	payloads "github.com/toejough/imptest/UAT/variations/behavior/serialized-payloads"
+	_reflect "reflect"
+	_time "time"
+)
+
+type PublisherImp struct {
+	Publish *PublisherMockPublishMethod
+	// Eventually provides async versions of all methods for concurrent code.
+	Eventually *PublisherImpEventually
+}
+
+type PublisherImpEventually struct {
+	Publish *PublisherMockPublishMethod
+}
+
+type PublisherMockPublishArgs struct {
+	Topic string
+	Body  []byte
+}
+
+type PublisherMockPublishCall struct {
+	*_imptest.DependencyCall
+}
+
+// FillBody copies values into the caller's Body slice before the mock returns.
+func (c *PublisherMockPublishCall) FillBody(values []byte) *PublisherMockPublishCall {
+	c.FillArg(1, values)
+	return c
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *PublisherMockPublishCall) GetArgs() PublisherMockPublishArgs {
+	raw := c.RawArgs()
+	return PublisherMockPublishArgs{
+		Topic: raw[0].(string),
+		Body:  raw[1].([]byte),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *PublisherMockPublishCall) Return(result0 error) {
+	c.DependencyCall.Return(result0)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *PublisherMockPublishCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
+type PublisherMockPublishMethod struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *PublisherMockPublishMethod) ArgsEqual(topic string, body []byte) *PublisherMockPublishCall {
+	call := m.DependencyMethod.ArgsEqual(topic, body)
+	return &PublisherMockPublishCall{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *PublisherMockPublishMethod) ArgsShould(topic any, body any) *PublisherMockPublishCall {
+	_call := m.DependencyMethod.ArgsShould(topic, body)
+	return &PublisherMockPublishCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *PublisherMockPublishMethod) ArgsWhere(predicate func(PublisherMockPublishArgs) error) *PublisherMockPublishCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args PublisherMockPublishArgs
+		args.Topic, _ = raw[0].(string)
+		args.Body, _ = raw[1].([]byte)
+		return predicate(args)
+	})
+	return &PublisherMockPublishCall{DependencyCall: call}
+}
+
+// MockPublisher creates a mock Publisher and returns (mock, expectation handle).
+func MockPublisher(t _imptest.TestReporter) (payloads.Publisher, *PublisherImp) {
+	ctrl := _imptest.GetOrCreateImp(t)
+	imp := &PublisherImp{
+		Publish: newPublisherMockPublishMethod(_imptest.NewDependencyMethod(ctrl, "Publish").Results(_reflect.TypeFor[error]())),
+	}
+	imp.Eventually = &PublisherImpEventually{
+		Publish: newPublisherMockPublishMethod(_imptest.NewDependencyMethod(ctrl, "Publish").Results(_reflect.TypeFor[error]()).AsEventually()),
+	}
+	mock := &mockPublisherImpl{ctrl: ctrl}
+	return mock, imp
+}
+
+type mockPublisherImpl struct {
+	ctrl *_imptest.Imp
+}
+
+// Publish implements payloads.Publisher.Publish.
+func (impl *mockPublisherImpl) Publish(topic string, body []byte) error {
+	call := &_imptest.GenericCall{
+		MethodName:   "Publish",
+		Args:         []any{topic, body},
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 error
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(error); ok {
+			result1 = value
+		}
+	}
+
+	return result1
+}
+
+// newPublisherMockPublishMethod creates a typed method wrapper.
+func newPublisherMockPublishMethod(dm *_imptest.DependencyMethod) *PublisherMockPublishMethod {
+	return &PublisherMockPublishMethod{DependencyMethod: dm}
+}
@@ -0,0 +1,156 @@
+package payloads_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/toejough/imptest/UAT/internal/failing"
+	payloads "github.com/toejough/imptest/UAT/variations/behavior/serialized-payloads"
+	. "github.com/toejough/imptest/match" //nolint:revive // Dot import for matcher DSL
+	"github.com/toejough/imptest/match/docmatch"
+)
+
+//go:generate impgen payloads.Publisher --dependency
+//go:generate impgen payloads.Cache --dependency
+
+// TestJSONPath_Fields demonstrates checking individual values inside a JSON payload.
+//
+// Key Requirements Met:
+//  1. Paths: object keys and array indexes select nested values.
+//  2. Plain Values: numbers compare without caring that JSON decodes them as float64.
+//  3. Matchers: selected values can be checked with any matcher.
+func TestJSONPath_Fields(t *testing.T) {
+	t.Parallel()
+
+	publisher, expectPub := MockPublisher(t)
+	cache, expectCache := MockCache(t)
+
+	go func() { _ = payloads.Announce(publisher, cache, newUser()) }()
+
+	expectPub.Publish.ArgsShould("signups", And(
+		JSONPath("$.event", "signup"),
+		JSONPath("$.user.id", 7),
+		JSONPath("$.user.roles[0]", HavePrefix("adm")),
+		JSONPath("$['user']['name']", "ada"),
+	)).Return(nil)
+	expectCache.Set.ArgsShould(BeAny, BeAny).Return(nil)
+}
+
+// TestJSONPath_Messages verifies JSONPath's reporting of missing values and bad paths.
+//
+// Key Requirements Met:
+//  1. Missing Values: the message says which step could not be followed.
+//  2. Path Validation: malformed paths are reported as errors.
+func TestJSONPath_Messages(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"user": {"id": 7, "roles": ["admin"]}}`)
+
+	for _, tc := range []struct {
+		path    string
+		message string
+	}{
+		{"$.user.email", `$.user.email not found: $.user has no key "email"`},
+		{"$.user.roles[3]", "$.user.roles[3] not found: $.user.roles has no index 3 (length 1)"},
+		{"$.user.id.value", "$.user.id.value not found: $.user.id is a number, not an object"},
+		{"$.user.id", "$.user.id: expected 8, got 7"},
+	} {
+		matcher := JSONPath(tc.path, 8)
+
+		matched, err := matcher.Match(body)
+		if err != nil || matched {
+			t.Fatalf("%s: expected no match, got (%v, %v)", tc.path, matched, err)
+		}
+
+		if msg := matcher.FailureMessage(body); !strings.Contains(msg, tc.message) {
+			t.Fatalf("expected failure message containing %q, got %q", tc.message, msg)
+		}
+	}
+
+	_, err := JSONPath("user.id", 7).Match(body)
+	if err == nil || !strings.Contains(err.Error(), `invalid JSON path: "user.id" must start with $`) {
+		t.Fatalf("expected a path error, got %v", err)
+	}
+}
+
+// TestMatchJSON_ReportsDiff demonstrates the semantic diff reported for a JSON mismatch.
+//
+// Key Requirements Met:
+//  1. Semantic Diffs: the failure compares decoded documents, not raw text.
+func TestMatchJSON_ReportsDiff(t *testing.T) {
+	t.Parallel()
+
+	reporter := failing.NewReporter()
+	publisher, expectPub := MockPublisher(reporter)
+	cache, _ := MockCache(reporter)
+
+	go func() { _ = payloads.Announce(publisher, cache, newUser()) }()
+
+	go func() {
+		expectPub.Publish.ArgsShould("signups", docmatch.MatchJSON(`{"event": "login", "user": {"id": 7}}`)).Return(nil)
+	}()
+
+	reporter.AssertFailure(t, "arg 1: JSON mismatch (-want +got):")
+
+	matcher := docmatch.MatchJSON(`{"id": 7, "name": "ada"}`)
+
+	matched, err := matcher.Match(`{"id": 7, "name": "bob"}`)
+	if err != nil || matched {
+		t.Fatalf("expected a mismatch, got (%v, %v)", matched, err)
+	}
+
+	if msg := matcher.FailureMessage(nil); !strings.Contains(msg, `"ada"`) || !strings.Contains(msg, `"bob"`) {
+		t.Fatalf("expected the diff to show both names, got %q", msg)
+	}
+
+	_, err = docmatch.MatchJSON(`{}`).Match("not json")
+	if err == nil || !strings.Contains(err.Error(), "MatchJSON actual value: not valid JSON") {
+		t.Fatalf("expected an invalid JSON error, got %v", err)
+	}
+}
+
+// TestMatchJSON_Semantic demonstrates matching a []byte JSON body regardless of key
+// order and whitespace.
+//
+// Key Requirements Met:
+//  1. Key Order: object keys may appear in any order.
+//  2. Formatting: whitespace and indentation are ignored.
+func TestMatchJSON_Semantic(t *testing.T) {
+	t.Parallel()
+
+	publisher, expectPub := MockPublisher(t)
+	cache, expectCache := MockCache(t)
+
+	go func() { _ = payloads.Announce(publisher, cache, newUser()) }()
+
+	expectPub.Publish.ArgsShould("signups", docmatch.MatchJSON(`{
+		"user": {"roles": ["admin", "ops"], "name": "ada", "id": 7},
+		"event": "signup"
+	}`)).Return(nil)
+	expectCache.Set.ArgsShould(BeAny, BeAny).Return(nil)
+}
+
+// TestMatchYAML_Semantic demonstrates matching a string YAML value against an expected
+// document or Go value.
+//
+// Key Requirements Met:
+//  1. Formatting: flow and block styles, key order, and comments are ignored.
+//  2. Go Values: a non-text expectation is encoded as YAML before comparing.
+func TestMatchYAML_Semantic(t *testing.T) {
+	t.Parallel()
+
+	publisher, expectPub := MockPublisher(t)
+	cache, expectCache := MockCache(t)
+
+	go func() { _ = payloads.Announce(publisher, cache, newUser()) }()
+
+	expectPub.Publish.ArgsShould(BeAny, BeAny).Return(nil)
+	expectCache.Set.ArgsShould("user:7", And(
+		docmatch.MatchYAML("# cached profile\nroles: [admin, ops]\nname: ada\nid: 7\n"),
+		docmatch.MatchYAML(newUser()),
+	)).Return(nil)
+}
+
+func newUser() payloads.User {
+	return payloads.User{ID: 7, Name: "ada", Roles: []string{"admin", "ops"}}
+}
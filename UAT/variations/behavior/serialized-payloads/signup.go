@@ -0,0 +1,46 @@
+// Package payloads demonstrates matching serialized JSON and YAML arguments.
+package payloads
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// User is a registered user.
+type User struct {
+	ID    int      `json:"id"    yaml:"id"`
+	Name  string   `json:"name"  yaml:"name"`
+	Roles []string `json:"roles" yaml:"roles"`
+}
+
+// Publisher sends message bodies to a topic.
+type Publisher interface {
+	Publish(topic string, body []byte) error
+}
+
+// Cache stores string values by key.
+type Cache interface {
+	Set(key, value string) error
+}
+
+// Announce publishes a signup event for user as JSON, then caches the user's profile as YAML.
+func Announce(publisher Publisher, cache Cache, user User) error {
+	body, err := json.Marshal(map[string]any{"event": "signup", "user": user})
+	if err != nil {
+		return err
+	}
+
+	err = publisher.Publish("signups", body)
+	if err != nil {
+		return err
+	}
+
+	profile, err := yaml.Marshal(user)
+	if err != nil {
+		return err
+	}
+
+	return cache.Set(fmt.Sprintf("user:%d", user.ID), string(profile))
+}
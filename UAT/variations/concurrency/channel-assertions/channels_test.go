@@ -0,0 +1,227 @@
+package channels_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/toejough/imptest"
+	"github.com/toejough/imptest/UAT/internal/failing"
+	channels "github.com/toejough/imptest/UAT/variations/concurrency/channel-assertions"
+	. "github.com/toejough/imptest/match" //nolint:revive // Dot import for matcher DSL
+)
+
+//go:generate impgen channels.Queue --dependency
+//go:generate impgen channels.Batch --target
+//go:generate impgen channels.Filter --target
+
+// TestExpectNoReceive_UsesTimer demonstrates that ExpectNoReceive measures its window
+// with the test's Timer, so a fake timer keeps long windows fast.
+//
+// Key Requirements Met:
+//  1. Timer Integration: the window elapses when the Timer says so.
+func TestExpectNoReceive_UsesTimer(t *testing.T) {
+	t.Parallel()
+
+	imptest.GetOrCreateImp(t).Timer = instantTimer{}
+
+	imptest.ExpectNoReceive(t, make(chan channels.Job), time.Hour)
+}
+
+// TestExpectReceive_CapturedChannel demonstrates driving a producer/consumer pipeline
+// through a channel argument captured from a mock call.
+//
+// Key Requirements Met:
+//  1. Blocking Receive: ExpectReceive waits for the value and checks it with a matcher.
+//  2. Negative Check: ExpectNoReceive verifies a filtered job is never forwarded.
+//  3. Typed Result: the received value is returned for further use.
+func TestExpectReceive_CapturedChannel(t *testing.T) {
+	t.Parallel()
+
+	mock, expect := MockQueue(t)
+	call := StartFilter(t, channels.Filter, mock, "builds", 5)
+
+	var deliveries chan<- channels.Job
+
+	expect.Subscribe.ArgsShould("builds", Capture(&deliveries)).Return(nil)
+	call.ReturnsShould(BeAny, BeNil())
+
+	jobs := call.Returned.Result0
+
+	go func() {
+		deliveries <- channels.Job{ID: "lint", Priority: 1}
+		deliveries <- channels.Job{ID: "release", Priority: 9}
+	}()
+
+	job := imptest.ExpectReceive(t, jobs, HaveField("Priority", BeNumerically(">=", 5)))
+	if job.ID != "release" {
+		t.Fatalf("expected the release job, got %q", job.ID)
+	}
+
+	imptest.ExpectNoReceive(t, jobs, 20*time.Millisecond)
+}
+
+// TestExpectReceive_Failures demonstrates how ExpectReceive reports mismatches,
+// closed channels, and timeouts.
+//
+// Key Requirements Met:
+//  1. Matcher Messages: a mismatch reports the matcher's failure message.
+//  2. Closed Channels: receiving the zero value from a closed channel is a failure.
+//  3. Timeouts: the test's SetTimeout bounds the wait.
+func TestExpectReceive_Failures(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name    string
+		setup   func(reporter *failing.Reporter) <-chan channels.Job
+		message string
+	}{
+		{
+			"Mismatch",
+			func(*failing.Reporter) <-chan channels.Job {
+				return channels.Batch([]channels.Job{{ID: "lint", Priority: 1}}, 0)
+			},
+			`received value: field ID: expected "release", got "lint"`,
+		},
+		{
+			"Closed",
+			func(*failing.Reporter) <-chan channels.Job { return channels.Batch(nil, 0) },
+			"expected to receive from <-chan channels.Job, but the channel was closed",
+		},
+		{
+			"Timeout",
+			func(reporter *failing.Reporter) <-chan channels.Job {
+				imptest.SetTimeout(reporter, 20*time.Millisecond)
+
+				return make(chan channels.Job)
+			},
+			"timeout after 20ms waiting to receive from <-chan channels.Job",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			reporter := failing.NewReporter()
+			jobs := tc.setup(reporter)
+
+			go imptest.ExpectReceive(reporter, jobs, HaveField("ID", "release"))
+
+			reporter.AssertFailure(t, tc.message)
+		})
+	}
+}
+
+// TestExpectReceive_Watchdog demonstrates that a receive that never completes fails
+// with the watchdog's state dump.
+//
+// Key Requirements Met:
+//  1. Deadlock Dump: the blocked receive is listed as what the test is waiting on.
+func TestExpectReceive_Watchdog(t *testing.T) {
+	t.Parallel()
+
+	reporter := failing.NewReporter()
+	imptest.SetWatchdogThreshold(reporter, 20*time.Millisecond)
+
+	go imptest.ExpectReceive(reporter, make(<-chan channels.Job), BeAny)
+
+	reporter.AssertFailure(t, "test goroutine is waiting on:\n  - a receive on <-chan channels.Job")
+}
+
+// TestReceive_RepeatedMatch demonstrates that matching the same channel again reuses the
+// value already received, until the call that matched is committed.
+//
+// Key Requirements Met:
+//  1. Idempotent Matching: imptest may evaluate a call's matchers more than once, and a
+//     second evaluation must not consume a second value.
+//  2. Commit Releases: after the match is committed, the next match receives afresh.
+func TestReceive_RepeatedMatch(t *testing.T) {
+	t.Parallel()
+
+	jobs := make(chan channels.Job, 2)
+	jobs <- channels.Job{ID: "lint"}
+	jobs <- channels.Job{ID: "release"}
+
+	matcher := Receive(Fields{"ID": "lint"})
+
+	for range 2 {
+		if matched, err := matcher.Match(jobs); err != nil || !matched {
+			t.Fatalf("expected the lint job to match, got (%v, %v)", matched, err)
+		}
+	}
+
+	committer, ok := matcher.(interface{ Commit(actual any) error })
+	if !ok {
+		t.Fatalf("expected Receive to commit its value, got %T", matcher)
+	}
+
+	if err := committer.Commit(jobs); err != nil {
+		t.Fatalf("expected commit to succeed, got %v", err)
+	}
+
+	if matched, err := matcher.Match(jobs); err != nil || matched {
+		t.Fatalf("expected the release job not to match, got (%v, %v)", matched, err)
+	}
+}
+
+// TestReceive_ReturnedChannel demonstrates matching a channel returned by a target.
+//
+// Key Requirements Met:
+//  1. Non-Blocking Receive: Receive checks the next buffered value with a matcher.
+//  2. Closed Channels: BeClosed succeeds once the channel is drained and closed.
+//  3. Misuse: a send-only channel is reported as an error.
+func TestReceive_ReturnedChannel(t *testing.T) {
+	t.Parallel()
+
+	jobs := []channels.Job{{ID: "lint", Priority: 1}, {ID: "release", Priority: 9}}
+
+	StartBatch(t, channels.Batch, jobs, 5).ReturnsShould(Receive(Fields{"ID": "release"}))
+	StartBatch(t, channels.Batch, jobs, 10).ReturnsShould(BeClosed())
+
+	for _, tc := range []struct {
+		name    string
+		matcher Matcher
+		actual  <-chan channels.Job
+		message string
+	}{
+		{
+			"ReceiveMismatch", Receive(Fields{"ID": "deploy"}), channels.Batch(jobs, 5),
+			`received value: field ID: expected "deploy", got "release"`,
+		},
+		{
+			"ReceiveClosed", Receive(), channels.Batch(jobs, 10),
+			"expected to receive from <-chan channels.Job, but it was closed",
+		},
+		{
+			"BeClosedOpen", BeClosed(), channels.Batch(jobs, 5),
+			"expected <-chan channels.Job to be closed, but received",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			matched, err := tc.matcher.Match(tc.actual)
+			if err != nil || matched {
+				t.Fatalf("expected no match, got (%v, %v)", matched, err)
+			}
+
+			if msg := tc.matcher.FailureMessage(tc.actual); !strings.Contains(msg, tc.message) {
+				t.Fatalf("expected failure message containing %q, got %q", tc.message, msg)
+			}
+		})
+	}
+
+	_, err := Receive().Match(make(chan<- channels.Job))
+	if err == nil || !strings.Contains(err.Error(), "Receive expects a channel, got chan<- channels.Job") {
+		t.Fatalf("expected a send-only channel error, got %v", err)
+	}
+}
+
+// instantTimer is a fake Timer whose durations elapse immediately.
+type instantTimer struct{}
+
+func (instantTimer) After(time.Duration) <-chan time.Time {
+	ch := make(chan time.Time, 1)
+	ch <- time.Now()
+
+	return ch
+}
@@ -0,0 +1,121 @@
+// Code generated by impgen. DO NOT EDIT.
+// impgen:hash:2469d4f1f8ecd33c
+
+package channels_test
+
+import (
+	_imptest "github.com/toejough/imptest"
+	channels "github.com/toejough/imptest/UAT/variations/concurrency/channel-assertions"
+	_reflect "reflect"
+	_time "time"
+)
+
+type QueueImp struct {
+	Subscribe *QueueMockSubscribeMethod
+	// Eventually provides async versions of all methods for concurrent code.
+	Eventually *QueueImpEventually
+}
+
+type QueueImpEventually struct {
+	Subscribe *QueueMockSubscribeMethod
+}
+
+type QueueMockSubscribeArgs struct {
+	Topic      string
+	Deliveries chan<- channels.Job
+}
+
+type QueueMockSubscribeCall struct {
+	*_imptest.DependencyCall
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *QueueMockSubscribeCall) GetArgs() QueueMockSubscribeArgs {
+	raw := c.RawArgs()
+	return QueueMockSubscribeArgs{
+		Topic:      raw[0].(string),
+		Deliveries: raw[1].(chan<- channels.Job),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *QueueMockSubscribeCall) Return(result0 error) {
+	c.DependencyCall.Return(result0)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *QueueMockSubscribeCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
+type QueueMockSubscribeMethod struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *QueueMockSubscribeMethod) ArgsEqual(topic string, deliveries chan<- channels.Job) *QueueMockSubscribeCall {
+	call := m.DependencyMethod.ArgsEqual(topic, deliveries)
+	return &QueueMockSubscribeCall{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *QueueMockSubscribeMethod) ArgsShould(topic any, deliveries any) *QueueMockSubscribeCall {
+	_call := m.DependencyMethod.ArgsShould(topic, deliveries)
+	return &QueueMockSubscribeCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *QueueMockSubscribeMethod) ArgsWhere(predicate func(QueueMockSubscribeArgs) error) *QueueMockSubscribeCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args QueueMockSubscribeArgs
+		args.Topic, _ = raw[0].(string)
+		args.Deliveries, _ = raw[1].(chan<- channels.Job)
+		return predicate(args)
+	})
+	return &QueueMockSubscribeCall{DependencyCall: call}
+}
+
+// MockQueue creates a mock Queue and returns (mock, expectation handle).
+func MockQueue(t _imptest.TestReporter) (channels.Queue, *QueueImp) {
+	ctrl := _imptest.GetOrCreateImp(t)
+	imp := &QueueImp{
+		Subscribe: newQueueMockSubscribeMethod(_imptest.NewDependencyMethod(ctrl, "Subscribe").Results(_reflect.TypeFor[error]())),
+	}
+	imp.Eventually = &QueueImpEventually{
+		Subscribe: newQueueMockSubscribeMethod(_imptest.NewDependencyMethod(ctrl, "Subscribe").Results(_reflect.TypeFor[error]()).AsEventually()),
+	}
+	mock := &mockQueueImpl{ctrl: ctrl}
+	return mock, imp
+}
+
+type mockQueueImpl struct {
+	ctrl *_imptest.Imp
+}
+
+// Subscribe implements channels.Queue.Subscribe.
+func (impl *mockQueueImpl) Subscribe(topic string, deliveries chan<- channels.Job) error {
+	call := &_imptest.GenericCall{
+		MethodName:   "Subscribe",
+		Args:         []any{topic, deliveries},
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 error
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(error); ok {
+			result1 = value
+		}
+	}
+
+	return result1
+}
+
+// newQueueMockSubscribeMethod creates a typed method wrapper.
+func newQueueMockSubscribeMethod(dm *_imptest.DependencyMethod) *QueueMockSubscribeMethod {
+	return &QueueMockSubscribeMethod{DependencyMethod: dm}
+}
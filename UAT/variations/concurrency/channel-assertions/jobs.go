@@ -0,0 +1,52 @@
+// Package channels demonstrates asserting on values sent over channels.
+package channels
+
+// Job is a unit of work delivered by a queue.
+type Job struct {
+	ID       string
+	Priority int
+}
+
+// Queue delivers jobs for a topic on a channel owned by the subscriber.
+type Queue interface {
+	Subscribe(topic string, deliveries chan<- Job) error
+}
+
+// Batch returns a closed, buffered channel holding the jobs with at least minPriority.
+func Batch(jobs []Job, minPriority int) <-chan Job {
+	out := make(chan Job, len(jobs))
+
+	for _, job := range jobs {
+		if job.Priority >= minPriority {
+			out <- job
+		}
+	}
+
+	close(out)
+
+	return out
+}
+
+// Filter subscribes to topic and forwards deliveries with at least minPriority.
+// The returned channel is closed once the queue closes the delivery channel.
+func Filter(queue Queue, topic string, minPriority int) (<-chan Job, error) {
+	deliveries := make(chan Job)
+	out := make(chan Job)
+
+	err := queue.Subscribe(topic, deliveries)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		defer close(out)
+
+		for job := range deliveries {
+			if job.Priority >= minPriority {
+				out <- job
+			}
+		}
+	}()
+
+	return out, nil
+}
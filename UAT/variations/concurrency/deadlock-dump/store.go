@@ -0,0 +1,17 @@
+// Package deadlock demonstrates the watchdog dump imptest prints when a test blocks too long.
+package deadlock
+
+type Store interface {
+	Get(key string) (string, error)
+	Put(key, value string) error
+}
+
+// Touch reads a key and writes it back, creating it if it doesn't exist.
+func Touch(store Store, key string) error {
+	value, err := store.Get(key)
+	if err != nil {
+		return err
+	}
+
+	return store.Put(key, value)
+}
@@ -0,0 +1,77 @@
+package deadlock_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/toejough/imptest"
+	"github.com/toejough/imptest/UAT/internal/failing"
+	deadlock "github.com/toejough/imptest/UAT/variations/concurrency/deadlock-dump"
+)
+
+//go:generate impgen deadlock.Store --dependency
+//go:generate impgen deadlock.Touch --target
+
+// TestWatchdog_DumpsBlockedMockCall verifies the dump for a test stuck waiting on a
+// target whose dependency call never received a response.
+//
+// Key Requirements Met:
+//  1. Blocked Mock Calls: calls matched by an expectation but never answered are listed.
+//  2. Unreturned Wrappers: target wrappers still running are listed.
+//  3. Clean Failure: the test fails via Fatalf instead of hanging until the global timeout.
+func TestWatchdog_DumpsBlockedMockCall(t *testing.T) {
+	t.Parallel()
+
+	reporter := failing.NewReporter()
+	imptest.SetWatchdogThreshold(reporter, 50*time.Millisecond)
+
+	mock, expect := MockStore(reporter)
+	call := StartTouch(reporter, deadlock.Touch, mock, "key")
+
+	// Forget to inject a response, then wait for the target to return.
+	expect.Get.ArgsEqual("key")
+
+	go call.ReturnsEqual(nil)
+
+	dump := reporter.Wait(t)
+
+	assertContains(t, dump, "imptest watchdog")
+	assertContains(t, dump, "StartTouch to return or panic")
+	assertContains(t, dump, "mock calls blocked awaiting a response:\n  - Get(\"key\")")
+	assertContains(t, dump, "target wrappers that have not returned:\n  - StartTouch")
+}
+
+// TestWatchdog_DumpsPendingEventually verifies the dump for a test stuck in Wait.
+//
+// Key Requirements Met:
+//  1. Pending Eventually: unsatisfied Eventually expectations are listed.
+//  2. Queued Calls: calls nobody has claimed are listed with their arguments.
+func TestWatchdog_DumpsPendingEventually(t *testing.T) {
+	t.Parallel()
+
+	reporter := failing.NewReporter()
+	imptest.SetWatchdogThreshold(reporter, 50*time.Millisecond)
+
+	mock, expect := MockStore(reporter)
+	StartTouch(reporter, deadlock.Touch, mock, "key")
+
+	// Expect a Put that can never happen before Get is answered.
+	expect.Eventually.Put.ArgsEqual("key", "value").Return(nil)
+
+	go imptest.Wait(reporter)
+
+	dump := reporter.Wait(t)
+
+	assertContains(t, dump, `Eventually expectation for "Put"`)
+	assertContains(t, dump, "queued calls (not yet claimed by an expectation):\n  - Get(\"key\")")
+	assertContains(t, dump, "pending Eventually expectations:\n  - \"Put\": waiting for a matching call")
+}
+
+func assertContains(t *testing.T, dump, want string) {
+	t.Helper()
+
+	if !strings.Contains(dump, want) {
+		t.Errorf("expected dump to contain %q, got:\n%s", want, dump)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/toejough/imptest"
+	"github.com/toejough/imptest/UAT/internal/failing"
 	concurrency "github.com/toejough/imptest/UAT/variations/concurrency/eventually"
 )
 
@@ -153,3 +154,39 @@ func TestSetTimeoutAPI(t *testing.T) {
 	imp.Eventually.DoA.ArgsEqual(1).Return("ok")
 	imptest.Wait(t)
 }
+
+// TestSetTimeout_Expectations verifies that SetTimeout bounds expectation waits.
+//
+// Key Requirements Met:
+//  1. Ordered Expectations: ArgsEqual fails once no matching call arrives in time.
+//  2. Wait: an unsatisfied Eventually expectation fails Wait in time.
+func TestSetTimeout_Expectations(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Ordered", func(t *testing.T) {
+		t.Parallel()
+
+		reporter := failing.NewReporter()
+		imptest.SetTimeout(reporter, 20*time.Millisecond)
+
+		_, imp := MockSlowService(reporter)
+
+		go imp.DoA.ArgsEqual(1)
+
+		reporter.AssertFailure(t, "timeout waiting for call matching validator")
+	})
+
+	t.Run("Wait", func(t *testing.T) {
+		t.Parallel()
+
+		reporter := failing.NewReporter()
+		imptest.SetTimeout(reporter, 20*time.Millisecond)
+
+		_, imp := MockSlowService(reporter)
+		imp.Eventually.DoB.ArgsEqual(2).Return("never")
+
+		go imptest.Wait(reporter)
+
+		reporter.AssertFailure(t, `timeout after 20ms waiting for Eventually expectation for "DoB"`)
+	})
+}
@@ -6,6 +6,8 @@ package concurrency_test
 import (
 	_imptest "github.com/toejough/imptest"
 	concurrency "github.com/toejough/imptest/UAT/variations/concurrency/eventually"
+	_reflect "reflect"
+	_time "time"
 )
 
 type SlowServiceImp struct {
@@ -41,6 +43,11 @@ func (c *SlowServiceMockDoACall) Return(result0 string) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *SlowServiceMockDoACall) ReturnAfter(d _time.Duration, result0 string) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type SlowServiceMockDoAMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -51,9 +58,21 @@ func (m *SlowServiceMockDoAMethod) ArgsEqual(id int) *SlowServiceMockDoACall {
 	return &SlowServiceMockDoACall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *SlowServiceMockDoAMethod) ArgsShould(matchers ...any) *SlowServiceMockDoACall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *SlowServiceMockDoAMethod) ArgsShould(id any) *SlowServiceMockDoACall {
+	_call := m.DependencyMethod.ArgsShould(id)
+	return &SlowServiceMockDoACall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *SlowServiceMockDoAMethod) ArgsWhere(predicate func(SlowServiceMockDoAArgs) error) *SlowServiceMockDoACall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args SlowServiceMockDoAArgs
+		args.Id, _ = raw[0].(int)
+		return predicate(args)
+	})
 	return &SlowServiceMockDoACall{DependencyCall: call}
 }
 
@@ -78,6 +97,11 @@ func (c *SlowServiceMockDoBCall) Return(result0 string) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *SlowServiceMockDoBCall) ReturnAfter(d _time.Duration, result0 string) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type SlowServiceMockDoBMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -88,9 +112,21 @@ func (m *SlowServiceMockDoBMethod) ArgsEqual(id int) *SlowServiceMockDoBCall {
 	return &SlowServiceMockDoBCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *SlowServiceMockDoBMethod) ArgsShould(matchers ...any) *SlowServiceMockDoBCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *SlowServiceMockDoBMethod) ArgsShould(id any) *SlowServiceMockDoBCall {
+	_call := m.DependencyMethod.ArgsShould(id)
+	return &SlowServiceMockDoBCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *SlowServiceMockDoBMethod) ArgsWhere(predicate func(SlowServiceMockDoBArgs) error) *SlowServiceMockDoBCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args SlowServiceMockDoBArgs
+		args.Id, _ = raw[0].(int)
+		return predicate(args)
+	})
 	return &SlowServiceMockDoBCall{DependencyCall: call}
 }
 
@@ -98,12 +134,12 @@ func (m *SlowServiceMockDoBMethod) ArgsShould(matchers ...any) *SlowServiceMockD
 func MockSlowService(t _imptest.TestReporter) (concurrency.SlowService, *SlowServiceImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &SlowServiceImp{
-		DoA: newSlowServiceMockDoAMethod(_imptest.NewDependencyMethod(ctrl, "DoA")),
-		DoB: newSlowServiceMockDoBMethod(_imptest.NewDependencyMethod(ctrl, "DoB")),
+		DoA: newSlowServiceMockDoAMethod(_imptest.NewDependencyMethod(ctrl, "DoA").Results(_reflect.TypeFor[string]())),
+		DoB: newSlowServiceMockDoBMethod(_imptest.NewDependencyMethod(ctrl, "DoB").Results(_reflect.TypeFor[string]())),
 	}
 	imp.Eventually = &SlowServiceImpEventually{
-		DoA: newSlowServiceMockDoAMethod(_imptest.NewDependencyMethod(ctrl, "DoA").AsEventually()),
-		DoB: newSlowServiceMockDoBMethod(_imptest.NewDependencyMethod(ctrl, "DoB").AsEventually()),
+		DoA: newSlowServiceMockDoAMethod(_imptest.NewDependencyMethod(ctrl, "DoA").Results(_reflect.TypeFor[string]()).AsEventually()),
+		DoB: newSlowServiceMockDoBMethod(_imptest.NewDependencyMethod(ctrl, "DoB").Results(_reflect.TypeFor[string]()).AsEventually()),
 	}
 	mock := &mockSlowServiceImpl{ctrl: ctrl}
 	return mock, imp
@@ -122,9 +158,7 @@ func (impl *mockSlowServiceImpl) DoA(id int) string {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 string
 	if len(resp.ReturnValues) > 0 {
@@ -145,9 +179,7 @@ func (impl *mockSlowServiceImpl) DoB(id int) string {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 string
 	if len(resp.ReturnValues) > 0 {
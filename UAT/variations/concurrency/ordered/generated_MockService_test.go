@@ -6,6 +6,8 @@ package orderedvsmode_test
 import (
 	_imptest "github.com/toejough/imptest"
 	orderedvsmode "github.com/toejough/imptest/UAT/variations/concurrency/ordered"
+	_reflect "reflect"
+	_time "time"
 )
 
 type ServiceImp struct {
@@ -43,6 +45,11 @@ func (c *ServiceMockOperationACall) Return(result0 error) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *ServiceMockOperationACall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type ServiceMockOperationAMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -53,9 +60,21 @@ func (m *ServiceMockOperationAMethod) ArgsEqual(id int) *ServiceMockOperationACa
 	return &ServiceMockOperationACall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *ServiceMockOperationAMethod) ArgsShould(matchers ...any) *ServiceMockOperationACall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *ServiceMockOperationAMethod) ArgsShould(id any) *ServiceMockOperationACall {
+	_call := m.DependencyMethod.ArgsShould(id)
+	return &ServiceMockOperationACall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *ServiceMockOperationAMethod) ArgsWhere(predicate func(ServiceMockOperationAArgs) error) *ServiceMockOperationACall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args ServiceMockOperationAArgs
+		args.Id, _ = raw[0].(int)
+		return predicate(args)
+	})
 	return &ServiceMockOperationACall{DependencyCall: call}
 }
 
@@ -80,6 +99,11 @@ func (c *ServiceMockOperationBCall) Return(result0 error) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *ServiceMockOperationBCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type ServiceMockOperationBMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -90,9 +114,21 @@ func (m *ServiceMockOperationBMethod) ArgsEqual(id int) *ServiceMockOperationBCa
 	return &ServiceMockOperationBCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *ServiceMockOperationBMethod) ArgsShould(matchers ...any) *ServiceMockOperationBCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *ServiceMockOperationBMethod) ArgsShould(id any) *ServiceMockOperationBCall {
+	_call := m.DependencyMethod.ArgsShould(id)
+	return &ServiceMockOperationBCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *ServiceMockOperationBMethod) ArgsWhere(predicate func(ServiceMockOperationBArgs) error) *ServiceMockOperationBCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args ServiceMockOperationBArgs
+		args.Id, _ = raw[0].(int)
+		return predicate(args)
+	})
 	return &ServiceMockOperationBCall{DependencyCall: call}
 }
 
@@ -117,6 +153,11 @@ func (c *ServiceMockOperationCCall) Return(result0 error) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *ServiceMockOperationCCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type ServiceMockOperationCMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -127,9 +168,21 @@ func (m *ServiceMockOperationCMethod) ArgsEqual(id int) *ServiceMockOperationCCa
 	return &ServiceMockOperationCCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *ServiceMockOperationCMethod) ArgsShould(matchers ...any) *ServiceMockOperationCCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *ServiceMockOperationCMethod) ArgsShould(id any) *ServiceMockOperationCCall {
+	_call := m.DependencyMethod.ArgsShould(id)
+	return &ServiceMockOperationCCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *ServiceMockOperationCMethod) ArgsWhere(predicate func(ServiceMockOperationCArgs) error) *ServiceMockOperationCCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args ServiceMockOperationCArgs
+		args.Id, _ = raw[0].(int)
+		return predicate(args)
+	})
 	return &ServiceMockOperationCCall{DependencyCall: call}
 }
 
@@ -137,14 +190,14 @@ func (m *ServiceMockOperationCMethod) ArgsShould(matchers ...any) *ServiceMockOp
 func MockService(t _imptest.TestReporter) (orderedvsmode.Service, *ServiceImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &ServiceImp{
-		OperationA: newServiceMockOperationAMethod(_imptest.NewDependencyMethod(ctrl, "OperationA")),
-		OperationB: newServiceMockOperationBMethod(_imptest.NewDependencyMethod(ctrl, "OperationB")),
-		OperationC: newServiceMockOperationCMethod(_imptest.NewDependencyMethod(ctrl, "OperationC")),
+		OperationA: newServiceMockOperationAMethod(_imptest.NewDependencyMethod(ctrl, "OperationA").Results(_reflect.TypeFor[error]())),
+		OperationB: newServiceMockOperationBMethod(_imptest.NewDependencyMethod(ctrl, "OperationB").Results(_reflect.TypeFor[error]())),
+		OperationC: newServiceMockOperationCMethod(_imptest.NewDependencyMethod(ctrl, "OperationC").Results(_reflect.TypeFor[error]())),
 	}
 	imp.Eventually = &ServiceImpEventually{
-		OperationA: newServiceMockOperationAMethod(_imptest.NewDependencyMethod(ctrl, "OperationA").AsEventually()),
-		OperationB: newServiceMockOperationBMethod(_imptest.NewDependencyMethod(ctrl, "OperationB").AsEventually()),
-		OperationC: newServiceMockOperationCMethod(_imptest.NewDependencyMethod(ctrl, "OperationC").AsEventually()),
+		OperationA: newServiceMockOperationAMethod(_imptest.NewDependencyMethod(ctrl, "OperationA").Results(_reflect.TypeFor[error]()).AsEventually()),
+		OperationB: newServiceMockOperationBMethod(_imptest.NewDependencyMethod(ctrl, "OperationB").Results(_reflect.TypeFor[error]()).AsEventually()),
+		OperationC: newServiceMockOperationCMethod(_imptest.NewDependencyMethod(ctrl, "OperationC").Results(_reflect.TypeFor[error]()).AsEventually()),
 	}
 	mock := &mockServiceImpl{ctrl: ctrl}
 	return mock, imp
@@ -163,9 +216,7 @@ func (impl *mockServiceImpl) OperationA(id int) error {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 error
 	if len(resp.ReturnValues) > 0 {
@@ -186,9 +237,7 @@ func (impl *mockServiceImpl) OperationB(id int) error {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 error
 	if len(resp.ReturnValues) > 0 {
@@ -209,9 +258,7 @@ func (impl *mockServiceImpl) OperationC(id int) error {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 error
 	if len(resp.ReturnValues) > 0 {
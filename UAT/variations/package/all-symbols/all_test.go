@@ -0,0 +1,74 @@
+package ports_test
+
+import (
+	"errors"
+	"testing"
+
+	ports "github.com/toejough/imptest/UAT/variations/package/all-symbols"
+)
+
+// Generate a mock for every exported interface and function type in the package, except AuditLog.
+// Adding an interface to ports.go is picked up by the next go generate, with no new directive.
+//go:generate impgen . --all --func-types --dependency --exclude ^Audit
+
+// TestAll_MocksEveryInterface demonstrates that one --all directive generates mocks for each
+// exported interface in the package.
+//
+// Key Requirements Met:
+//  1. Package discovery: every exported interface gets a MockXxx without its own directive.
+//  2. Function types: --func-types adds exported function types to the discovered set.
+//  3. Filtering: --exclude skips matching names (no MockAuditLog is generated), and type
+//     constraints like Number are never generated.
+func TestAll_MocksEveryInterface(t *testing.T) {
+	t.Parallel()
+
+	users, usersImp := MockUserRepo(t)
+	orders, ordersImp := MockOrderRepo(t)
+	notifier, notifierImp := MockNotifier(t)
+	format, formatImp := MockFormatter(t)
+
+	user := ports.User{ID: 7, Name: "ada"}
+	errChan := make(chan error, 1)
+
+	go func() {
+		errChan <- ports.Welcome(users, orders, notifier, format, user)
+	}()
+
+	usersImp.Save.ArgsEqual(user).Return(nil)
+	ordersImp.Count.ArgsEqual(7).Return(3, nil)
+	formatImp.ArgsEqual(user).Return("Ada")
+	notifierImp.Notify.ArgsEqual(7, "Welcome, Ada! You have 3 orders.").Return(nil)
+
+	err := <-errChan
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+// TestAll_ErrorPropagation demonstrates that the discovered mocks are ordinary generated mocks,
+// with the same response controls as mocks from single-symbol directives.
+//
+// Key Requirements Met:
+//  1. Discovered mocks support error injection like any other mock.
+func TestAll_ErrorPropagation(t *testing.T) {
+	t.Parallel()
+
+	users, usersImp := MockUserRepo(t)
+	orders, _ := MockOrderRepo(t)
+	notifier, _ := MockNotifier(t)
+	format, _ := MockFormatter(t)
+
+	errDisk := errors.New("disk full")
+	errChan := make(chan error, 1)
+
+	go func() {
+		errChan <- ports.Welcome(users, orders, notifier, format, ports.User{ID: 1})
+	}()
+
+	usersImp.Save.ArgsEqual(ports.User{ID: 1}).Return(errDisk)
+
+	err := <-errChan
+	if !errors.Is(err, errDisk) {
+		t.Fatalf("expected %v, got %v", errDisk, err)
+	}
+}
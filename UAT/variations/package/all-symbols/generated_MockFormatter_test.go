@@ -0,0 +1,100 @@
+// Code generated by impgen. DO NOT EDIT.
+// impgen:hash:59594116f888e0ff
+
+package ports_test
+
+import (
+	_imptest "github.com/toejough/imptest"
+	ports "github.com/toejough/imptest/UAT/variations/package/all-symbols"
+	_reflect "reflect"
+	_time "time"
+)
+
+type FormatterMockArgs struct {
+	User ports.User
+}
+
+type FormatterMockCall struct {
+	*_imptest.DependencyCall
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *FormatterMockCall) GetArgs() FormatterMockArgs {
+	raw := c.RawArgs()
+	return FormatterMockArgs{
+		User: raw[0].(ports.User),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *FormatterMockCall) Return(result0 string) {
+	c.DependencyCall.Return(result0)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *FormatterMockCall) ReturnAfter(d _time.Duration, result0 string) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
+type FormatterMockMethod struct {
+	*_imptest.DependencyMethod
+	// Eventually provides async version of this function for concurrent code.
+	Eventually *FormatterMockMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *FormatterMockMethod) ArgsEqual(user ports.User) *FormatterMockCall {
+	call := m.DependencyMethod.ArgsEqual(user)
+	return &FormatterMockCall{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *FormatterMockMethod) ArgsShould(user any) *FormatterMockCall {
+	_call := m.DependencyMethod.ArgsShould(user)
+	return &FormatterMockCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *FormatterMockMethod) ArgsWhere(predicate func(FormatterMockArgs) error) *FormatterMockCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args FormatterMockArgs
+		args.User, _ = raw[0].(ports.User)
+		return predicate(args)
+	})
+	return &FormatterMockCall{DependencyCall: call}
+}
+
+// MockFormatter creates a mock Formatter function and returns (mock, expectation handle).
+func MockFormatter(t _imptest.TestReporter) (func(user ports.User) string, *FormatterMockMethod) {
+	ctrl := _imptest.GetOrCreateImp(t)
+	imp := newFormatterMockMethod(_imptest.NewDependencyMethod(ctrl, "Formatter").Results(_reflect.TypeFor[string]()))
+	mock := func(user ports.User) string {
+		call := &_imptest.GenericCall{
+			MethodName:   "Formatter",
+			Args:         []any{user},
+			ResponseChan: make(chan _imptest.GenericResponse, 1),
+		}
+		ctrl.CallChan <- call
+		resp := <-call.ResponseChan
+		resp.Resolve()
+
+		var result1 string
+		if len(resp.ReturnValues) > 0 {
+			if value, ok := resp.ReturnValues[0].(string); ok {
+				result1 = value
+			}
+		}
+
+		return result1
+	}
+	return mock, imp
+}
+
+// newFormatterMockMethod creates a typed method wrapper with Eventually initialized.
+func newFormatterMockMethod(dm *_imptest.DependencyMethod) *FormatterMockMethod {
+	m := &FormatterMockMethod{DependencyMethod: dm}
+	m.Eventually = &FormatterMockMethod{DependencyMethod: dm.AsEventually()}
+	return m
+}
@@ -0,0 +1,121 @@
+// Code generated by impgen. DO NOT EDIT.
+// impgen:hash:876f0fdee489a35b
+
+package ports_test
+
+import (
+	_imptest "github.com/toejough/imptest"
+	ports "github.com/toejough/imptest/UAT/variations/package/all-symbols"
+	_reflect "reflect"
+	_time "time"
+)
+
+type NotifierImp struct {
+	Notify *NotifierMockNotifyMethod
+	// Eventually provides async versions of all methods for concurrent code.
+	Eventually *NotifierImpEventually
+}
+
+type NotifierImpEventually struct {
+	Notify *NotifierMockNotifyMethod
+}
+
+type NotifierMockNotifyArgs struct {
+	UserID int
+	Msg    string
+}
+
+type NotifierMockNotifyCall struct {
+	*_imptest.DependencyCall
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *NotifierMockNotifyCall) GetArgs() NotifierMockNotifyArgs {
+	raw := c.RawArgs()
+	return NotifierMockNotifyArgs{
+		UserID: raw[0].(int),
+		Msg:    raw[1].(string),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *NotifierMockNotifyCall) Return(result0 error) {
+	c.DependencyCall.Return(result0)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *NotifierMockNotifyCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
+type NotifierMockNotifyMethod struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *NotifierMockNotifyMethod) ArgsEqual(userID int, msg string) *NotifierMockNotifyCall {
+	call := m.DependencyMethod.ArgsEqual(userID, msg)
+	return &NotifierMockNotifyCall{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *NotifierMockNotifyMethod) ArgsShould(userID any, msg any) *NotifierMockNotifyCall {
+	_call := m.DependencyMethod.ArgsShould(userID, msg)
+	return &NotifierMockNotifyCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *NotifierMockNotifyMethod) ArgsWhere(predicate func(NotifierMockNotifyArgs) error) *NotifierMockNotifyCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args NotifierMockNotifyArgs
+		args.UserID, _ = raw[0].(int)
+		args.Msg, _ = raw[1].(string)
+		return predicate(args)
+	})
+	return &NotifierMockNotifyCall{DependencyCall: call}
+}
+
+// MockNotifier creates a mock Notifier and returns (mock, expectation handle).
+func MockNotifier(t _imptest.TestReporter) (ports.Notifier, *NotifierImp) {
+	ctrl := _imptest.GetOrCreateImp(t)
+	imp := &NotifierImp{
+		Notify: newNotifierMockNotifyMethod(_imptest.NewDependencyMethod(ctrl, "Notify").Results(_reflect.TypeFor[error]())),
+	}
+	imp.Eventually = &NotifierImpEventually{
+		Notify: newNotifierMockNotifyMethod(_imptest.NewDependencyMethod(ctrl, "Notify").Results(_reflect.TypeFor[error]()).AsEventually()),
+	}
+	mock := &mockNotifierImpl{ctrl: ctrl}
+	return mock, imp
+}
+
+type mockNotifierImpl struct {
+	ctrl *_imptest.Imp
+}
+
+// Notify implements ports.Notifier.Notify.
+func (impl *mockNotifierImpl) Notify(userID int, msg string) error {
+	call := &_imptest.GenericCall{
+		MethodName:   "Notify",
+		Args:         []any{userID, msg},
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 error
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(error); ok {
+			result1 = value
+		}
+	}
+
+	return result1
+}
+
+// newNotifierMockNotifyMethod creates a typed method wrapper.
+func newNotifierMockNotifyMethod(dm *_imptest.DependencyMethod) *NotifierMockNotifyMethod {
+	return &NotifierMockNotifyMethod{DependencyMethod: dm}
+}
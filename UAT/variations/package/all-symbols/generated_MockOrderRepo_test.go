@@ -0,0 +1,125 @@
+// Code generated by impgen. DO NOT EDIT.
+// impgen:hash:77a90b6d3987123a
+
+package ports_test
+
+import (
+	_imptest "github.com/toejough/imptest"
+	ports "github.com/toejough/imptest/UAT/variations/package/all-symbols"
+	_reflect "reflect"
+	_time "time"
+)
+
+type OrderRepoImp struct {
+	Count *OrderRepoMockCountMethod
+	// Eventually provides async versions of all methods for concurrent code.
+	Eventually *OrderRepoImpEventually
+}
+
+type OrderRepoImpEventually struct {
+	Count *OrderRepoMockCountMethod
+}
+
+type OrderRepoMockCountArgs struct {
+	UserID int
+}
+
+type OrderRepoMockCountCall struct {
+	*_imptest.DependencyCall
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *OrderRepoMockCountCall) GetArgs() OrderRepoMockCountArgs {
+	raw := c.RawArgs()
+	return OrderRepoMockCountArgs{
+		UserID: raw[0].(int),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *OrderRepoMockCountCall) Return(result0 int, result1 error) {
+	c.DependencyCall.Return(result0, result1)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *OrderRepoMockCountCall) ReturnAfter(d _time.Duration, result0 int, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
+type OrderRepoMockCountMethod struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *OrderRepoMockCountMethod) ArgsEqual(userID int) *OrderRepoMockCountCall {
+	call := m.DependencyMethod.ArgsEqual(userID)
+	return &OrderRepoMockCountCall{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *OrderRepoMockCountMethod) ArgsShould(userID any) *OrderRepoMockCountCall {
+	_call := m.DependencyMethod.ArgsShould(userID)
+	return &OrderRepoMockCountCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *OrderRepoMockCountMethod) ArgsWhere(predicate func(OrderRepoMockCountArgs) error) *OrderRepoMockCountCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args OrderRepoMockCountArgs
+		args.UserID, _ = raw[0].(int)
+		return predicate(args)
+	})
+	return &OrderRepoMockCountCall{DependencyCall: call}
+}
+
+// MockOrderRepo creates a mock OrderRepo and returns (mock, expectation handle).
+func MockOrderRepo(t _imptest.TestReporter) (ports.OrderRepo, *OrderRepoImp) {
+	ctrl := _imptest.GetOrCreateImp(t)
+	imp := &OrderRepoImp{
+		Count: newOrderRepoMockCountMethod(_imptest.NewDependencyMethod(ctrl, "Count").Results(_reflect.TypeFor[int](), _reflect.TypeFor[error]())),
+	}
+	imp.Eventually = &OrderRepoImpEventually{
+		Count: newOrderRepoMockCountMethod(_imptest.NewDependencyMethod(ctrl, "Count").Results(_reflect.TypeFor[int](), _reflect.TypeFor[error]()).AsEventually()),
+	}
+	mock := &mockOrderRepoImpl{ctrl: ctrl}
+	return mock, imp
+}
+
+type mockOrderRepoImpl struct {
+	ctrl *_imptest.Imp
+}
+
+// Count implements ports.OrderRepo.Count.
+func (impl *mockOrderRepoImpl) Count(userID int) (int, error) {
+	call := &_imptest.GenericCall{
+		MethodName:   "Count",
+		Args:         []any{userID},
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 int
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(int); ok {
+			result1 = value
+		}
+	}
+
+	var result2 error
+	if len(resp.ReturnValues) > 1 {
+		if value, ok := resp.ReturnValues[1].(error); ok {
+			result2 = value
+		}
+	}
+
+	return result1, result2
+}
+
+// newOrderRepoMockCountMethod creates a typed method wrapper.
+func newOrderRepoMockCountMethod(dm *_imptest.DependencyMethod) *OrderRepoMockCountMethod {
+	return &OrderRepoMockCountMethod{DependencyMethod: dm}
+}
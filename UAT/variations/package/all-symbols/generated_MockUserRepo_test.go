@@ -0,0 +1,209 @@
+// Code generated by impgen. DO NOT EDIT.
+// impgen:hash:b6121d93cb0ddbc3
+
+package ports_test
+
+import (
+	_imptest "github.com/toejough/imptest"
+	ports "github.com/toejough/imptest/UAT/variations/package/all-symbols"
+	_reflect "reflect"
+	_time "time"
+)
+
+type UserRepoImp struct {
+	Get  *UserRepoMockGetMethod
+	Save *UserRepoMockSaveMethod
+	// Eventually provides async versions of all methods for concurrent code.
+	Eventually *UserRepoImpEventually
+}
+
+type UserRepoImpEventually struct {
+	Get  *UserRepoMockGetMethod
+	Save *UserRepoMockSaveMethod
+}
+
+type UserRepoMockGetArgs struct {
+	Id int
+}
+
+type UserRepoMockGetCall struct {
+	*_imptest.DependencyCall
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *UserRepoMockGetCall) GetArgs() UserRepoMockGetArgs {
+	raw := c.RawArgs()
+	return UserRepoMockGetArgs{
+		Id: raw[0].(int),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *UserRepoMockGetCall) Return(result0 ports.User, result1 error) {
+	c.DependencyCall.Return(result0, result1)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *UserRepoMockGetCall) ReturnAfter(d _time.Duration, result0 ports.User, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
+type UserRepoMockGetMethod struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *UserRepoMockGetMethod) ArgsEqual(id int) *UserRepoMockGetCall {
+	call := m.DependencyMethod.ArgsEqual(id)
+	return &UserRepoMockGetCall{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *UserRepoMockGetMethod) ArgsShould(id any) *UserRepoMockGetCall {
+	_call := m.DependencyMethod.ArgsShould(id)
+	return &UserRepoMockGetCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *UserRepoMockGetMethod) ArgsWhere(predicate func(UserRepoMockGetArgs) error) *UserRepoMockGetCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args UserRepoMockGetArgs
+		args.Id, _ = raw[0].(int)
+		return predicate(args)
+	})
+	return &UserRepoMockGetCall{DependencyCall: call}
+}
+
+type UserRepoMockSaveArgs struct {
+	User ports.User
+}
+
+type UserRepoMockSaveCall struct {
+	*_imptest.DependencyCall
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *UserRepoMockSaveCall) GetArgs() UserRepoMockSaveArgs {
+	raw := c.RawArgs()
+	return UserRepoMockSaveArgs{
+		User: raw[0].(ports.User),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *UserRepoMockSaveCall) Return(result0 error) {
+	c.DependencyCall.Return(result0)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *UserRepoMockSaveCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
+type UserRepoMockSaveMethod struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *UserRepoMockSaveMethod) ArgsEqual(user ports.User) *UserRepoMockSaveCall {
+	call := m.DependencyMethod.ArgsEqual(user)
+	return &UserRepoMockSaveCall{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *UserRepoMockSaveMethod) ArgsShould(user any) *UserRepoMockSaveCall {
+	_call := m.DependencyMethod.ArgsShould(user)
+	return &UserRepoMockSaveCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *UserRepoMockSaveMethod) ArgsWhere(predicate func(UserRepoMockSaveArgs) error) *UserRepoMockSaveCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args UserRepoMockSaveArgs
+		args.User, _ = raw[0].(ports.User)
+		return predicate(args)
+	})
+	return &UserRepoMockSaveCall{DependencyCall: call}
+}
+
+// MockUserRepo creates a mock UserRepo and returns (mock, expectation handle).
+func MockUserRepo(t _imptest.TestReporter) (ports.UserRepo, *UserRepoImp) {
+	ctrl := _imptest.GetOrCreateImp(t)
+	imp := &UserRepoImp{
+		Get:  newUserRepoMockGetMethod(_imptest.NewDependencyMethod(ctrl, "Get").Results(_reflect.TypeFor[ports.User](), _reflect.TypeFor[error]())),
+		Save: newUserRepoMockSaveMethod(_imptest.NewDependencyMethod(ctrl, "Save").Results(_reflect.TypeFor[error]())),
+	}
+	imp.Eventually = &UserRepoImpEventually{
+		Get:  newUserRepoMockGetMethod(_imptest.NewDependencyMethod(ctrl, "Get").Results(_reflect.TypeFor[ports.User](), _reflect.TypeFor[error]()).AsEventually()),
+		Save: newUserRepoMockSaveMethod(_imptest.NewDependencyMethod(ctrl, "Save").Results(_reflect.TypeFor[error]()).AsEventually()),
+	}
+	mock := &mockUserRepoImpl{ctrl: ctrl}
+	return mock, imp
+}
+
+type mockUserRepoImpl struct {
+	ctrl *_imptest.Imp
+}
+
+// Get implements ports.UserRepo.Get.
+func (impl *mockUserRepoImpl) Get(id int) (ports.User, error) {
+	call := &_imptest.GenericCall{
+		MethodName:   "Get",
+		Args:         []any{id},
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 ports.User
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(ports.User); ok {
+			result1 = value
+		}
+	}
+
+	var result2 error
+	if len(resp.ReturnValues) > 1 {
+		if value, ok := resp.ReturnValues[1].(error); ok {
+			result2 = value
+		}
+	}
+
+	return result1, result2
+}
+
+// Save implements ports.UserRepo.Save.
+func (impl *mockUserRepoImpl) Save(user ports.User) error {
+	call := &_imptest.GenericCall{
+		MethodName:   "Save",
+		Args:         []any{user},
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 error
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(error); ok {
+			result1 = value
+		}
+	}
+
+	return result1
+}
+
+// newUserRepoMockGetMethod creates a typed method wrapper.
+func newUserRepoMockGetMethod(dm *_imptest.DependencyMethod) *UserRepoMockGetMethod {
+	return &UserRepoMockGetMethod{DependencyMethod: dm}
+}
+
+// newUserRepoMockSaveMethod creates a typed method wrapper.
+func newUserRepoMockSaveMethod(dm *_imptest.DependencyMethod) *UserRepoMockSaveMethod {
+	return &UserRepoMockSaveMethod{DependencyMethod: dm}
+}
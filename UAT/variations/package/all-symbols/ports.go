@@ -0,0 +1,54 @@
+// Package ports demonstrates generating mocks for every exported interface in a package with a single
+// --all directive, instead of one directive per interface.
+package ports
+
+import "fmt"
+
+// AuditLog records security-relevant events. It is excluded from generation by the --exclude pattern.
+type AuditLog interface {
+	Record(event string)
+}
+
+// Formatter renders a user for display. It is only generated because of the --func-types flag.
+type Formatter func(user User) string
+
+// Notifier sends messages to users.
+type Notifier interface {
+	Notify(userID int, msg string) error
+}
+
+// Number is a type constraint, which cannot be mocked and is always skipped.
+type Number interface {
+	~int | ~float64
+}
+
+// OrderRepo stores orders.
+type OrderRepo interface {
+	Count(userID int) (int, error)
+}
+
+type User struct {
+	ID   int
+	Name string
+}
+
+// UserRepo stores users.
+type UserRepo interface {
+	Get(id int) (User, error)
+	Save(user User) error
+}
+
+// Welcome saves the user and sends them a greeting that includes their order count.
+func Welcome(users UserRepo, orders OrderRepo, notifier Notifier, format Formatter, user User) error {
+	err := users.Save(user)
+	if err != nil {
+		return fmt.Errorf("saving user: %w", err)
+	}
+
+	count, err := orders.Count(user.ID)
+	if err != nil {
+		return fmt.Errorf("counting orders: %w", err)
+	}
+
+	return notifier.Notify(user.ID, fmt.Sprintf("Welcome, %s! You have %d orders.", format(user), count))
+}
@@ -6,6 +6,8 @@ package service_test
 import (
 	_imptest "github.com/toejough/imptest"
 	storage "github.com/toejough/imptest/UAT/variations/package/dot-imports/business/storage"
+	_reflect "reflect"
+	_time "time"
 )
 
 type RepositoryImp struct {
@@ -43,6 +45,11 @@ func (c *RepositoryMockDeleteCall) Return(result0 error) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *RepositoryMockDeleteCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type RepositoryMockDeleteMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -53,9 +60,21 @@ func (m *RepositoryMockDeleteMethod) ArgsEqual(key string) *RepositoryMockDelete
 	return &RepositoryMockDeleteCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *RepositoryMockDeleteMethod) ArgsShould(matchers ...any) *RepositoryMockDeleteCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *RepositoryMockDeleteMethod) ArgsShould(key any) *RepositoryMockDeleteCall {
+	_call := m.DependencyMethod.ArgsShould(key)
+	return &RepositoryMockDeleteCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *RepositoryMockDeleteMethod) ArgsWhere(predicate func(RepositoryMockDeleteArgs) error) *RepositoryMockDeleteCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args RepositoryMockDeleteArgs
+		args.Key, _ = raw[0].(string)
+		return predicate(args)
+	})
 	return &RepositoryMockDeleteCall{DependencyCall: call}
 }
 
@@ -80,6 +99,11 @@ func (c *RepositoryMockLoadCall) Return(result0 []byte, result1 error) {
 	c.DependencyCall.Return(result0, result1)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *RepositoryMockLoadCall) ReturnAfter(d _time.Duration, result0 []byte, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
 type RepositoryMockLoadMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -90,9 +114,21 @@ func (m *RepositoryMockLoadMethod) ArgsEqual(key string) *RepositoryMockLoadCall
 	return &RepositoryMockLoadCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *RepositoryMockLoadMethod) ArgsShould(matchers ...any) *RepositoryMockLoadCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *RepositoryMockLoadMethod) ArgsShould(key any) *RepositoryMockLoadCall {
+	_call := m.DependencyMethod.ArgsShould(key)
+	return &RepositoryMockLoadCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *RepositoryMockLoadMethod) ArgsWhere(predicate func(RepositoryMockLoadArgs) error) *RepositoryMockLoadCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args RepositoryMockLoadArgs
+		args.Key, _ = raw[0].(string)
+		return predicate(args)
+	})
 	return &RepositoryMockLoadCall{DependencyCall: call}
 }
 
@@ -105,6 +141,12 @@ type RepositoryMockSaveCall struct {
 	*_imptest.DependencyCall
 }
 
+// FillData copies values into the caller's Data slice before the mock returns.
+func (c *RepositoryMockSaveCall) FillData(values []byte) *RepositoryMockSaveCall {
+	c.FillArg(1, values)
+	return c
+}
+
 // GetArgs returns the typed arguments for this call.
 func (c *RepositoryMockSaveCall) GetArgs() RepositoryMockSaveArgs {
 	raw := c.RawArgs()
@@ -119,6 +161,11 @@ func (c *RepositoryMockSaveCall) Return(result0 error) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *RepositoryMockSaveCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type RepositoryMockSaveMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -129,9 +176,22 @@ func (m *RepositoryMockSaveMethod) ArgsEqual(key string, data []byte) *Repositor
 	return &RepositoryMockSaveCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *RepositoryMockSaveMethod) ArgsShould(matchers ...any) *RepositoryMockSaveCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *RepositoryMockSaveMethod) ArgsShould(key any, data any) *RepositoryMockSaveCall {
+	_call := m.DependencyMethod.ArgsShould(key, data)
+	return &RepositoryMockSaveCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *RepositoryMockSaveMethod) ArgsWhere(predicate func(RepositoryMockSaveArgs) error) *RepositoryMockSaveCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args RepositoryMockSaveArgs
+		args.Key, _ = raw[0].(string)
+		args.Data, _ = raw[1].([]byte)
+		return predicate(args)
+	})
 	return &RepositoryMockSaveCall{DependencyCall: call}
 }
 
@@ -139,14 +199,14 @@ func (m *RepositoryMockSaveMethod) ArgsShould(matchers ...any) *RepositoryMockSa
 func MockRepository(t _imptest.TestReporter) (storage.Repository, *RepositoryImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &RepositoryImp{
-		Save:   newRepositoryMockSaveMethod(_imptest.NewDependencyMethod(ctrl, "Save")),
-		Load:   newRepositoryMockLoadMethod(_imptest.NewDependencyMethod(ctrl, "Load")),
-		Delete: newRepositoryMockDeleteMethod(_imptest.NewDependencyMethod(ctrl, "Delete")),
+		Save:   newRepositoryMockSaveMethod(_imptest.NewDependencyMethod(ctrl, "Save").Results(_reflect.TypeFor[error]())),
+		Load:   newRepositoryMockLoadMethod(_imptest.NewDependencyMethod(ctrl, "Load").Results(_reflect.TypeFor[[]byte](), _reflect.TypeFor[error]())),
+		Delete: newRepositoryMockDeleteMethod(_imptest.NewDependencyMethod(ctrl, "Delete").Results(_reflect.TypeFor[error]())),
 	}
 	imp.Eventually = &RepositoryImpEventually{
-		Save:   newRepositoryMockSaveMethod(_imptest.NewDependencyMethod(ctrl, "Save").AsEventually()),
-		Load:   newRepositoryMockLoadMethod(_imptest.NewDependencyMethod(ctrl, "Load").AsEventually()),
-		Delete: newRepositoryMockDeleteMethod(_imptest.NewDependencyMethod(ctrl, "Delete").AsEventually()),
+		Save:   newRepositoryMockSaveMethod(_imptest.NewDependencyMethod(ctrl, "Save").Results(_reflect.TypeFor[error]()).AsEventually()),
+		Load:   newRepositoryMockLoadMethod(_imptest.NewDependencyMethod(ctrl, "Load").Results(_reflect.TypeFor[[]byte](), _reflect.TypeFor[error]()).AsEventually()),
+		Delete: newRepositoryMockDeleteMethod(_imptest.NewDependencyMethod(ctrl, "Delete").Results(_reflect.TypeFor[error]()).AsEventually()),
 	}
 	mock := &mockRepositoryImpl{ctrl: ctrl}
 	return mock, imp
@@ -165,9 +225,7 @@ func (impl *mockRepositoryImpl) Delete(key string) error {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 error
 	if len(resp.ReturnValues) > 0 {
@@ -188,9 +246,7 @@ func (impl *mockRepositoryImpl) Load(key string) ([]byte, error) {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 []byte
 	if len(resp.ReturnValues) > 0 {
@@ -218,9 +274,7 @@ func (impl *mockRepositoryImpl) Save(key string, data []byte) error {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 error
 	if len(resp.ReturnValues) > 0 {
@@ -6,6 +6,8 @@ package dotimports_test
 import (
 	_imptest "github.com/toejough/imptest"
 	helpers "github.com/toejough/imptest/UAT/variations/package/dot-imports/helpers"
+	_reflect "reflect"
+	_time "time"
 )
 
 type ProcessorImp struct {
@@ -39,6 +41,11 @@ func (c *ProcessorMockProcessCall) Return(result0 string) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *ProcessorMockProcessCall) ReturnAfter(d _time.Duration, result0 string) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type ProcessorMockProcessMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -49,9 +56,21 @@ func (m *ProcessorMockProcessMethod) ArgsEqual(input string) *ProcessorMockProce
 	return &ProcessorMockProcessCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *ProcessorMockProcessMethod) ArgsShould(matchers ...any) *ProcessorMockProcessCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *ProcessorMockProcessMethod) ArgsShould(input any) *ProcessorMockProcessCall {
+	_call := m.DependencyMethod.ArgsShould(input)
+	return &ProcessorMockProcessCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *ProcessorMockProcessMethod) ArgsWhere(predicate func(ProcessorMockProcessArgs) error) *ProcessorMockProcessCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args ProcessorMockProcessArgs
+		args.Input, _ = raw[0].(string)
+		return predicate(args)
+	})
 	return &ProcessorMockProcessCall{DependencyCall: call}
 }
 
@@ -59,10 +78,10 @@ func (m *ProcessorMockProcessMethod) ArgsShould(matchers ...any) *ProcessorMockP
 func MockProcessor(t _imptest.TestReporter) (helpers.Processor, *ProcessorImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &ProcessorImp{
-		Process: newProcessorMockProcessMethod(_imptest.NewDependencyMethod(ctrl, "Process")),
+		Process: newProcessorMockProcessMethod(_imptest.NewDependencyMethod(ctrl, "Process").Results(_reflect.TypeFor[string]())),
 	}
 	imp.Eventually = &ProcessorImpEventually{
-		Process: newProcessorMockProcessMethod(_imptest.NewDependencyMethod(ctrl, "Process").AsEventually()),
+		Process: newProcessorMockProcessMethod(_imptest.NewDependencyMethod(ctrl, "Process").Results(_reflect.TypeFor[string]()).AsEventually()),
 	}
 	mock := &mockProcessorImpl{ctrl: ctrl}
 	return mock, imp
@@ -81,9 +100,7 @@ func (impl *mockProcessorImpl) Process(input string) string {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 string
 	if len(resp.ReturnValues) > 0 {
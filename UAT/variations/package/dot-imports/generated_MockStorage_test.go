@@ -6,6 +6,8 @@ package dotimports_test
 import (
 	_imptest "github.com/toejough/imptest"
 	helpers "github.com/toejough/imptest/UAT/variations/package/dot-imports/helpers"
+	_reflect "reflect"
+	_time "time"
 )
 
 type StorageImp struct {
@@ -41,6 +43,11 @@ func (c *StorageMockLoadCall) Return(result0 string, result1 error) {
 	c.DependencyCall.Return(result0, result1)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *StorageMockLoadCall) ReturnAfter(d _time.Duration, result0 string, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
 type StorageMockLoadMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -51,9 +58,21 @@ func (m *StorageMockLoadMethod) ArgsEqual(key string) *StorageMockLoadCall {
 	return &StorageMockLoadCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *StorageMockLoadMethod) ArgsShould(matchers ...any) *StorageMockLoadCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *StorageMockLoadMethod) ArgsShould(key any) *StorageMockLoadCall {
+	_call := m.DependencyMethod.ArgsShould(key)
+	return &StorageMockLoadCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *StorageMockLoadMethod) ArgsWhere(predicate func(StorageMockLoadArgs) error) *StorageMockLoadCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args StorageMockLoadArgs
+		args.Key, _ = raw[0].(string)
+		return predicate(args)
+	})
 	return &StorageMockLoadCall{DependencyCall: call}
 }
 
@@ -80,6 +99,11 @@ func (c *StorageMockSaveCall) Return(result0 error) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *StorageMockSaveCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type StorageMockSaveMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -90,9 +114,22 @@ func (m *StorageMockSaveMethod) ArgsEqual(key string, value string) *StorageMock
 	return &StorageMockSaveCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *StorageMockSaveMethod) ArgsShould(matchers ...any) *StorageMockSaveCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *StorageMockSaveMethod) ArgsShould(key any, value any) *StorageMockSaveCall {
+	_call := m.DependencyMethod.ArgsShould(key, value)
+	return &StorageMockSaveCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *StorageMockSaveMethod) ArgsWhere(predicate func(StorageMockSaveArgs) error) *StorageMockSaveCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args StorageMockSaveArgs
+		args.Key, _ = raw[0].(string)
+		args.Value, _ = raw[1].(string)
+		return predicate(args)
+	})
 	return &StorageMockSaveCall{DependencyCall: call}
 }
 
@@ -100,12 +137,12 @@ func (m *StorageMockSaveMethod) ArgsShould(matchers ...any) *StorageMockSaveCall
 func MockStorage(t _imptest.TestReporter) (helpers.Storage, *StorageImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &StorageImp{
-		Save: newStorageMockSaveMethod(_imptest.NewDependencyMethod(ctrl, "Save")),
-		Load: newStorageMockLoadMethod(_imptest.NewDependencyMethod(ctrl, "Load")),
+		Save: newStorageMockSaveMethod(_imptest.NewDependencyMethod(ctrl, "Save").Results(_reflect.TypeFor[error]())),
+		Load: newStorageMockLoadMethod(_imptest.NewDependencyMethod(ctrl, "Load").Results(_reflect.TypeFor[string](), _reflect.TypeFor[error]())),
 	}
 	imp.Eventually = &StorageImpEventually{
-		Save: newStorageMockSaveMethod(_imptest.NewDependencyMethod(ctrl, "Save").AsEventually()),
-		Load: newStorageMockLoadMethod(_imptest.NewDependencyMethod(ctrl, "Load").AsEventually()),
+		Save: newStorageMockSaveMethod(_imptest.NewDependencyMethod(ctrl, "Save").Results(_reflect.TypeFor[error]()).AsEventually()),
+		Load: newStorageMockLoadMethod(_imptest.NewDependencyMethod(ctrl, "Load").Results(_reflect.TypeFor[string](), _reflect.TypeFor[error]()).AsEventually()),
 	}
 	mock := &mockStorageImpl{ctrl: ctrl}
 	return mock, imp
@@ -124,9 +161,7 @@ func (impl *mockStorageImpl) Load(key string) (string, error) {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 string
 	if len(resp.ReturnValues) > 0 {
@@ -154,9 +189,7 @@ func (impl *mockStorageImpl) Save(key string, value string) error {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 error
 	if len(resp.ReturnValues) > 0 {
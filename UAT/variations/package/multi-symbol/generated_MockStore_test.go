@@ -0,0 +1,121 @@
+// Code generated by impgen. DO NOT EDIT.
+// impgen:hash:f1f42d727816da05
+
+package stamp_test
+
+import (
+	_imptest "github.com/toejough/imptest"
+	stamp "github.com/toejough/imptest/UAT/variations/package/multi-symbol"
+	_reflect "reflect"
+	_time "time"
+)
+
+type StoreImp struct {
+	Put *StoreMockPutMethod
+	// Eventually provides async versions of all methods for concurrent code.
+	Eventually *StoreImpEventually
+}
+
+type StoreImpEventually struct {
+	Put *StoreMockPutMethod
+}
+
+type StoreMockPutArgs struct {
+	Key   string
+	Value string
+}
+
+type StoreMockPutCall struct {
+	*_imptest.DependencyCall
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *StoreMockPutCall) GetArgs() StoreMockPutArgs {
+	raw := c.RawArgs()
+	return StoreMockPutArgs{
+		Key:   raw[0].(string),
+		Value: raw[1].(string),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *StoreMockPutCall) Return(result0 error) {
+	c.DependencyCall.Return(result0)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *StoreMockPutCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
+type StoreMockPutMethod struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *StoreMockPutMethod) ArgsEqual(key string, value string) *StoreMockPutCall {
+	call := m.DependencyMethod.ArgsEqual(key, value)
+	return &StoreMockPutCall{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *StoreMockPutMethod) ArgsShould(key any, value any) *StoreMockPutCall {
+	_call := m.DependencyMethod.ArgsShould(key, value)
+	return &StoreMockPutCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *StoreMockPutMethod) ArgsWhere(predicate func(StoreMockPutArgs) error) *StoreMockPutCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args StoreMockPutArgs
+		args.Key, _ = raw[0].(string)
+		args.Value, _ = raw[1].(string)
+		return predicate(args)
+	})
+	return &StoreMockPutCall{DependencyCall: call}
+}
+
+// MockStore creates a mock Store and returns (mock, expectation handle).
+func MockStore(t _imptest.TestReporter) (stamp.Store, *StoreImp) {
+	ctrl := _imptest.GetOrCreateImp(t)
+	imp := &StoreImp{
+		Put: newStoreMockPutMethod(_imptest.NewDependencyMethod(ctrl, "Put").Results(_reflect.TypeFor[error]())),
+	}
+	imp.Eventually = &StoreImpEventually{
+		Put: newStoreMockPutMethod(_imptest.NewDependencyMethod(ctrl, "Put").Results(_reflect.TypeFor[error]()).AsEventually()),
+	}
+	mock := &mockStoreImpl{ctrl: ctrl}
+	return mock, imp
+}
+
+type mockStoreImpl struct {
+	ctrl *_imptest.Imp
+}
+
+// Put implements stamp.Store.Put.
+func (impl *mockStoreImpl) Put(key string, value string) error {
+	call := &_imptest.GenericCall{
+		MethodName:   "Put",
+		Args:         []any{key, value},
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 error
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(error); ok {
+			result1 = value
+		}
+	}
+
+	return result1
+}
+
+// newStoreMockPutMethod creates a typed method wrapper.
+func newStoreMockPutMethod(dm *_imptest.DependencyMethod) *StoreMockPutMethod {
+	return &StoreMockPutMethod{DependencyMethod: dm}
+}
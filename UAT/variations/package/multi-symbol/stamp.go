@@ -0,0 +1,20 @@
+// Package stamp demonstrates generating several symbols, with different modes, from a single
+// impgen directive instead of one directive per symbol.
+package stamp
+
+import "strconv"
+
+// Clock reports the current time as a Unix timestamp.
+type Clock interface {
+	Now() int64
+}
+
+// Store persists string values by key.
+type Store interface {
+	Put(key, value string) error
+}
+
+// Touch records the current time under key.
+func Touch(store Store, clock Clock, key string) error {
+	return store.Put(key, strconv.FormatInt(clock.Now(), 10))
+}
@@ -0,0 +1,54 @@
+package stamp_test
+
+import (
+	"errors"
+	"testing"
+
+	stamp "github.com/toejough/imptest/UAT/variations/package/multi-symbol"
+)
+
+// Generate both mocks and the target wrapper from one directive. The package is loaded once for all
+// three symbols; --dependency is the default mode and the :target suffix overrides it for Touch.
+//go:generate impgen stamp.Store stamp.Clock stamp.Touch:target --dependency
+
+// TestTouch demonstrates using mocks and a wrapper generated by a single multi-symbol directive.
+//
+// Key Requirements Met:
+//  1. Multiple Symbols: MockStore, MockClock, and StartTouch all come from one directive.
+//  2. Per-Symbol Modes: the :target suffix generates a wrapper while the rest are mocks.
+func TestTouch(t *testing.T) {
+	t.Parallel()
+
+	store, storeImp := MockStore(t)
+	clock, clockImp := MockClock(t)
+
+	call := StartTouch(t, stamp.Touch, store, clock, "last-seen")
+
+	clockImp.Now.Called().Return(now)
+	storeImp.Put.ArgsEqual("last-seen", "1700000000").Return(nil)
+
+	call.ReturnsEqual(nil)
+}
+
+// TestTouch_StoreError demonstrates that the generated symbols work together on failure paths too.
+//
+// Key Requirements Met:
+//  1. Error Propagation: the wrapper reports the error returned by the Store mock.
+func TestTouch_StoreError(t *testing.T) {
+	t.Parallel()
+
+	errFull := errors.New("store full")
+
+	store, storeImp := MockStore(t)
+	clock, clockImp := MockClock(t)
+
+	call := StartTouch(t, stamp.Touch, store, clock, "last-seen")
+
+	clockImp.Now.Called().Return(now)
+	storeImp.Put.Called().Return(errFull)
+
+	call.ReturnsEqual(errFull)
+}
+
+// now is the timestamp the Clock mock reports.
+const now int64 = 1700000000
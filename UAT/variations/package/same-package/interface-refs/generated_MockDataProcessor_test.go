@@ -6,6 +6,8 @@ package samepackage_test
 import (
 	_imptest "github.com/toejough/imptest"
 	samepackage "github.com/toejough/imptest/UAT/variations/package/same-package/interface-refs"
+	_reflect "reflect"
+	_time "time"
 )
 
 type DataProcessorImp struct {
@@ -45,6 +47,11 @@ func (c *DataProcessorMockProcessCall) Return(result0 error) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *DataProcessorMockProcessCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type DataProcessorMockProcessMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -55,9 +62,22 @@ func (m *DataProcessorMockProcessMethod) ArgsEqual(source samepackage.DataSource
 	return &DataProcessorMockProcessCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *DataProcessorMockProcessMethod) ArgsShould(matchers ...any) *DataProcessorMockProcessCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *DataProcessorMockProcessMethod) ArgsShould(source any, sink any) *DataProcessorMockProcessCall {
+	_call := m.DependencyMethod.ArgsShould(source, sink)
+	return &DataProcessorMockProcessCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *DataProcessorMockProcessMethod) ArgsWhere(predicate func(DataProcessorMockProcessArgs) error) *DataProcessorMockProcessCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args DataProcessorMockProcessArgs
+		args.Source, _ = raw[0].(samepackage.DataSource)
+		args.Sink, _ = raw[1].(samepackage.DataSink)
+		return predicate(args)
+	})
 	return &DataProcessorMockProcessCall{DependencyCall: call}
 }
 
@@ -82,6 +102,11 @@ func (c *DataProcessorMockTransformCall) Return(result0 samepackage.DataSource,
 	c.DependencyCall.Return(result0, result1)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *DataProcessorMockTransformCall) ReturnAfter(d _time.Duration, result0 samepackage.DataSource, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
 type DataProcessorMockTransformMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -92,9 +117,21 @@ func (m *DataProcessorMockTransformMethod) ArgsEqual(input samepackage.DataSourc
 	return &DataProcessorMockTransformCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *DataProcessorMockTransformMethod) ArgsShould(matchers ...any) *DataProcessorMockTransformCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *DataProcessorMockTransformMethod) ArgsShould(input any) *DataProcessorMockTransformCall {
+	_call := m.DependencyMethod.ArgsShould(input)
+	return &DataProcessorMockTransformCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *DataProcessorMockTransformMethod) ArgsWhere(predicate func(DataProcessorMockTransformArgs) error) *DataProcessorMockTransformCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args DataProcessorMockTransformArgs
+		args.Input, _ = raw[0].(samepackage.DataSource)
+		return predicate(args)
+	})
 	return &DataProcessorMockTransformCall{DependencyCall: call}
 }
 
@@ -119,6 +156,11 @@ func (c *DataProcessorMockValidateCall) Return(result0 bool) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *DataProcessorMockValidateCall) ReturnAfter(d _time.Duration, result0 bool) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type DataProcessorMockValidateMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -129,9 +171,21 @@ func (m *DataProcessorMockValidateMethod) ArgsEqual(sink samepackage.DataSink) *
 	return &DataProcessorMockValidateCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *DataProcessorMockValidateMethod) ArgsShould(matchers ...any) *DataProcessorMockValidateCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *DataProcessorMockValidateMethod) ArgsShould(sink any) *DataProcessorMockValidateCall {
+	_call := m.DependencyMethod.ArgsShould(sink)
+	return &DataProcessorMockValidateCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *DataProcessorMockValidateMethod) ArgsWhere(predicate func(DataProcessorMockValidateArgs) error) *DataProcessorMockValidateCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args DataProcessorMockValidateArgs
+		args.Sink, _ = raw[0].(samepackage.DataSink)
+		return predicate(args)
+	})
 	return &DataProcessorMockValidateCall{DependencyCall: call}
 }
 
@@ -139,14 +193,14 @@ func (m *DataProcessorMockValidateMethod) ArgsShould(matchers ...any) *DataProce
 func MockDataProcessor(t _imptest.TestReporter) (samepackage.DataProcessor, *DataProcessorImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &DataProcessorImp{
-		Process:   newDataProcessorMockProcessMethod(_imptest.NewDependencyMethod(ctrl, "Process")),
-		Transform: newDataProcessorMockTransformMethod(_imptest.NewDependencyMethod(ctrl, "Transform")),
-		Validate:  newDataProcessorMockValidateMethod(_imptest.NewDependencyMethod(ctrl, "Validate")),
+		Process:   newDataProcessorMockProcessMethod(_imptest.NewDependencyMethod(ctrl, "Process").Results(_reflect.TypeFor[error]())),
+		Transform: newDataProcessorMockTransformMethod(_imptest.NewDependencyMethod(ctrl, "Transform").Results(_reflect.TypeFor[samepackage.DataSource](), _reflect.TypeFor[error]())),
+		Validate:  newDataProcessorMockValidateMethod(_imptest.NewDependencyMethod(ctrl, "Validate").Results(_reflect.TypeFor[bool]())),
 	}
 	imp.Eventually = &DataProcessorImpEventually{
-		Process:   newDataProcessorMockProcessMethod(_imptest.NewDependencyMethod(ctrl, "Process").AsEventually()),
-		Transform: newDataProcessorMockTransformMethod(_imptest.NewDependencyMethod(ctrl, "Transform").AsEventually()),
-		Validate:  newDataProcessorMockValidateMethod(_imptest.NewDependencyMethod(ctrl, "Validate").AsEventually()),
+		Process:   newDataProcessorMockProcessMethod(_imptest.NewDependencyMethod(ctrl, "Process").Results(_reflect.TypeFor[error]()).AsEventually()),
+		Transform: newDataProcessorMockTransformMethod(_imptest.NewDependencyMethod(ctrl, "Transform").Results(_reflect.TypeFor[samepackage.DataSource](), _reflect.TypeFor[error]()).AsEventually()),
+		Validate:  newDataProcessorMockValidateMethod(_imptest.NewDependencyMethod(ctrl, "Validate").Results(_reflect.TypeFor[bool]()).AsEventually()),
 	}
 	mock := &mockDataProcessorImpl{ctrl: ctrl}
 	return mock, imp
@@ -165,9 +219,7 @@ func (impl *mockDataProcessorImpl) Process(source samepackage.DataSource, sink s
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 error
 	if len(resp.ReturnValues) > 0 {
@@ -188,9 +240,7 @@ func (impl *mockDataProcessorImpl) Transform(input samepackage.DataSource) (same
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 samepackage.DataSource
 	if len(resp.ReturnValues) > 0 {
@@ -218,9 +268,7 @@ func (impl *mockDataProcessorImpl) Validate(sink samepackage.DataSink) bool {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 bool
 	if len(resp.ReturnValues) > 0 {
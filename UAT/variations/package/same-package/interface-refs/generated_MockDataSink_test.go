@@ -6,6 +6,8 @@ package samepackage_test
 import (
 	_imptest "github.com/toejough/imptest"
 	samepackage "github.com/toejough/imptest/UAT/variations/package/same-package/interface-refs"
+	_reflect "reflect"
+	_time "time"
 )
 
 type DataSinkImp struct {
@@ -26,6 +28,12 @@ type DataSinkMockPutDataCall struct {
 	*_imptest.DependencyCall
 }
 
+// FillData copies values into the caller's Data slice before the mock returns.
+func (c *DataSinkMockPutDataCall) FillData(values []byte) *DataSinkMockPutDataCall {
+	c.FillArg(0, values)
+	return c
+}
+
 // GetArgs returns the typed arguments for this call.
 func (c *DataSinkMockPutDataCall) GetArgs() DataSinkMockPutDataArgs {
 	raw := c.RawArgs()
@@ -39,6 +47,11 @@ func (c *DataSinkMockPutDataCall) Return(result0 error) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *DataSinkMockPutDataCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type DataSinkMockPutDataMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -49,9 +62,21 @@ func (m *DataSinkMockPutDataMethod) ArgsEqual(data []byte) *DataSinkMockPutDataC
 	return &DataSinkMockPutDataCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *DataSinkMockPutDataMethod) ArgsShould(matchers ...any) *DataSinkMockPutDataCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *DataSinkMockPutDataMethod) ArgsShould(data any) *DataSinkMockPutDataCall {
+	_call := m.DependencyMethod.ArgsShould(data)
+	return &DataSinkMockPutDataCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *DataSinkMockPutDataMethod) ArgsWhere(predicate func(DataSinkMockPutDataArgs) error) *DataSinkMockPutDataCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args DataSinkMockPutDataArgs
+		args.Data, _ = raw[0].([]byte)
+		return predicate(args)
+	})
 	return &DataSinkMockPutDataCall{DependencyCall: call}
 }
 
@@ -59,10 +84,10 @@ func (m *DataSinkMockPutDataMethod) ArgsShould(matchers ...any) *DataSinkMockPut
 func MockDataSink(t _imptest.TestReporter) (samepackage.DataSink, *DataSinkImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &DataSinkImp{
-		PutData: newDataSinkMockPutDataMethod(_imptest.NewDependencyMethod(ctrl, "PutData")),
+		PutData: newDataSinkMockPutDataMethod(_imptest.NewDependencyMethod(ctrl, "PutData").Results(_reflect.TypeFor[error]())),
 	}
 	imp.Eventually = &DataSinkImpEventually{
-		PutData: newDataSinkMockPutDataMethod(_imptest.NewDependencyMethod(ctrl, "PutData").AsEventually()),
+		PutData: newDataSinkMockPutDataMethod(_imptest.NewDependencyMethod(ctrl, "PutData").Results(_reflect.TypeFor[error]()).AsEventually()),
 	}
 	mock := &mockDataSinkImpl{ctrl: ctrl}
 	return mock, imp
@@ -81,9 +106,7 @@ func (impl *mockDataSinkImpl) PutData(data []byte) error {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 error
 	if len(resp.ReturnValues) > 0 {
@@ -6,6 +6,8 @@ package samepackage_test
 import (
 	_imptest "github.com/toejough/imptest"
 	samepackage "github.com/toejough/imptest/UAT/variations/package/same-package/interface-refs"
+	_reflect "reflect"
+	_time "time"
 )
 
 type DataSourceImp struct {
@@ -27,14 +29,19 @@ func (c *DataSourceMockGetDataCall) Return(result0 []byte, result1 error) {
 	c.DependencyCall.Return(result0, result1)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *DataSourceMockGetDataCall) ReturnAfter(d _time.Duration, result0 []byte, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
 // MockDataSource creates a mock DataSource and returns (mock, expectation handle).
 func MockDataSource(t _imptest.TestReporter) (samepackage.DataSource, *DataSourceImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &DataSourceImp{
-		GetData: _imptest.NewDependencyMethod(ctrl, "GetData"),
+		GetData: _imptest.NewDependencyMethod(ctrl, "GetData").Results(_reflect.TypeFor[[]byte](), _reflect.TypeFor[error]()),
 	}
 	imp.Eventually = &DataSourceImpEventually{
-		GetData: _imptest.NewDependencyMethod(ctrl, "GetData").AsEventually(),
+		GetData: _imptest.NewDependencyMethod(ctrl, "GetData").Results(_reflect.TypeFor[[]byte](), _reflect.TypeFor[error]()).AsEventually(),
 	}
 	mock := &mockDataSourceImpl{ctrl: ctrl}
 	return mock, imp
@@ -53,9 +60,7 @@ func (impl *mockDataSourceImpl) GetData() ([]byte, error) {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 []byte
 	if len(resp.ReturnValues) > 0 {
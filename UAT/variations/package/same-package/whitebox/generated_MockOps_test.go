@@ -5,6 +5,8 @@ package whitebox
 
 import (
 	_imptest "github.com/toejough/imptest"
+	_reflect "reflect"
+	_time "time"
 )
 
 type OpsImp struct {
@@ -40,6 +42,11 @@ func (c *OpsMockPublicMethodCall) Return(result0 int) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *OpsMockPublicMethodCall) ReturnAfter(d _time.Duration, result0 int) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type OpsMockPublicMethodMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -50,9 +57,21 @@ func (m *OpsMockPublicMethodMethod) ArgsEqual(x int) *OpsMockPublicMethodCall {
 	return &OpsMockPublicMethodCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *OpsMockPublicMethodMethod) ArgsShould(matchers ...any) *OpsMockPublicMethodCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *OpsMockPublicMethodMethod) ArgsShould(x any) *OpsMockPublicMethodCall {
+	_call := m.DependencyMethod.ArgsShould(x)
+	return &OpsMockPublicMethodCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *OpsMockPublicMethodMethod) ArgsWhere(predicate func(OpsMockPublicMethodArgs) error) *OpsMockPublicMethodCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args OpsMockPublicMethodArgs
+		args.X, _ = raw[0].(int)
+		return predicate(args)
+	})
 	return &OpsMockPublicMethodCall{DependencyCall: call}
 }
 
@@ -77,6 +96,11 @@ func (c *OpsMockinternalMethodCall) Return(result0 int) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *OpsMockinternalMethodCall) ReturnAfter(d _time.Duration, result0 int) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type OpsMockinternalMethodMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -87,9 +111,21 @@ func (m *OpsMockinternalMethodMethod) ArgsEqual(x int) *OpsMockinternalMethodCal
 	return &OpsMockinternalMethodCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *OpsMockinternalMethodMethod) ArgsShould(matchers ...any) *OpsMockinternalMethodCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *OpsMockinternalMethodMethod) ArgsShould(x any) *OpsMockinternalMethodCall {
+	_call := m.DependencyMethod.ArgsShould(x)
+	return &OpsMockinternalMethodCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *OpsMockinternalMethodMethod) ArgsWhere(predicate func(OpsMockinternalMethodArgs) error) *OpsMockinternalMethodCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args OpsMockinternalMethodArgs
+		args.X, _ = raw[0].(int)
+		return predicate(args)
+	})
 	return &OpsMockinternalMethodCall{DependencyCall: call}
 }
 
@@ -97,12 +133,12 @@ func (m *OpsMockinternalMethodMethod) ArgsShould(matchers ...any) *OpsMockintern
 func MockOps(t _imptest.TestReporter) (Ops, *OpsImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &OpsImp{
-		internalMethod: newOpsMockinternalMethodMethod(_imptest.NewDependencyMethod(ctrl, "internalMethod")),
-		PublicMethod:   newOpsMockPublicMethodMethod(_imptest.NewDependencyMethod(ctrl, "PublicMethod")),
+		internalMethod: newOpsMockinternalMethodMethod(_imptest.NewDependencyMethod(ctrl, "internalMethod").Results(_reflect.TypeFor[int]())),
+		PublicMethod:   newOpsMockPublicMethodMethod(_imptest.NewDependencyMethod(ctrl, "PublicMethod").Results(_reflect.TypeFor[int]())),
 	}
 	imp.Eventually = &OpsImpEventually{
-		internalMethod: newOpsMockinternalMethodMethod(_imptest.NewDependencyMethod(ctrl, "internalMethod").AsEventually()),
-		PublicMethod:   newOpsMockPublicMethodMethod(_imptest.NewDependencyMethod(ctrl, "PublicMethod").AsEventually()),
+		internalMethod: newOpsMockinternalMethodMethod(_imptest.NewDependencyMethod(ctrl, "internalMethod").Results(_reflect.TypeFor[int]()).AsEventually()),
+		PublicMethod:   newOpsMockPublicMethodMethod(_imptest.NewDependencyMethod(ctrl, "PublicMethod").Results(_reflect.TypeFor[int]()).AsEventually()),
 	}
 	mock := &mockOpsImpl{ctrl: ctrl}
 	return mock, imp
@@ -121,9 +157,7 @@ func (impl *mockOpsImpl) PublicMethod(x int) int {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 int
 	if len(resp.ReturnValues) > 0 {
@@ -144,9 +178,7 @@ func (impl *mockOpsImpl) internalMethod(x int) int {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 int
 	if len(resp.ReturnValues) > 0 {
@@ -6,6 +6,7 @@ package timeconflict_test
 import (
 	_imptest "github.com/toejough/imptest"
 	timeconflict "github.com/toejough/imptest/UAT/variations/package/shadowing"
+	_reflect "reflect"
 	time "time"
 )
 
@@ -48,6 +49,11 @@ func (c *SchedulerMockDelayCall) Return(result0 error) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *SchedulerMockDelayCall) ReturnAfter(d time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type SchedulerMockDelayMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -58,9 +64,22 @@ func (m *SchedulerMockDelayMethod) ArgsEqual(taskID string, duration time.Durati
 	return &SchedulerMockDelayCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *SchedulerMockDelayMethod) ArgsShould(matchers ...any) *SchedulerMockDelayCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *SchedulerMockDelayMethod) ArgsShould(taskID any, duration any) *SchedulerMockDelayCall {
+	_call := m.DependencyMethod.ArgsShould(taskID, duration)
+	return &SchedulerMockDelayCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *SchedulerMockDelayMethod) ArgsWhere(predicate func(SchedulerMockDelayArgs) error) *SchedulerMockDelayCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args SchedulerMockDelayArgs
+		args.TaskID, _ = raw[0].(string)
+		args.Duration, _ = raw[1].(time.Duration)
+		return predicate(args)
+	})
 	return &SchedulerMockDelayCall{DependencyCall: call}
 }
 
@@ -85,6 +104,11 @@ func (c *SchedulerMockGetIntervalCall) Return(result0 time.Duration) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *SchedulerMockGetIntervalCall) ReturnAfter(d time.Duration, result0 time.Duration) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type SchedulerMockGetIntervalMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -95,9 +119,21 @@ func (m *SchedulerMockGetIntervalMethod) ArgsEqual(taskID string) *SchedulerMock
 	return &SchedulerMockGetIntervalCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *SchedulerMockGetIntervalMethod) ArgsShould(matchers ...any) *SchedulerMockGetIntervalCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *SchedulerMockGetIntervalMethod) ArgsShould(taskID any) *SchedulerMockGetIntervalCall {
+	_call := m.DependencyMethod.ArgsShould(taskID)
+	return &SchedulerMockGetIntervalCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *SchedulerMockGetIntervalMethod) ArgsWhere(predicate func(SchedulerMockGetIntervalArgs) error) *SchedulerMockGetIntervalCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args SchedulerMockGetIntervalArgs
+		args.TaskID, _ = raw[0].(string)
+		return predicate(args)
+	})
 	return &SchedulerMockGetIntervalCall{DependencyCall: call}
 }
 
@@ -110,6 +146,11 @@ func (c *SchedulerMockNextRunCall) Return(result0 time.Time, result1 error) {
 	c.DependencyCall.Return(result0, result1)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *SchedulerMockNextRunCall) ReturnAfter(d time.Duration, result0 time.Time, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
 type SchedulerMockScheduleAtArgs struct {
 	TaskID string
 	When   time.Time
@@ -133,6 +174,11 @@ func (c *SchedulerMockScheduleAtCall) Return(result0 error) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *SchedulerMockScheduleAtCall) ReturnAfter(d time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type SchedulerMockScheduleAtMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -143,9 +189,22 @@ func (m *SchedulerMockScheduleAtMethod) ArgsEqual(taskID string, when time.Time)
 	return &SchedulerMockScheduleAtCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *SchedulerMockScheduleAtMethod) ArgsShould(matchers ...any) *SchedulerMockScheduleAtCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *SchedulerMockScheduleAtMethod) ArgsShould(taskID any, when any) *SchedulerMockScheduleAtCall {
+	_call := m.DependencyMethod.ArgsShould(taskID, when)
+	return &SchedulerMockScheduleAtCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *SchedulerMockScheduleAtMethod) ArgsWhere(predicate func(SchedulerMockScheduleAtArgs) error) *SchedulerMockScheduleAtCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args SchedulerMockScheduleAtArgs
+		args.TaskID, _ = raw[0].(string)
+		args.When, _ = raw[1].(time.Time)
+		return predicate(args)
+	})
 	return &SchedulerMockScheduleAtCall{DependencyCall: call}
 }
 
@@ -153,16 +212,16 @@ func (m *SchedulerMockScheduleAtMethod) ArgsShould(matchers ...any) *SchedulerMo
 func MockScheduler(t _imptest.TestReporter) (timeconflict.Scheduler, *SchedulerImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &SchedulerImp{
-		ScheduleAt:  newSchedulerMockScheduleAtMethod(_imptest.NewDependencyMethod(ctrl, "ScheduleAt")),
-		Delay:       newSchedulerMockDelayMethod(_imptest.NewDependencyMethod(ctrl, "Delay")),
-		NextRun:     _imptest.NewDependencyMethod(ctrl, "NextRun"),
-		GetInterval: newSchedulerMockGetIntervalMethod(_imptest.NewDependencyMethod(ctrl, "GetInterval")),
+		ScheduleAt:  newSchedulerMockScheduleAtMethod(_imptest.NewDependencyMethod(ctrl, "ScheduleAt").Results(_reflect.TypeFor[error]())),
+		Delay:       newSchedulerMockDelayMethod(_imptest.NewDependencyMethod(ctrl, "Delay").Results(_reflect.TypeFor[error]())),
+		NextRun:     _imptest.NewDependencyMethod(ctrl, "NextRun").Results(_reflect.TypeFor[time.Time](), _reflect.TypeFor[error]()),
+		GetInterval: newSchedulerMockGetIntervalMethod(_imptest.NewDependencyMethod(ctrl, "GetInterval").Results(_reflect.TypeFor[time.Duration]())),
 	}
 	imp.Eventually = &SchedulerImpEventually{
-		ScheduleAt:  newSchedulerMockScheduleAtMethod(_imptest.NewDependencyMethod(ctrl, "ScheduleAt").AsEventually()),
-		Delay:       newSchedulerMockDelayMethod(_imptest.NewDependencyMethod(ctrl, "Delay").AsEventually()),
-		NextRun:     _imptest.NewDependencyMethod(ctrl, "NextRun").AsEventually(),
-		GetInterval: newSchedulerMockGetIntervalMethod(_imptest.NewDependencyMethod(ctrl, "GetInterval").AsEventually()),
+		ScheduleAt:  newSchedulerMockScheduleAtMethod(_imptest.NewDependencyMethod(ctrl, "ScheduleAt").Results(_reflect.TypeFor[error]()).AsEventually()),
+		Delay:       newSchedulerMockDelayMethod(_imptest.NewDependencyMethod(ctrl, "Delay").Results(_reflect.TypeFor[error]()).AsEventually()),
+		NextRun:     _imptest.NewDependencyMethod(ctrl, "NextRun").Results(_reflect.TypeFor[time.Time](), _reflect.TypeFor[error]()).AsEventually(),
+		GetInterval: newSchedulerMockGetIntervalMethod(_imptest.NewDependencyMethod(ctrl, "GetInterval").Results(_reflect.TypeFor[time.Duration]()).AsEventually()),
 	}
 	mock := &mockSchedulerImpl{ctrl: ctrl}
 	return mock, imp
@@ -181,9 +240,7 @@ func (impl *mockSchedulerImpl) Delay(taskID string, duration time.Duration) erro
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 error
 	if len(resp.ReturnValues) > 0 {
@@ -204,9 +261,7 @@ func (impl *mockSchedulerImpl) GetInterval(taskID string) time.Duration {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 time.Duration
 	if len(resp.ReturnValues) > 0 {
@@ -227,9 +282,7 @@ func (impl *mockSchedulerImpl) NextRun() (time.Time, error) {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 time.Time
 	if len(resp.ReturnValues) > 0 {
@@ -257,9 +310,7 @@ func (impl *mockSchedulerImpl) ScheduleAt(taskID string, when time.Time) error {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 error
 	if len(resp.ReturnValues) > 0 {
@@ -6,6 +6,8 @@ package timeconflict_test
 import (
 	_imptest "github.com/toejough/imptest"
 	time "github.com/toejough/imptest/UAT/variations/package/shadowing/time"
+	_reflect "reflect"
+	_time "time"
 )
 
 type TimerImp struct {
@@ -29,6 +31,11 @@ func (c *TimerMockGetElapsedCall) Return(result0 int) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *TimerMockGetElapsedCall) ReturnAfter(d _time.Duration, result0 int) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type TimerMockWaitArgs struct {
 	Seconds int
 }
@@ -50,6 +57,11 @@ func (c *TimerMockWaitCall) Return(result0 error) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *TimerMockWaitCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type TimerMockWaitMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -60,9 +72,21 @@ func (m *TimerMockWaitMethod) ArgsEqual(seconds int) *TimerMockWaitCall {
 	return &TimerMockWaitCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *TimerMockWaitMethod) ArgsShould(matchers ...any) *TimerMockWaitCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *TimerMockWaitMethod) ArgsShould(seconds any) *TimerMockWaitCall {
+	_call := m.DependencyMethod.ArgsShould(seconds)
+	return &TimerMockWaitCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *TimerMockWaitMethod) ArgsWhere(predicate func(TimerMockWaitArgs) error) *TimerMockWaitCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args TimerMockWaitArgs
+		args.Seconds, _ = raw[0].(int)
+		return predicate(args)
+	})
 	return &TimerMockWaitCall{DependencyCall: call}
 }
 
@@ -70,12 +94,12 @@ func (m *TimerMockWaitMethod) ArgsShould(matchers ...any) *TimerMockWaitCall {
 func MockTimer(t _imptest.TestReporter) (time.Timer, *TimerImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &TimerImp{
-		Wait:       newTimerMockWaitMethod(_imptest.NewDependencyMethod(ctrl, "Wait")),
-		GetElapsed: _imptest.NewDependencyMethod(ctrl, "GetElapsed"),
+		Wait:       newTimerMockWaitMethod(_imptest.NewDependencyMethod(ctrl, "Wait").Results(_reflect.TypeFor[error]())),
+		GetElapsed: _imptest.NewDependencyMethod(ctrl, "GetElapsed").Results(_reflect.TypeFor[int]()),
 	}
 	imp.Eventually = &TimerImpEventually{
-		Wait:       newTimerMockWaitMethod(_imptest.NewDependencyMethod(ctrl, "Wait").AsEventually()),
-		GetElapsed: _imptest.NewDependencyMethod(ctrl, "GetElapsed").AsEventually(),
+		Wait:       newTimerMockWaitMethod(_imptest.NewDependencyMethod(ctrl, "Wait").Results(_reflect.TypeFor[error]()).AsEventually()),
+		GetElapsed: _imptest.NewDependencyMethod(ctrl, "GetElapsed").Results(_reflect.TypeFor[int]()).AsEventually(),
 	}
 	mock := &mockTimerImpl{ctrl: ctrl}
 	return mock, imp
@@ -94,9 +118,7 @@ func (impl *mockTimerImpl) GetElapsed() int {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 int
 	if len(resp.ReturnValues) > 0 {
@@ -117,9 +139,7 @@ func (impl *mockTimerImpl) Wait(seconds int) error {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 error
 	if len(resp.ReturnValues) > 0 {
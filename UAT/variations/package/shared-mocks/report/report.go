@@ -0,0 +1,18 @@
+// Package report summarizes stored values. Its tests reuse the shared store mocks.
+package report
+
+import (
+	"fmt"
+
+	"github.com/toejough/imptest/UAT/variations/package/shared-mocks/store"
+)
+
+// Line returns a one-line report of the value stored under key.
+func Line(s store.Store, key string) string {
+	value, err := s.Get(key)
+	if err != nil {
+		return fmt.Sprintf("%s: unavailable", key)
+	}
+
+	return fmt.Sprintf("%s: %s", key, value)
+}
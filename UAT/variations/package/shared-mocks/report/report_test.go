@@ -0,0 +1,29 @@
+package report_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/toejough/imptest/UAT/variations/package/shared-mocks/mocks/storemock"
+	"github.com/toejough/imptest/UAT/variations/package/shared-mocks/report"
+)
+
+// TestReport_SharedMock demonstrates another package reusing the same shared mock, with no
+// directive of its own.
+func TestReport_SharedMock(t *testing.T) {
+	t.Parallel()
+
+	store, storeImp := storemock.MockStore(t)
+
+	lineChan := make(chan string, 1)
+
+	go func() {
+		lineChan <- report.Line(store, "k")
+	}()
+
+	storeImp.Get.ArgsEqual("k").Return("", errors.New("offline"))
+
+	if line := <-lineChan; line != "k: unavailable" {
+		t.Errorf("Line() = %q, want %q", line, "k: unavailable")
+	}
+}
@@ -0,0 +1,19 @@
+// Package shared demonstrates generating mocks into an importable package shared by the whole module,
+// instead of next to each test that needs them.
+package shared
+
+import (
+	"fmt"
+
+	"github.com/toejough/imptest/UAT/variations/package/shared-mocks/store"
+)
+
+// Touch records the current time under key.
+func Touch(s store.Store, clock store.Clock, key string) error {
+	err := s.Put(key, clock.Now().UTC().Format("2006-01-02"))
+	if err != nil {
+		return fmt.Errorf("failed to touch %s: %w", key, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package shared_test
+
+import (
+	"testing"
+	"time"
+
+	shared "github.com/toejough/imptest/UAT/variations/package/shared-mocks"
+	"github.com/toejough/imptest/UAT/variations/package/shared-mocks/mocks/storemock"
+)
+
+// Generated from a test file, but into the shared package: the output is non-test code either way.
+//go:generate impgen store.Clock --dependency --output-dir mocks/storemock --package storemock
+
+// TestShared_Touch demonstrates using mocks generated into a shared, importable package.
+//
+// Key Requirements Met:
+//  1. --output-dir writes the mocks into their own package, as exported non-test code, so any
+//     package in the module can import them instead of generating its own.
+//  2. The shared package imports the mocked interfaces' package, even when the directive was in
+//     that package itself (store.Store).
+func TestShared_Touch(t *testing.T) {
+	t.Parallel()
+
+	store, storeImp := storemock.MockStore(t)
+	clock, clockImp := storemock.MockClock(t)
+
+	errChan := make(chan error, 1)
+
+	go func() {
+		errChan <- shared.Touch(store, clock, "k")
+	}()
+
+	clockImp.Now.Called().Return(time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC))
+	storeImp.Put.ArgsEqual("k", "2024-05-06").Return(nil)
+
+	err := <-errChan
+	if err != nil {
+		t.Fatalf("Touch() error = %v", err)
+	}
+}
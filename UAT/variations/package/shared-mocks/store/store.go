@@ -0,0 +1,18 @@
+// Package store declares the storage interfaces shared by the rest of the module.
+package store
+
+import "time"
+
+// Generated from within store itself: the shared mocks package imports store to refer to Store.
+//go:generate impgen Store --dependency --output-dir ../mocks/storemock
+
+// Clock tells the time.
+type Clock interface {
+	Now() time.Time
+}
+
+// Store gets and puts values by key.
+type Store interface {
+	Get(key string) (string, error)
+	Put(key, value string) error
+}
@@ -6,6 +6,8 @@ package testpkgimport_test
 import (
 	_imptest "github.com/toejough/imptest"
 	testpkgimport "github.com/toejough/imptest/UAT/variations/package/test-package"
+	_reflect "reflect"
+	_time "time"
 )
 
 type ServiceImp struct {
@@ -41,6 +43,11 @@ func (c *ServiceMockExecuteCall) Return(result0 string, result1 error) {
 	c.DependencyCall.Return(result0, result1)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *ServiceMockExecuteCall) ReturnAfter(d _time.Duration, result0 string, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
 type ServiceMockExecuteMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -51,9 +58,21 @@ func (m *ServiceMockExecuteMethod) ArgsEqual(input string) *ServiceMockExecuteCa
 	return &ServiceMockExecuteCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *ServiceMockExecuteMethod) ArgsShould(matchers ...any) *ServiceMockExecuteCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *ServiceMockExecuteMethod) ArgsShould(input any) *ServiceMockExecuteCall {
+	_call := m.DependencyMethod.ArgsShould(input)
+	return &ServiceMockExecuteCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *ServiceMockExecuteMethod) ArgsWhere(predicate func(ServiceMockExecuteArgs) error) *ServiceMockExecuteCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args ServiceMockExecuteArgs
+		args.Input, _ = raw[0].(string)
+		return predicate(args)
+	})
 	return &ServiceMockExecuteCall{DependencyCall: call}
 }
 
@@ -78,6 +97,11 @@ func (c *ServiceMockValidateCall) Return(result0 bool) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *ServiceMockValidateCall) ReturnAfter(d _time.Duration, result0 bool) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type ServiceMockValidateMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -88,9 +112,21 @@ func (m *ServiceMockValidateMethod) ArgsEqual(input string) *ServiceMockValidate
 	return &ServiceMockValidateCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *ServiceMockValidateMethod) ArgsShould(matchers ...any) *ServiceMockValidateCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *ServiceMockValidateMethod) ArgsShould(input any) *ServiceMockValidateCall {
+	_call := m.DependencyMethod.ArgsShould(input)
+	return &ServiceMockValidateCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *ServiceMockValidateMethod) ArgsWhere(predicate func(ServiceMockValidateArgs) error) *ServiceMockValidateCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args ServiceMockValidateArgs
+		args.Input, _ = raw[0].(string)
+		return predicate(args)
+	})
 	return &ServiceMockValidateCall{DependencyCall: call}
 }
 
@@ -98,12 +134,12 @@ func (m *ServiceMockValidateMethod) ArgsShould(matchers ...any) *ServiceMockVali
 func MockService(t _imptest.TestReporter) (testpkgimport.Service, *ServiceImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &ServiceImp{
-		Execute:  newServiceMockExecuteMethod(_imptest.NewDependencyMethod(ctrl, "Execute")),
-		Validate: newServiceMockValidateMethod(_imptest.NewDependencyMethod(ctrl, "Validate")),
+		Execute:  newServiceMockExecuteMethod(_imptest.NewDependencyMethod(ctrl, "Execute").Results(_reflect.TypeFor[string](), _reflect.TypeFor[error]())),
+		Validate: newServiceMockValidateMethod(_imptest.NewDependencyMethod(ctrl, "Validate").Results(_reflect.TypeFor[bool]())),
 	}
 	imp.Eventually = &ServiceImpEventually{
-		Execute:  newServiceMockExecuteMethod(_imptest.NewDependencyMethod(ctrl, "Execute").AsEventually()),
-		Validate: newServiceMockValidateMethod(_imptest.NewDependencyMethod(ctrl, "Validate").AsEventually()),
+		Execute:  newServiceMockExecuteMethod(_imptest.NewDependencyMethod(ctrl, "Execute").Results(_reflect.TypeFor[string](), _reflect.TypeFor[error]()).AsEventually()),
+		Validate: newServiceMockValidateMethod(_imptest.NewDependencyMethod(ctrl, "Validate").Results(_reflect.TypeFor[bool]()).AsEventually()),
 	}
 	mock := &mockServiceImpl{ctrl: ctrl}
 	return mock, imp
@@ -122,9 +158,7 @@ func (impl *mockServiceImpl) Execute(input string) (string, error) {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 string
 	if len(resp.ReturnValues) > 0 {
@@ -152,9 +186,7 @@ func (impl *mockServiceImpl) Validate(input string) bool {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 bool
 	if len(resp.ReturnValues) > 0 {
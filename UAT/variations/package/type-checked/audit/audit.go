@@ -0,0 +1,14 @@
+// Package audit records events. The type-checked package never imports it directly; its types only reach
+// that package through the storage interfaces that embed Trail.
+package audit
+
+// Event is something worth recording.
+type Event struct {
+	Action string
+	ID     string
+}
+
+// Trail records events.
+type Trail interface {
+	Record(event Event) error
+}
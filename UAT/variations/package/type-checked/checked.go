@@ -0,0 +1,59 @@
+// Package checked declares symbols whose method sets syntax alone cannot resolve: aliases, interfaces
+// embedding interfaces from other packages, structs with methods promoted from another package, and
+// generics with constraint interfaces.
+package checked
+
+import (
+	"fmt"
+
+	"github.com/toejough/imptest/UAT/variations/package/type-checked/audit"
+	"github.com/toejough/imptest/UAT/variations/package/type-checked/storage"
+)
+
+// Cache is a generic interface constrained by Key.
+type Cache[K Key, V any] interface {
+	Get(key K) (V, bool)
+	Put(key K, value V)
+}
+
+// Connection embeds storage.Base, so Close and Name are promoted from another package.
+type Connection struct {
+	*storage.Base
+
+	hits int
+}
+
+// Hits reports how many lookups the connection has served.
+func (c *Connection) Hits() int {
+	return c.hits
+}
+
+// Key is a constraint interface: it can only be used as a type constraint.
+type Key interface {
+	~string | ~int
+}
+
+// Repo is a generic alias for an interface declared in another package.
+type Repo[T any] = storage.Repo[T]
+
+// Store is an interface whose methods all come from other packages, some through interfaces that
+// embed interfaces from packages this one never imports.
+type Store interface {
+	storage.Reader
+	storage.Audited
+}
+
+// Fetch loads a record and records that it was read.
+func Fetch(store Store, id string) (storage.Record, error) {
+	record, err := store.Get(id)
+	if err != nil {
+		return storage.Record{}, fmt.Errorf("fetch %s: %w", id, err)
+	}
+
+	err = store.Record(audit.Event{Action: "read", ID: id})
+	if err != nil {
+		return storage.Record{}, fmt.Errorf("audit %s: %w", id, err)
+	}
+
+	return record, nil
+}
@@ -0,0 +1,135 @@
+package checked_test
+
+import (
+	"errors"
+	"testing"
+
+	checked "github.com/toejough/imptest/UAT/variations/package/type-checked"
+	"github.com/toejough/imptest/UAT/variations/package/type-checked/audit"
+	"github.com/toejough/imptest/UAT/variations/package/type-checked/storage"
+)
+
+// These symbols are resolved with the type checker: syntax alone cannot find the alias, expand
+// interfaces embedded from other packages, or see methods promoted from another package's struct.
+//go:generate impgen checked.Store --dependency
+//go:generate impgen checked.Repo --dependency
+//go:generate impgen checked.Connection --dependency
+// Plain generics need no type checking, but --types forces it.
+//go:generate impgen checked.Cache --dependency --types
+
+// TestTypeChecked_EmbeddedAcrossPackages demonstrates mocking an interface whose methods all come from
+// interfaces embedded from other packages.
+//
+// Key Requirements Met:
+//  1. Embedded interfaces are expanded even when they embed interfaces from packages the symbol's
+//     package never imports (audit.Trail reaches checked only through storage.Audited).
+//  2. The mock imports every package its method signatures need.
+func TestTypeChecked_EmbeddedAcrossPackages(t *testing.T) {
+	t.Parallel()
+
+	store, storeImp := MockStore(t)
+	record := storage.Record{ID: "u1", Data: []byte("ada")}
+
+	type fetched struct {
+		record storage.Record
+		err    error
+	}
+
+	resultChan := make(chan fetched, 1)
+
+	go func() {
+		got, err := checked.Fetch(store, "u1")
+		resultChan <- fetched{record: got, err: err}
+	}()
+
+	storeImp.Get.ArgsEqual("u1").Return(record, nil)
+	storeImp.Record.ArgsEqual(audit.Event{Action: "read", ID: "u1"}).Return(nil)
+
+	result := <-resultChan
+	if result.err != nil || result.record.ID != "u1" {
+		t.Fatalf("Fetch() = %+v, %v; want record u1", result.record, result.err)
+	}
+}
+
+// TestTypeChecked_GenericAlias demonstrates mocking a generic alias of an interface declared in
+// another package.
+//
+// Key Requirements Met:
+//  1. Aliases resolve to the interface they stand for.
+//  2. A generic alias keeps its type parameters, so the mock is instantiated like the alias.
+func TestTypeChecked_GenericAlias(t *testing.T) {
+	t.Parallel()
+
+	repo, repoImp := MockRepo[storage.Record](t)
+
+	errChan := make(chan error, 1)
+
+	go func() {
+		_, err := repo.Load("missing")
+		errChan <- err
+	}()
+
+	repoImp.Load.ArgsEqual("missing").Return(storage.Record{}, storage.ErrNotFound)
+
+	err := <-errChan
+	if !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("Load() error = %v, want %v", err, storage.ErrNotFound)
+	}
+}
+
+// TestTypeChecked_PromotedMethods demonstrates mocking a struct whose embedded struct comes from
+// another package.
+//
+// Key Requirements Met:
+//  1. Methods promoted from storage.Base (Close, Name) are mocked alongside the struct's own Hits.
+func TestTypeChecked_PromotedMethods(t *testing.T) {
+	t.Parallel()
+
+	conn, connImp := MockConnection(t)
+
+	nameChan := make(chan string, 1)
+
+	go func() {
+		_ = conn.Hits()
+		nameChan <- conn.Name()
+		_ = conn.Close()
+	}()
+
+	connImp.Hits.ArgsShould().Return(1)
+	connImp.Name.ArgsShould().Return("primary")
+	connImp.Close.ArgsShould().Return(nil)
+
+	if name := <-nameChan; name != "primary" {
+		t.Fatalf("Name() = %q, want %q", name, "primary")
+	}
+}
+
+// TestTypeChecked_ConstraintInterface demonstrates mocking a generic interface constrained by a
+// constraint interface, resolved by the type checker because of --types.
+//
+// Key Requirements Met:
+//  1. Type parameters keep their constraint interfaces.
+//  2. The mock can be instantiated with any type satisfying the constraint.
+func TestTypeChecked_ConstraintInterface(t *testing.T) {
+	t.Parallel()
+
+	cache, cacheImp := MockCache[string, int](t)
+
+	type lookup struct {
+		value int
+		ok    bool
+	}
+
+	resultChan := make(chan lookup, 1)
+
+	go func() {
+		value, ok := cache.Get("answer")
+		resultChan <- lookup{value: value, ok: ok}
+	}()
+
+	cacheImp.Get.ArgsEqual("answer").Return(42, true)
+
+	if result := <-resultChan; !result.ok || result.value != 42 {
+		t.Fatalf("Get() = %+v, want 42, true", result)
+	}
+}
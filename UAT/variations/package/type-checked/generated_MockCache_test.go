@@ -0,0 +1,194 @@
+// Code generated by impgen. DO NOT EDIT.
+// impgen:hash:82ab6f11be4f32e3
+
+package checked_test
+
+import (
+	_imptest "github.com/toejough/imptest"
+	checked "github.com/toejough/imptest/UAT/variations/package/type-checked"
+	_reflect "reflect"
+	_time "time"
+)
+
+type CacheImp[K checked.Key, V any] struct {
+	Get *CacheMockGetMethod[K, V]
+	Put *CacheMockPutMethod[K, V]
+	// Eventually provides async versions of all methods for concurrent code.
+	Eventually *CacheImpEventually[K, V]
+}
+
+type CacheImpEventually[K checked.Key, V any] struct {
+	Get *CacheMockGetMethod[K, V]
+	Put *CacheMockPutMethod[K, V]
+}
+
+type CacheMockGetArgs[K checked.Key, V any] struct {
+	Key K
+}
+
+type CacheMockGetCall[K checked.Key, V any] struct {
+	*_imptest.DependencyCall
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *CacheMockGetCall[K, V]) GetArgs() CacheMockGetArgs[K, V] {
+	raw := c.RawArgs()
+	return CacheMockGetArgs[K, V]{
+		Key: raw[0].(K),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *CacheMockGetCall[K, V]) Return(result0 V, result1 bool) {
+	c.DependencyCall.Return(result0, result1)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *CacheMockGetCall[K, V]) ReturnAfter(d _time.Duration, result0 V, result1 bool) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
+type CacheMockGetMethod[K checked.Key, V any] struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *CacheMockGetMethod[K, V]) ArgsEqual(key K) *CacheMockGetCall[K, V] {
+	call := m.DependencyMethod.ArgsEqual(key)
+	return &CacheMockGetCall[K, V]{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *CacheMockGetMethod[K, V]) ArgsShould(key any) *CacheMockGetCall[K, V] {
+	_call := m.DependencyMethod.ArgsShould(key)
+	return &CacheMockGetCall[K, V]{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *CacheMockGetMethod[K, V]) ArgsWhere(predicate func(CacheMockGetArgs[K, V]) error) *CacheMockGetCall[K, V] {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args CacheMockGetArgs[K, V]
+		args.Key, _ = raw[0].(K)
+		return predicate(args)
+	})
+	return &CacheMockGetCall[K, V]{DependencyCall: call}
+}
+
+type CacheMockPutArgs[K checked.Key, V any] struct {
+	Key   K
+	Value V
+}
+
+type CacheMockPutCall[K checked.Key, V any] struct {
+	*_imptest.DependencyCall
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *CacheMockPutCall[K, V]) GetArgs() CacheMockPutArgs[K, V] {
+	raw := c.RawArgs()
+	return CacheMockPutArgs[K, V]{
+		Key:   raw[0].(K),
+		Value: raw[1].(V),
+	}
+}
+
+type CacheMockPutMethod[K checked.Key, V any] struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *CacheMockPutMethod[K, V]) ArgsEqual(key K, value V) *CacheMockPutCall[K, V] {
+	call := m.DependencyMethod.ArgsEqual(key, value)
+	return &CacheMockPutCall[K, V]{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *CacheMockPutMethod[K, V]) ArgsShould(key any, value any) *CacheMockPutCall[K, V] {
+	_call := m.DependencyMethod.ArgsShould(key, value)
+	return &CacheMockPutCall[K, V]{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *CacheMockPutMethod[K, V]) ArgsWhere(predicate func(CacheMockPutArgs[K, V]) error) *CacheMockPutCall[K, V] {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args CacheMockPutArgs[K, V]
+		args.Key, _ = raw[0].(K)
+		args.Value, _ = raw[1].(V)
+		return predicate(args)
+	})
+	return &CacheMockPutCall[K, V]{DependencyCall: call}
+}
+
+// MockCache creates a mock Cache and returns (mock, expectation handle).
+func MockCache[K checked.Key, V any](t _imptest.TestReporter) (checked.Cache[K, V], *CacheImp[K, V]) {
+	ctrl := _imptest.GetOrCreateImp(t)
+	imp := &CacheImp[K, V]{
+		Get: newCacheMockGetMethod[K, V](_imptest.NewDependencyMethod(ctrl, "Get").Results(_reflect.TypeFor[V](), _reflect.TypeFor[bool]())),
+		Put: newCacheMockPutMethod[K, V](_imptest.NewDependencyMethod(ctrl, "Put").Results()),
+	}
+	imp.Eventually = &CacheImpEventually[K, V]{
+		Get: newCacheMockGetMethod[K, V](_imptest.NewDependencyMethod(ctrl, "Get").Results(_reflect.TypeFor[V](), _reflect.TypeFor[bool]()).AsEventually()),
+		Put: newCacheMockPutMethod[K, V](_imptest.NewDependencyMethod(ctrl, "Put").Results().AsEventually()),
+	}
+	mock := &mockCacheImpl[K, V]{ctrl: ctrl}
+	return mock, imp
+}
+
+type mockCacheImpl[K checked.Key, V any] struct {
+	ctrl *_imptest.Imp
+}
+
+// Get implements checked.Cache[K, V].Get.
+func (impl *mockCacheImpl[K, V]) Get(key K) (V, bool) {
+	call := &_imptest.GenericCall{
+		MethodName:   "Get",
+		Args:         []any{key},
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 V
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(V); ok {
+			result1 = value
+		}
+	}
+
+	var result2 bool
+	if len(resp.ReturnValues) > 1 {
+		if value, ok := resp.ReturnValues[1].(bool); ok {
+			result2 = value
+		}
+	}
+
+	return result1, result2
+}
+
+// Put implements checked.Cache[K, V].Put.
+func (impl *mockCacheImpl[K, V]) Put(key K, value V) {
+	call := &_imptest.GenericCall{
+		MethodName:   "Put",
+		Args:         []any{key, value},
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+}
+
+// newCacheMockGetMethod creates a typed method wrapper.
+func newCacheMockGetMethod[K checked.Key, V any](dm *_imptest.DependencyMethod) *CacheMockGetMethod[K, V] {
+	return &CacheMockGetMethod[K, V]{DependencyMethod: dm}
+}
+
+// newCacheMockPutMethod creates a typed method wrapper.
+func newCacheMockPutMethod[K checked.Key, V any](dm *_imptest.DependencyMethod) *CacheMockPutMethod[K, V] {
+	return &CacheMockPutMethod[K, V]{DependencyMethod: dm}
+}
@@ -0,0 +1,211 @@
+// Code generated by impgen. DO NOT EDIT.
+// impgen:hash:56675cab4277f212
+
+package checked_test
+
+import (
+	_imptest "github.com/toejough/imptest"
+	checked "github.com/toejough/imptest/UAT/variations/package/type-checked"
+	audit "github.com/toejough/imptest/UAT/variations/package/type-checked/audit"
+	storage "github.com/toejough/imptest/UAT/variations/package/type-checked/storage"
+	_reflect "reflect"
+	_time "time"
+)
+
+type StoreImp struct {
+	Get    *StoreMockGetMethod
+	Record *StoreMockRecordMethod
+	// Eventually provides async versions of all methods for concurrent code.
+	Eventually *StoreImpEventually
+}
+
+type StoreImpEventually struct {
+	Get    *StoreMockGetMethod
+	Record *StoreMockRecordMethod
+}
+
+type StoreMockGetArgs struct {
+	Id string
+}
+
+type StoreMockGetCall struct {
+	*_imptest.DependencyCall
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *StoreMockGetCall) GetArgs() StoreMockGetArgs {
+	raw := c.RawArgs()
+	return StoreMockGetArgs{
+		Id: raw[0].(string),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *StoreMockGetCall) Return(result0 storage.Record, result1 error) {
+	c.DependencyCall.Return(result0, result1)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *StoreMockGetCall) ReturnAfter(d _time.Duration, result0 storage.Record, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
+type StoreMockGetMethod struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *StoreMockGetMethod) ArgsEqual(id string) *StoreMockGetCall {
+	call := m.DependencyMethod.ArgsEqual(id)
+	return &StoreMockGetCall{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *StoreMockGetMethod) ArgsShould(id any) *StoreMockGetCall {
+	_call := m.DependencyMethod.ArgsShould(id)
+	return &StoreMockGetCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *StoreMockGetMethod) ArgsWhere(predicate func(StoreMockGetArgs) error) *StoreMockGetCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args StoreMockGetArgs
+		args.Id, _ = raw[0].(string)
+		return predicate(args)
+	})
+	return &StoreMockGetCall{DependencyCall: call}
+}
+
+type StoreMockRecordArgs struct {
+	Event audit.Event
+}
+
+type StoreMockRecordCall struct {
+	*_imptest.DependencyCall
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *StoreMockRecordCall) GetArgs() StoreMockRecordArgs {
+	raw := c.RawArgs()
+	return StoreMockRecordArgs{
+		Event: raw[0].(audit.Event),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *StoreMockRecordCall) Return(result0 error) {
+	c.DependencyCall.Return(result0)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *StoreMockRecordCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
+type StoreMockRecordMethod struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *StoreMockRecordMethod) ArgsEqual(event audit.Event) *StoreMockRecordCall {
+	call := m.DependencyMethod.ArgsEqual(event)
+	return &StoreMockRecordCall{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *StoreMockRecordMethod) ArgsShould(event any) *StoreMockRecordCall {
+	_call := m.DependencyMethod.ArgsShould(event)
+	return &StoreMockRecordCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *StoreMockRecordMethod) ArgsWhere(predicate func(StoreMockRecordArgs) error) *StoreMockRecordCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args StoreMockRecordArgs
+		args.Event, _ = raw[0].(audit.Event)
+		return predicate(args)
+	})
+	return &StoreMockRecordCall{DependencyCall: call}
+}
+
+// MockStore creates a mock Store and returns (mock, expectation handle).
+func MockStore(t _imptest.TestReporter) (checked.Store, *StoreImp) {
+	ctrl := _imptest.GetOrCreateImp(t)
+	imp := &StoreImp{
+		Get:    newStoreMockGetMethod(_imptest.NewDependencyMethod(ctrl, "Get").Results(_reflect.TypeFor[storage.Record](), _reflect.TypeFor[error]())),
+		Record: newStoreMockRecordMethod(_imptest.NewDependencyMethod(ctrl, "Record").Results(_reflect.TypeFor[error]())),
+	}
+	imp.Eventually = &StoreImpEventually{
+		Get:    newStoreMockGetMethod(_imptest.NewDependencyMethod(ctrl, "Get").Results(_reflect.TypeFor[storage.Record](), _reflect.TypeFor[error]()).AsEventually()),
+		Record: newStoreMockRecordMethod(_imptest.NewDependencyMethod(ctrl, "Record").Results(_reflect.TypeFor[error]()).AsEventually()),
+	}
+	mock := &mockStoreImpl{ctrl: ctrl}
+	return mock, imp
+}
+
+type mockStoreImpl struct {
+	ctrl *_imptest.Imp
+}
+
+// Get implements checked.Store.Get.
+func (impl *mockStoreImpl) Get(id string) (storage.Record, error) {
+	call := &_imptest.GenericCall{
+		MethodName:   "Get",
+		Args:         []any{id},
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 storage.Record
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(storage.Record); ok {
+			result1 = value
+		}
+	}
+
+	var result2 error
+	if len(resp.ReturnValues) > 1 {
+		if value, ok := resp.ReturnValues[1].(error); ok {
+			result2 = value
+		}
+	}
+
+	return result1, result2
+}
+
+// Record implements checked.Store.Record.
+func (impl *mockStoreImpl) Record(event audit.Event) error {
+	call := &_imptest.GenericCall{
+		MethodName:   "Record",
+		Args:         []any{event},
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 error
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(error); ok {
+			result1 = value
+		}
+	}
+
+	return result1
+}
+
+// newStoreMockGetMethod creates a typed method wrapper.
+func newStoreMockGetMethod(dm *_imptest.DependencyMethod) *StoreMockGetMethod {
+	return &StoreMockGetMethod{DependencyMethod: dm}
+}
+
+// newStoreMockRecordMethod creates a typed method wrapper.
+func newStoreMockRecordMethod(dm *_imptest.DependencyMethod) *StoreMockRecordMethod {
+	return &StoreMockRecordMethod{DependencyMethod: dm}
+}
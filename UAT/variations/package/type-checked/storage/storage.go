@@ -0,0 +1,50 @@
+// Package storage declares the interfaces and base types that the type-checked package builds on.
+package storage
+
+import (
+	"errors"
+
+	"github.com/toejough/imptest/UAT/variations/package/type-checked/audit"
+)
+
+// ErrNotFound is returned when there is no record for an ID.
+var ErrNotFound = errors.New("not found")
+
+// Audited is satisfied by anything that keeps an audit trail.
+type Audited interface {
+	audit.Trail
+}
+
+// Base provides methods that structs embedding it get promoted.
+type Base struct {
+	name string
+}
+
+// Close releases the connection.
+func (b *Base) Close() error {
+	b.name = ""
+
+	return nil
+}
+
+// Name reports the connection name.
+func (b *Base) Name() string {
+	return b.name
+}
+
+// Reader loads records.
+type Reader interface {
+	Get(id string) (Record, error)
+}
+
+// Record is a stored value.
+type Record struct {
+	ID   string
+	Data []byte
+}
+
+// Repo stores values of any type.
+type Repo[T any] interface {
+	Load(id string) (T, error)
+	Save(id string, item T) error
+}
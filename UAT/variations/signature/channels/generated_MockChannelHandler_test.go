@@ -6,6 +6,8 @@ package channels_test
 import (
 	_imptest "github.com/toejough/imptest"
 	channels "github.com/toejough/imptest/UAT/variations/signature/channels"
+	_reflect "reflect"
+	_time "time"
 )
 
 type ChannelHandlerImp struct {
@@ -32,6 +34,11 @@ type ChannelHandlerMockBidirectionalCall struct {
 	*_imptest.DependencyCall
 }
 
+// CloseReturnedChannels closes the channels returned by earlier mock calls, then returns the typed values.
+func (c *ChannelHandlerMockBidirectionalCall) CloseReturnedChannels(result0 bool) {
+	c.DependencyCall.CloseReturnedChannels(result0)
+}
+
 // GetArgs returns the typed arguments for this call.
 func (c *ChannelHandlerMockBidirectionalCall) GetArgs() ChannelHandlerMockBidirectionalArgs {
 	raw := c.RawArgs()
@@ -45,6 +52,11 @@ func (c *ChannelHandlerMockBidirectionalCall) Return(result0 bool) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *ChannelHandlerMockBidirectionalCall) ReturnAfter(d _time.Duration, result0 bool) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type ChannelHandlerMockBidirectionalMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -55,9 +67,21 @@ func (m *ChannelHandlerMockBidirectionalMethod) ArgsEqual(ch chan bool) *Channel
 	return &ChannelHandlerMockBidirectionalCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *ChannelHandlerMockBidirectionalMethod) ArgsShould(matchers ...any) *ChannelHandlerMockBidirectionalCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *ChannelHandlerMockBidirectionalMethod) ArgsShould(ch any) *ChannelHandlerMockBidirectionalCall {
+	_call := m.DependencyMethod.ArgsShould(ch)
+	return &ChannelHandlerMockBidirectionalCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *ChannelHandlerMockBidirectionalMethod) ArgsWhere(predicate func(ChannelHandlerMockBidirectionalArgs) error) *ChannelHandlerMockBidirectionalCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args ChannelHandlerMockBidirectionalArgs
+		args.Ch, _ = raw[0].(chan bool)
+		return predicate(args)
+	})
 	return &ChannelHandlerMockBidirectionalCall{DependencyCall: call}
 }
 
@@ -69,6 +93,11 @@ type ChannelHandlerMockReceiveOnlyCall struct {
 	*_imptest.DependencyCall
 }
 
+// CloseReturnedChannels closes the channels returned by earlier mock calls, then returns the typed values.
+func (c *ChannelHandlerMockReceiveOnlyCall) CloseReturnedChannels(result0 string, result1 error) {
+	c.DependencyCall.CloseReturnedChannels(result0, result1)
+}
+
 // GetArgs returns the typed arguments for this call.
 func (c *ChannelHandlerMockReceiveOnlyCall) GetArgs() ChannelHandlerMockReceiveOnlyArgs {
 	raw := c.RawArgs()
@@ -82,6 +111,11 @@ func (c *ChannelHandlerMockReceiveOnlyCall) Return(result0 string, result1 error
 	c.DependencyCall.Return(result0, result1)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *ChannelHandlerMockReceiveOnlyCall) ReturnAfter(d _time.Duration, result0 string, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
 type ChannelHandlerMockReceiveOnlyMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -92,9 +126,21 @@ func (m *ChannelHandlerMockReceiveOnlyMethod) ArgsEqual(ch <-chan string) *Chann
 	return &ChannelHandlerMockReceiveOnlyCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *ChannelHandlerMockReceiveOnlyMethod) ArgsShould(matchers ...any) *ChannelHandlerMockReceiveOnlyCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *ChannelHandlerMockReceiveOnlyMethod) ArgsShould(ch any) *ChannelHandlerMockReceiveOnlyCall {
+	_call := m.DependencyMethod.ArgsShould(ch)
+	return &ChannelHandlerMockReceiveOnlyCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *ChannelHandlerMockReceiveOnlyMethod) ArgsWhere(predicate func(ChannelHandlerMockReceiveOnlyArgs) error) *ChannelHandlerMockReceiveOnlyCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args ChannelHandlerMockReceiveOnlyArgs
+		args.Ch, _ = raw[0].(<-chan string)
+		return predicate(args)
+	})
 	return &ChannelHandlerMockReceiveOnlyCall{DependencyCall: call}
 }
 
@@ -102,11 +148,21 @@ type ChannelHandlerMockReturnChannelCall struct {
 	*_imptest.DependencyCall
 }
 
+// CloseReturnedChannels closes the channels returned by earlier mock calls, then returns the typed values.
+func (c *ChannelHandlerMockReturnChannelCall) CloseReturnedChannels(result0 <-chan int) {
+	c.DependencyCall.CloseReturnedChannels(result0)
+}
+
 // Return specifies the typed values the mock should return.
 func (c *ChannelHandlerMockReturnChannelCall) Return(result0 <-chan int) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *ChannelHandlerMockReturnChannelCall) ReturnAfter(d _time.Duration, result0 <-chan int) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type ChannelHandlerMockSendOnlyArgs struct {
 	Ch chan<- int
 }
@@ -115,6 +171,11 @@ type ChannelHandlerMockSendOnlyCall struct {
 	*_imptest.DependencyCall
 }
 
+// CloseReturnedChannels closes the channels returned by earlier mock calls, then returns the typed values.
+func (c *ChannelHandlerMockSendOnlyCall) CloseReturnedChannels(result0 error) {
+	c.DependencyCall.CloseReturnedChannels(result0)
+}
+
 // GetArgs returns the typed arguments for this call.
 func (c *ChannelHandlerMockSendOnlyCall) GetArgs() ChannelHandlerMockSendOnlyArgs {
 	raw := c.RawArgs()
@@ -128,6 +189,11 @@ func (c *ChannelHandlerMockSendOnlyCall) Return(result0 error) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *ChannelHandlerMockSendOnlyCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type ChannelHandlerMockSendOnlyMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -138,9 +204,21 @@ func (m *ChannelHandlerMockSendOnlyMethod) ArgsEqual(ch chan<- int) *ChannelHand
 	return &ChannelHandlerMockSendOnlyCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *ChannelHandlerMockSendOnlyMethod) ArgsShould(matchers ...any) *ChannelHandlerMockSendOnlyCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *ChannelHandlerMockSendOnlyMethod) ArgsShould(ch any) *ChannelHandlerMockSendOnlyCall {
+	_call := m.DependencyMethod.ArgsShould(ch)
+	return &ChannelHandlerMockSendOnlyCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *ChannelHandlerMockSendOnlyMethod) ArgsWhere(predicate func(ChannelHandlerMockSendOnlyArgs) error) *ChannelHandlerMockSendOnlyCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args ChannelHandlerMockSendOnlyArgs
+		args.Ch, _ = raw[0].(chan<- int)
+		return predicate(args)
+	})
 	return &ChannelHandlerMockSendOnlyCall{DependencyCall: call}
 }
 
@@ -148,16 +226,16 @@ func (m *ChannelHandlerMockSendOnlyMethod) ArgsShould(matchers ...any) *ChannelH
 func MockChannelHandler(t _imptest.TestReporter) (channels.ChannelHandler, *ChannelHandlerImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &ChannelHandlerImp{
-		SendOnly:      newChannelHandlerMockSendOnlyMethod(_imptest.NewDependencyMethod(ctrl, "SendOnly")),
-		ReceiveOnly:   newChannelHandlerMockReceiveOnlyMethod(_imptest.NewDependencyMethod(ctrl, "ReceiveOnly")),
-		Bidirectional: newChannelHandlerMockBidirectionalMethod(_imptest.NewDependencyMethod(ctrl, "Bidirectional")),
-		ReturnChannel: _imptest.NewDependencyMethod(ctrl, "ReturnChannel"),
+		SendOnly:      newChannelHandlerMockSendOnlyMethod(_imptest.NewDependencyMethod(ctrl, "SendOnly").Results(_reflect.TypeFor[error]())),
+		ReceiveOnly:   newChannelHandlerMockReceiveOnlyMethod(_imptest.NewDependencyMethod(ctrl, "ReceiveOnly").Results(_reflect.TypeFor[string](), _reflect.TypeFor[error]())),
+		Bidirectional: newChannelHandlerMockBidirectionalMethod(_imptest.NewDependencyMethod(ctrl, "Bidirectional").Results(_reflect.TypeFor[bool]())),
+		ReturnChannel: _imptest.NewDependencyMethod(ctrl, "ReturnChannel").Results(_reflect.TypeFor[<-chan int]()),
 	}
 	imp.Eventually = &ChannelHandlerImpEventually{
-		SendOnly:      newChannelHandlerMockSendOnlyMethod(_imptest.NewDependencyMethod(ctrl, "SendOnly").AsEventually()),
-		ReceiveOnly:   newChannelHandlerMockReceiveOnlyMethod(_imptest.NewDependencyMethod(ctrl, "ReceiveOnly").AsEventually()),
-		Bidirectional: newChannelHandlerMockBidirectionalMethod(_imptest.NewDependencyMethod(ctrl, "Bidirectional").AsEventually()),
-		ReturnChannel: _imptest.NewDependencyMethod(ctrl, "ReturnChannel").AsEventually(),
+		SendOnly:      newChannelHandlerMockSendOnlyMethod(_imptest.NewDependencyMethod(ctrl, "SendOnly").Results(_reflect.TypeFor[error]()).AsEventually()),
+		ReceiveOnly:   newChannelHandlerMockReceiveOnlyMethod(_imptest.NewDependencyMethod(ctrl, "ReceiveOnly").Results(_reflect.TypeFor[string](), _reflect.TypeFor[error]()).AsEventually()),
+		Bidirectional: newChannelHandlerMockBidirectionalMethod(_imptest.NewDependencyMethod(ctrl, "Bidirectional").Results(_reflect.TypeFor[bool]()).AsEventually()),
+		ReturnChannel: _imptest.NewDependencyMethod(ctrl, "ReturnChannel").Results(_reflect.TypeFor[<-chan int]()).AsEventually(),
 	}
 	mock := &mockChannelHandlerImpl{ctrl: ctrl}
 	return mock, imp
@@ -176,9 +254,7 @@ func (impl *mockChannelHandlerImpl) Bidirectional(ch chan bool) bool {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 bool
 	if len(resp.ReturnValues) > 0 {
@@ -199,9 +275,7 @@ func (impl *mockChannelHandlerImpl) ReceiveOnly(ch <-chan string) (string, error
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 string
 	if len(resp.ReturnValues) > 0 {
@@ -229,9 +303,7 @@ func (impl *mockChannelHandlerImpl) ReturnChannel() <-chan int {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 <-chan int
 	if len(resp.ReturnValues) > 0 {
@@ -252,9 +324,7 @@ func (impl *mockChannelHandlerImpl) SendOnly(ch chan<- int) error {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 error
 	if len(resp.ReturnValues) > 0 {
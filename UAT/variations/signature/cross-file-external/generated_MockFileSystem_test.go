@@ -7,6 +7,7 @@ import (
 	_imptest "github.com/toejough/imptest"
 	crossfile "github.com/toejough/imptest/UAT/variations/signature/cross-file-external"
 	os "os"
+	_reflect "reflect"
 	time "time"
 )
 
@@ -45,6 +46,11 @@ func (c *FileSystemMockCreateCall) Return(result0 error) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *FileSystemMockCreateCall) ReturnAfter(d time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type FileSystemMockCreateMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -55,9 +61,22 @@ func (m *FileSystemMockCreateMethod) ArgsEqual(path string, mode os.FileMode) *F
 	return &FileSystemMockCreateCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *FileSystemMockCreateMethod) ArgsShould(matchers ...any) *FileSystemMockCreateCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *FileSystemMockCreateMethod) ArgsShould(path any, mode any) *FileSystemMockCreateCall {
+	_call := m.DependencyMethod.ArgsShould(path, mode)
+	return &FileSystemMockCreateCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *FileSystemMockCreateMethod) ArgsWhere(predicate func(FileSystemMockCreateArgs) error) *FileSystemMockCreateCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args FileSystemMockCreateArgs
+		args.Path, _ = raw[0].(string)
+		args.Mode, _ = raw[1].(os.FileMode)
+		return predicate(args)
+	})
 	return &FileSystemMockCreateCall{DependencyCall: call}
 }
 
@@ -82,6 +101,11 @@ func (c *FileSystemMockStatCall) Return(result0 os.FileMode, result1 time.Time,
 	c.DependencyCall.Return(result0, result1, result2)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *FileSystemMockStatCall) ReturnAfter(d time.Duration, result0 os.FileMode, result1 time.Time, result2 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1, result2)
+}
+
 type FileSystemMockStatMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -92,9 +116,21 @@ func (m *FileSystemMockStatMethod) ArgsEqual(path string) *FileSystemMockStatCal
 	return &FileSystemMockStatCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *FileSystemMockStatMethod) ArgsShould(matchers ...any) *FileSystemMockStatCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *FileSystemMockStatMethod) ArgsShould(path any) *FileSystemMockStatCall {
+	_call := m.DependencyMethod.ArgsShould(path)
+	return &FileSystemMockStatCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *FileSystemMockStatMethod) ArgsWhere(predicate func(FileSystemMockStatArgs) error) *FileSystemMockStatCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args FileSystemMockStatArgs
+		args.Path, _ = raw[0].(string)
+		return predicate(args)
+	})
 	return &FileSystemMockStatCall{DependencyCall: call}
 }
 
@@ -102,12 +138,12 @@ func (m *FileSystemMockStatMethod) ArgsShould(matchers ...any) *FileSystemMockSt
 func MockFileSystem(t _imptest.TestReporter) (crossfile.FileSystem, *FileSystemImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &FileSystemImp{
-		Stat:   newFileSystemMockStatMethod(_imptest.NewDependencyMethod(ctrl, "Stat")),
-		Create: newFileSystemMockCreateMethod(_imptest.NewDependencyMethod(ctrl, "Create")),
+		Stat:   newFileSystemMockStatMethod(_imptest.NewDependencyMethod(ctrl, "Stat").Results(_reflect.TypeFor[os.FileMode](), _reflect.TypeFor[time.Time](), _reflect.TypeFor[error]())),
+		Create: newFileSystemMockCreateMethod(_imptest.NewDependencyMethod(ctrl, "Create").Results(_reflect.TypeFor[error]())),
 	}
 	imp.Eventually = &FileSystemImpEventually{
-		Stat:   newFileSystemMockStatMethod(_imptest.NewDependencyMethod(ctrl, "Stat").AsEventually()),
-		Create: newFileSystemMockCreateMethod(_imptest.NewDependencyMethod(ctrl, "Create").AsEventually()),
+		Stat:   newFileSystemMockStatMethod(_imptest.NewDependencyMethod(ctrl, "Stat").Results(_reflect.TypeFor[os.FileMode](), _reflect.TypeFor[time.Time](), _reflect.TypeFor[error]()).AsEventually()),
+		Create: newFileSystemMockCreateMethod(_imptest.NewDependencyMethod(ctrl, "Create").Results(_reflect.TypeFor[error]()).AsEventually()),
 	}
 	mock := &mockFileSystemImpl{ctrl: ctrl}
 	return mock, imp
@@ -126,9 +162,7 @@ func (impl *mockFileSystemImpl) Create(path string, mode os.FileMode) error {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 error
 	if len(resp.ReturnValues) > 0 {
@@ -149,9 +183,7 @@ func (impl *mockFileSystemImpl) Stat(path string) (os.FileMode, time.Time, error
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 os.FileMode
 	if len(resp.ReturnValues) > 0 {
@@ -6,6 +6,8 @@ package manyparams_test
 import (
 	_imptest "github.com/toejough/imptest"
 	manyparams "github.com/toejough/imptest/UAT/variations/signature/edge-many-params"
+	_reflect "reflect"
+	_time "time"
 )
 
 type ManyParamsImp struct {
@@ -57,6 +59,11 @@ func (c *ManyParamsMockProcessCall) Return(result0 string) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *ManyParamsMockProcessCall) ReturnAfter(d _time.Duration, result0 string) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type ManyParamsMockProcessMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -67,9 +74,30 @@ func (m *ManyParamsMockProcessMethod) ArgsEqual(a int, b int, c int, d int, e in
 	return &ManyParamsMockProcessCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *ManyParamsMockProcessMethod) ArgsShould(matchers ...any) *ManyParamsMockProcessCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *ManyParamsMockProcessMethod) ArgsShould(a any, b any, c any, d any, e any, f any, g any, h any, i any, j any) *ManyParamsMockProcessCall {
+	_call := m.DependencyMethod.ArgsShould(a, b, c, d, e, f, g, h, i, j)
+	return &ManyParamsMockProcessCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *ManyParamsMockProcessMethod) ArgsWhere(predicate func(ManyParamsMockProcessArgs) error) *ManyParamsMockProcessCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args ManyParamsMockProcessArgs
+		args.A, _ = raw[0].(int)
+		args.B, _ = raw[1].(int)
+		args.C, _ = raw[2].(int)
+		args.D, _ = raw[3].(int)
+		args.E, _ = raw[4].(int)
+		args.F, _ = raw[5].(int)
+		args.G, _ = raw[6].(int)
+		args.H, _ = raw[7].(int)
+		args.I, _ = raw[8].(int)
+		args.J, _ = raw[9].(int)
+		return predicate(args)
+	})
 	return &ManyParamsMockProcessCall{DependencyCall: call}
 }
 
@@ -77,10 +105,10 @@ func (m *ManyParamsMockProcessMethod) ArgsShould(matchers ...any) *ManyParamsMoc
 func MockManyParams(t _imptest.TestReporter) (manyparams.ManyParams, *ManyParamsImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &ManyParamsImp{
-		Process: newManyParamsMockProcessMethod(_imptest.NewDependencyMethod(ctrl, "Process")),
+		Process: newManyParamsMockProcessMethod(_imptest.NewDependencyMethod(ctrl, "Process").Results(_reflect.TypeFor[string]())),
 	}
 	imp.Eventually = &ManyParamsImpEventually{
-		Process: newManyParamsMockProcessMethod(_imptest.NewDependencyMethod(ctrl, "Process").AsEventually()),
+		Process: newManyParamsMockProcessMethod(_imptest.NewDependencyMethod(ctrl, "Process").Results(_reflect.TypeFor[string]()).AsEventually()),
 	}
 	mock := &mockManyParamsImpl{ctrl: ctrl}
 	return mock, imp
@@ -99,9 +127,7 @@ func (impl *mockManyParamsImpl) Process(a int, b int, c int, d int, e int, f int
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 string
 	if len(resp.ReturnValues) > 0 {
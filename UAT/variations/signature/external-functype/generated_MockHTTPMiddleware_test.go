@@ -7,6 +7,8 @@ import (
 	_imptest "github.com/toejough/imptest"
 	middleware "github.com/toejough/imptest/UAT/variations/signature/external-functype"
 	http "net/http"
+	_reflect "reflect"
+	_time "time"
 )
 
 type HTTPMiddlewareImp struct {
@@ -40,6 +42,11 @@ func (c *HTTPMiddlewareMockWrapCall) Return(result0 http.HandlerFunc) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *HTTPMiddlewareMockWrapCall) ReturnAfter(d _time.Duration, result0 http.HandlerFunc) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type HTTPMiddlewareMockWrapMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -50,9 +57,21 @@ func (m *HTTPMiddlewareMockWrapMethod) ArgsEqual(handler http.HandlerFunc) *HTTP
 	return &HTTPMiddlewareMockWrapCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *HTTPMiddlewareMockWrapMethod) ArgsShould(matchers ...any) *HTTPMiddlewareMockWrapCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *HTTPMiddlewareMockWrapMethod) ArgsShould(handler any) *HTTPMiddlewareMockWrapCall {
+	_call := m.DependencyMethod.ArgsShould(handler)
+	return &HTTPMiddlewareMockWrapCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *HTTPMiddlewareMockWrapMethod) ArgsWhere(predicate func(HTTPMiddlewareMockWrapArgs) error) *HTTPMiddlewareMockWrapCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args HTTPMiddlewareMockWrapArgs
+		args.Handler, _ = raw[0].(http.HandlerFunc)
+		return predicate(args)
+	})
 	return &HTTPMiddlewareMockWrapCall{DependencyCall: call}
 }
 
@@ -60,10 +79,10 @@ func (m *HTTPMiddlewareMockWrapMethod) ArgsShould(matchers ...any) *HTTPMiddlewa
 func MockHTTPMiddleware(t _imptest.TestReporter) (middleware.HTTPMiddleware, *HTTPMiddlewareImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &HTTPMiddlewareImp{
-		Wrap: newHTTPMiddlewareMockWrapMethod(_imptest.NewDependencyMethod(ctrl, "Wrap")),
+		Wrap: newHTTPMiddlewareMockWrapMethod(_imptest.NewDependencyMethod(ctrl, "Wrap").Results(_reflect.TypeFor[http.HandlerFunc]())),
 	}
 	imp.Eventually = &HTTPMiddlewareImpEventually{
-		Wrap: newHTTPMiddlewareMockWrapMethod(_imptest.NewDependencyMethod(ctrl, "Wrap").AsEventually()),
+		Wrap: newHTTPMiddlewareMockWrapMethod(_imptest.NewDependencyMethod(ctrl, "Wrap").Results(_reflect.TypeFor[http.HandlerFunc]()).AsEventually()),
 	}
 	mock := &mockHTTPMiddlewareImpl{ctrl: ctrl}
 	return mock, imp
@@ -82,9 +101,7 @@ func (impl *mockHTTPMiddlewareImpl) Wrap(handler http.HandlerFunc) http.HandlerF
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 http.HandlerFunc
 	if len(resp.ReturnValues) > 0 {
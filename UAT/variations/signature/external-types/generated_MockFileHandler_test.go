@@ -8,6 +8,8 @@ import (
 	externalimports "github.com/toejough/imptest/UAT/variations/signature/external-types"
 	io "io"
 	os "os"
+	_reflect "reflect"
+	_time "time"
 )
 
 type FileHandlerImp struct {
@@ -47,6 +49,11 @@ func (c *FileHandlerMockOpenFileCall) Return(result0 *os.File, result1 error) {
 	c.DependencyCall.Return(result0, result1)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *FileHandlerMockOpenFileCall) ReturnAfter(d _time.Duration, result0 *os.File, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
 type FileHandlerMockOpenFileMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -57,9 +64,22 @@ func (m *FileHandlerMockOpenFileMethod) ArgsEqual(path string, mode os.FileMode)
 	return &FileHandlerMockOpenFileCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *FileHandlerMockOpenFileMethod) ArgsShould(matchers ...any) *FileHandlerMockOpenFileCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *FileHandlerMockOpenFileMethod) ArgsShould(path any, mode any) *FileHandlerMockOpenFileCall {
+	_call := m.DependencyMethod.ArgsShould(path, mode)
+	return &FileHandlerMockOpenFileCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *FileHandlerMockOpenFileMethod) ArgsWhere(predicate func(FileHandlerMockOpenFileArgs) error) *FileHandlerMockOpenFileCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args FileHandlerMockOpenFileArgs
+		args.Path, _ = raw[0].(string)
+		args.Mode, _ = raw[1].(os.FileMode)
+		return predicate(args)
+	})
 	return &FileHandlerMockOpenFileCall{DependencyCall: call}
 }
 
@@ -84,6 +104,11 @@ func (c *FileHandlerMockReadAllCall) Return(result0 []byte, result1 error) {
 	c.DependencyCall.Return(result0, result1)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *FileHandlerMockReadAllCall) ReturnAfter(d _time.Duration, result0 []byte, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
 type FileHandlerMockReadAllMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -94,9 +119,21 @@ func (m *FileHandlerMockReadAllMethod) ArgsEqual(r io.Reader) *FileHandlerMockRe
 	return &FileHandlerMockReadAllCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *FileHandlerMockReadAllMethod) ArgsShould(matchers ...any) *FileHandlerMockReadAllCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *FileHandlerMockReadAllMethod) ArgsShould(r any) *FileHandlerMockReadAllCall {
+	_call := m.DependencyMethod.ArgsShould(r)
+	return &FileHandlerMockReadAllCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *FileHandlerMockReadAllMethod) ArgsWhere(predicate func(FileHandlerMockReadAllArgs) error) *FileHandlerMockReadAllCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args FileHandlerMockReadAllArgs
+		args.R, _ = raw[0].(io.Reader)
+		return predicate(args)
+	})
 	return &FileHandlerMockReadAllCall{DependencyCall: call}
 }
 
@@ -121,6 +158,11 @@ func (c *FileHandlerMockStatsCall) Return(result0 os.FileInfo, result1 error) {
 	c.DependencyCall.Return(result0, result1)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *FileHandlerMockStatsCall) ReturnAfter(d _time.Duration, result0 os.FileInfo, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
 type FileHandlerMockStatsMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -131,9 +173,21 @@ func (m *FileHandlerMockStatsMethod) ArgsEqual(path string) *FileHandlerMockStat
 	return &FileHandlerMockStatsCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *FileHandlerMockStatsMethod) ArgsShould(matchers ...any) *FileHandlerMockStatsCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *FileHandlerMockStatsMethod) ArgsShould(path any) *FileHandlerMockStatsCall {
+	_call := m.DependencyMethod.ArgsShould(path)
+	return &FileHandlerMockStatsCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *FileHandlerMockStatsMethod) ArgsWhere(predicate func(FileHandlerMockStatsArgs) error) *FileHandlerMockStatsCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args FileHandlerMockStatsArgs
+		args.Path, _ = raw[0].(string)
+		return predicate(args)
+	})
 	return &FileHandlerMockStatsCall{DependencyCall: call}
 }
 
@@ -141,14 +195,14 @@ func (m *FileHandlerMockStatsMethod) ArgsShould(matchers ...any) *FileHandlerMoc
 func MockFileHandler(t _imptest.TestReporter) (externalimports.FileHandler, *FileHandlerImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &FileHandlerImp{
-		ReadAll:  newFileHandlerMockReadAllMethod(_imptest.NewDependencyMethod(ctrl, "ReadAll")),
-		OpenFile: newFileHandlerMockOpenFileMethod(_imptest.NewDependencyMethod(ctrl, "OpenFile")),
-		Stats:    newFileHandlerMockStatsMethod(_imptest.NewDependencyMethod(ctrl, "Stats")),
+		ReadAll:  newFileHandlerMockReadAllMethod(_imptest.NewDependencyMethod(ctrl, "ReadAll").Results(_reflect.TypeFor[[]byte](), _reflect.TypeFor[error]())),
+		OpenFile: newFileHandlerMockOpenFileMethod(_imptest.NewDependencyMethod(ctrl, "OpenFile").Results(_reflect.TypeFor[*os.File](), _reflect.TypeFor[error]())),
+		Stats:    newFileHandlerMockStatsMethod(_imptest.NewDependencyMethod(ctrl, "Stats").Results(_reflect.TypeFor[os.FileInfo](), _reflect.TypeFor[error]())),
 	}
 	imp.Eventually = &FileHandlerImpEventually{
-		ReadAll:  newFileHandlerMockReadAllMethod(_imptest.NewDependencyMethod(ctrl, "ReadAll").AsEventually()),
-		OpenFile: newFileHandlerMockOpenFileMethod(_imptest.NewDependencyMethod(ctrl, "OpenFile").AsEventually()),
-		Stats:    newFileHandlerMockStatsMethod(_imptest.NewDependencyMethod(ctrl, "Stats").AsEventually()),
+		ReadAll:  newFileHandlerMockReadAllMethod(_imptest.NewDependencyMethod(ctrl, "ReadAll").Results(_reflect.TypeFor[[]byte](), _reflect.TypeFor[error]()).AsEventually()),
+		OpenFile: newFileHandlerMockOpenFileMethod(_imptest.NewDependencyMethod(ctrl, "OpenFile").Results(_reflect.TypeFor[*os.File](), _reflect.TypeFor[error]()).AsEventually()),
+		Stats:    newFileHandlerMockStatsMethod(_imptest.NewDependencyMethod(ctrl, "Stats").Results(_reflect.TypeFor[os.FileInfo](), _reflect.TypeFor[error]()).AsEventually()),
 	}
 	mock := &mockFileHandlerImpl{ctrl: ctrl}
 	return mock, imp
@@ -167,9 +221,7 @@ func (impl *mockFileHandlerImpl) OpenFile(path string, mode os.FileMode) (*os.Fi
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 *os.File
 	if len(resp.ReturnValues) > 0 {
@@ -197,9 +249,7 @@ func (impl *mockFileHandlerImpl) ReadAll(r io.Reader) ([]byte, error) {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 []byte
 	if len(resp.ReturnValues) > 0 {
@@ -227,9 +277,7 @@ func (impl *mockFileHandlerImpl) Stats(path string) (os.FileInfo, error) {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 os.FileInfo
 	if len(resp.ReturnValues) > 0 {
@@ -6,6 +6,8 @@ package funclit_test
 import (
 	_imptest "github.com/toejough/imptest"
 	funclit "github.com/toejough/imptest/UAT/variations/signature/function-literal"
+	_reflect "reflect"
+	_time "time"
 )
 
 type DataProcessorImp struct {
@@ -31,6 +33,12 @@ type DataProcessorMockFilterCall struct {
 	*_imptest.DependencyCall
 }
 
+// FillItems copies values into the caller's Items slice before the mock returns.
+func (c *DataProcessorMockFilterCall) FillItems(values []int) *DataProcessorMockFilterCall {
+	c.FillArg(0, values)
+	return c
+}
+
 // GetArgs returns the typed arguments for this call.
 func (c *DataProcessorMockFilterCall) GetArgs() DataProcessorMockFilterArgs {
 	raw := c.RawArgs()
@@ -45,6 +53,11 @@ func (c *DataProcessorMockFilterCall) Return(result0 []int) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *DataProcessorMockFilterCall) ReturnAfter(d _time.Duration, result0 []int) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type DataProcessorMockFilterMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -55,9 +68,22 @@ func (m *DataProcessorMockFilterMethod) ArgsEqual(items []int, predicate func(in
 	return &DataProcessorMockFilterCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *DataProcessorMockFilterMethod) ArgsShould(matchers ...any) *DataProcessorMockFilterCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *DataProcessorMockFilterMethod) ArgsShould(items any, predicate any) *DataProcessorMockFilterCall {
+	_call := m.DependencyMethod.ArgsShould(items, predicate)
+	return &DataProcessorMockFilterCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *DataProcessorMockFilterMethod) ArgsWhere(predicate func(DataProcessorMockFilterArgs) error) *DataProcessorMockFilterCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args DataProcessorMockFilterArgs
+		args.Items, _ = raw[0].([]int)
+		args.Predicate, _ = raw[1].(func(int) bool)
+		return predicate(args)
+	})
 	return &DataProcessorMockFilterCall{DependencyCall: call}
 }
 
@@ -71,6 +97,12 @@ type DataProcessorMockReduceCall struct {
 	*_imptest.DependencyCall
 }
 
+// FillItems copies values into the caller's Items slice before the mock returns.
+func (c *DataProcessorMockReduceCall) FillItems(values []int) *DataProcessorMockReduceCall {
+	c.FillArg(0, values)
+	return c
+}
+
 // GetArgs returns the typed arguments for this call.
 func (c *DataProcessorMockReduceCall) GetArgs() DataProcessorMockReduceArgs {
 	raw := c.RawArgs()
@@ -86,6 +118,11 @@ func (c *DataProcessorMockReduceCall) Return(result0 int) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *DataProcessorMockReduceCall) ReturnAfter(d _time.Duration, result0 int) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type DataProcessorMockReduceMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -96,9 +133,23 @@ func (m *DataProcessorMockReduceMethod) ArgsEqual(items []int, initial int, redu
 	return &DataProcessorMockReduceCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *DataProcessorMockReduceMethod) ArgsShould(matchers ...any) *DataProcessorMockReduceCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *DataProcessorMockReduceMethod) ArgsShould(items any, initial any, reducer any) *DataProcessorMockReduceCall {
+	_call := m.DependencyMethod.ArgsShould(items, initial, reducer)
+	return &DataProcessorMockReduceCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *DataProcessorMockReduceMethod) ArgsWhere(predicate func(DataProcessorMockReduceArgs) error) *DataProcessorMockReduceCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args DataProcessorMockReduceArgs
+		args.Items, _ = raw[0].([]int)
+		args.Initial, _ = raw[1].(int)
+		args.Reducer, _ = raw[2].(func(int, int) int)
+		return predicate(args)
+	})
 	return &DataProcessorMockReduceCall{DependencyCall: call}
 }
 
@@ -111,6 +162,12 @@ type DataProcessorMockTransformCall struct {
 	*_imptest.DependencyCall
 }
 
+// FillItems copies values into the caller's Items slice before the mock returns.
+func (c *DataProcessorMockTransformCall) FillItems(values []int) *DataProcessorMockTransformCall {
+	c.FillArg(0, values)
+	return c
+}
+
 // GetArgs returns the typed arguments for this call.
 func (c *DataProcessorMockTransformCall) GetArgs() DataProcessorMockTransformArgs {
 	raw := c.RawArgs()
@@ -125,6 +182,11 @@ func (c *DataProcessorMockTransformCall) Return(result0 []int, result1 error) {
 	c.DependencyCall.Return(result0, result1)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *DataProcessorMockTransformCall) ReturnAfter(d _time.Duration, result0 []int, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
 type DataProcessorMockTransformMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -135,9 +197,22 @@ func (m *DataProcessorMockTransformMethod) ArgsEqual(items []int, fn func(int) (
 	return &DataProcessorMockTransformCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *DataProcessorMockTransformMethod) ArgsShould(matchers ...any) *DataProcessorMockTransformCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *DataProcessorMockTransformMethod) ArgsShould(items any, fn any) *DataProcessorMockTransformCall {
+	_call := m.DependencyMethod.ArgsShould(items, fn)
+	return &DataProcessorMockTransformCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *DataProcessorMockTransformMethod) ArgsWhere(predicate func(DataProcessorMockTransformArgs) error) *DataProcessorMockTransformCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args DataProcessorMockTransformArgs
+		args.Items, _ = raw[0].([]int)
+		args.Fn, _ = raw[1].(func(int) (int, error))
+		return predicate(args)
+	})
 	return &DataProcessorMockTransformCall{DependencyCall: call}
 }
 
@@ -145,14 +220,14 @@ func (m *DataProcessorMockTransformMethod) ArgsShould(matchers ...any) *DataProc
 func MockDataProcessor(t _imptest.TestReporter) (funclit.DataProcessor, *DataProcessorImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &DataProcessorImp{
-		Transform: newDataProcessorMockTransformMethod(_imptest.NewDependencyMethod(ctrl, "Transform")),
-		Filter:    newDataProcessorMockFilterMethod(_imptest.NewDependencyMethod(ctrl, "Filter")),
-		Reduce:    newDataProcessorMockReduceMethod(_imptest.NewDependencyMethod(ctrl, "Reduce")),
+		Transform: newDataProcessorMockTransformMethod(_imptest.NewDependencyMethod(ctrl, "Transform").Results(_reflect.TypeFor[[]int](), _reflect.TypeFor[error]())),
+		Filter:    newDataProcessorMockFilterMethod(_imptest.NewDependencyMethod(ctrl, "Filter").Results(_reflect.TypeFor[[]int]())),
+		Reduce:    newDataProcessorMockReduceMethod(_imptest.NewDependencyMethod(ctrl, "Reduce").Results(_reflect.TypeFor[int]())),
 	}
 	imp.Eventually = &DataProcessorImpEventually{
-		Transform: newDataProcessorMockTransformMethod(_imptest.NewDependencyMethod(ctrl, "Transform").AsEventually()),
-		Filter:    newDataProcessorMockFilterMethod(_imptest.NewDependencyMethod(ctrl, "Filter").AsEventually()),
-		Reduce:    newDataProcessorMockReduceMethod(_imptest.NewDependencyMethod(ctrl, "Reduce").AsEventually()),
+		Transform: newDataProcessorMockTransformMethod(_imptest.NewDependencyMethod(ctrl, "Transform").Results(_reflect.TypeFor[[]int](), _reflect.TypeFor[error]()).AsEventually()),
+		Filter:    newDataProcessorMockFilterMethod(_imptest.NewDependencyMethod(ctrl, "Filter").Results(_reflect.TypeFor[[]int]()).AsEventually()),
+		Reduce:    newDataProcessorMockReduceMethod(_imptest.NewDependencyMethod(ctrl, "Reduce").Results(_reflect.TypeFor[int]()).AsEventually()),
 	}
 	mock := &mockDataProcessorImpl{ctrl: ctrl}
 	return mock, imp
@@ -171,9 +246,7 @@ func (impl *mockDataProcessorImpl) Filter(items []int, predicate func(int) bool)
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 []int
 	if len(resp.ReturnValues) > 0 {
@@ -194,9 +267,7 @@ func (impl *mockDataProcessorImpl) Reduce(items []int, initial int, reducer func
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 int
 	if len(resp.ReturnValues) > 0 {
@@ -217,9 +288,7 @@ func (impl *mockDataProcessorImpl) Transform(items []int, fn func(int) (int, err
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 []int
 	if len(resp.ReturnValues) > 0 {
@@ -6,6 +6,8 @@ package generics_test
 import (
 	_imptest "github.com/toejough/imptest"
 	generics "github.com/toejough/imptest/UAT/variations/signature/generics"
+	_reflect "reflect"
+	_time "time"
 )
 
 type RepositoryImp[T any] struct {
@@ -41,6 +43,11 @@ func (c *RepositoryMockGetCall[T]) Return(result0 T, result1 error) {
 	c.DependencyCall.Return(result0, result1)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *RepositoryMockGetCall[T]) ReturnAfter(d _time.Duration, result0 T, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
 type RepositoryMockGetMethod[T any] struct {
 	*_imptest.DependencyMethod
 }
@@ -51,9 +58,21 @@ func (m *RepositoryMockGetMethod[T]) ArgsEqual(id string) *RepositoryMockGetCall
 	return &RepositoryMockGetCall[T]{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *RepositoryMockGetMethod[T]) ArgsShould(matchers ...any) *RepositoryMockGetCall[T] {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *RepositoryMockGetMethod[T]) ArgsShould(id any) *RepositoryMockGetCall[T] {
+	_call := m.DependencyMethod.ArgsShould(id)
+	return &RepositoryMockGetCall[T]{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *RepositoryMockGetMethod[T]) ArgsWhere(predicate func(RepositoryMockGetArgs[T]) error) *RepositoryMockGetCall[T] {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args RepositoryMockGetArgs[T]
+		args.Id, _ = raw[0].(string)
+		return predicate(args)
+	})
 	return &RepositoryMockGetCall[T]{DependencyCall: call}
 }
 
@@ -78,6 +97,11 @@ func (c *RepositoryMockSaveCall[T]) Return(result0 error) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *RepositoryMockSaveCall[T]) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type RepositoryMockSaveMethod[T any] struct {
 	*_imptest.DependencyMethod
 }
@@ -88,9 +112,21 @@ func (m *RepositoryMockSaveMethod[T]) ArgsEqual(item T) *RepositoryMockSaveCall[
 	return &RepositoryMockSaveCall[T]{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *RepositoryMockSaveMethod[T]) ArgsShould(matchers ...any) *RepositoryMockSaveCall[T] {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *RepositoryMockSaveMethod[T]) ArgsShould(item any) *RepositoryMockSaveCall[T] {
+	_call := m.DependencyMethod.ArgsShould(item)
+	return &RepositoryMockSaveCall[T]{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *RepositoryMockSaveMethod[T]) ArgsWhere(predicate func(RepositoryMockSaveArgs[T]) error) *RepositoryMockSaveCall[T] {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args RepositoryMockSaveArgs[T]
+		args.Item, _ = raw[0].(T)
+		return predicate(args)
+	})
 	return &RepositoryMockSaveCall[T]{DependencyCall: call}
 }
 
@@ -98,12 +134,12 @@ func (m *RepositoryMockSaveMethod[T]) ArgsShould(matchers ...any) *RepositoryMoc
 func MockRepository[T any](t _imptest.TestReporter) (generics.Repository[T], *RepositoryImp[T]) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &RepositoryImp[T]{
-		Save: newRepositoryMockSaveMethod[T](_imptest.NewDependencyMethod(ctrl, "Save")),
-		Get:  newRepositoryMockGetMethod[T](_imptest.NewDependencyMethod(ctrl, "Get")),
+		Save: newRepositoryMockSaveMethod[T](_imptest.NewDependencyMethod(ctrl, "Save").Results(_reflect.TypeFor[error]())),
+		Get:  newRepositoryMockGetMethod[T](_imptest.NewDependencyMethod(ctrl, "Get").Results(_reflect.TypeFor[T](), _reflect.TypeFor[error]())),
 	}
 	imp.Eventually = &RepositoryImpEventually[T]{
-		Save: newRepositoryMockSaveMethod[T](_imptest.NewDependencyMethod(ctrl, "Save").AsEventually()),
-		Get:  newRepositoryMockGetMethod[T](_imptest.NewDependencyMethod(ctrl, "Get").AsEventually()),
+		Save: newRepositoryMockSaveMethod[T](_imptest.NewDependencyMethod(ctrl, "Save").Results(_reflect.TypeFor[error]()).AsEventually()),
+		Get:  newRepositoryMockGetMethod[T](_imptest.NewDependencyMethod(ctrl, "Get").Results(_reflect.TypeFor[T](), _reflect.TypeFor[error]()).AsEventually()),
 	}
 	mock := &mockRepositoryImpl[T]{ctrl: ctrl}
 	return mock, imp
@@ -122,9 +158,7 @@ func (impl *mockRepositoryImpl[T]) Get(id string) (T, error) {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 T
 	if len(resp.ReturnValues) > 0 {
@@ -152,9 +186,7 @@ func (impl *mockRepositoryImpl[T]) Save(item T) error {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 error
 	if len(resp.ReturnValues) > 0 {
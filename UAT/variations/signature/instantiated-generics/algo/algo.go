@@ -0,0 +1,27 @@
+// Package algo declares generic helpers over slices.
+package algo
+
+// Predicate reports whether item should be kept.
+type Predicate[T any] func(item T) bool
+
+// Filter returns the elements of in that keep reports true for.
+func Filter[T any](in []T, keep Predicate[T]) []T {
+	out := make([]T, 0, len(in))
+	for _, item := range in {
+		if keep(item) {
+			out = append(out, item)
+		}
+	}
+
+	return out
+}
+
+// Map returns the result of applying transform to each element of in.
+func Map[T, U any](in []T, transform func(T) U) []U {
+	out := make([]U, 0, len(in))
+	for _, item := range in {
+		out = append(out, transform(item))
+	}
+
+	return out
+}
@@ -0,0 +1,301 @@
+// Code generated by impgen. DO NOT EDIT.
+// impgen:hash:28d772b670ff849f
+
+package instantiated_test
+
+import (
+	_imptest "github.com/toejough/imptest"
+	model "github.com/toejough/imptest/UAT/variations/signature/instantiated-generics/model"
+	store "github.com/toejough/imptest/UAT/variations/signature/instantiated-generics/store"
+	_reflect "reflect"
+	_time "time"
+)
+
+type UserRepoImp struct {
+	Get  *UserRepoMockGetMethod
+	List *UserRepoMockListMethod
+	Save *UserRepoMockSaveMethod
+	// Eventually provides async versions of all methods for concurrent code.
+	Eventually *UserRepoImpEventually
+}
+
+type UserRepoImpEventually struct {
+	Get  *UserRepoMockGetMethod
+	List *UserRepoMockListMethod
+	Save *UserRepoMockSaveMethod
+}
+
+type UserRepoMockGetArgs struct {
+	Id string
+}
+
+type UserRepoMockGetCall struct {
+	*_imptest.DependencyCall
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *UserRepoMockGetCall) GetArgs() UserRepoMockGetArgs {
+	raw := c.RawArgs()
+	return UserRepoMockGetArgs{
+		Id: raw[0].(string),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *UserRepoMockGetCall) Return(result0 model.User, result1 error) {
+	c.DependencyCall.Return(result0, result1)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *UserRepoMockGetCall) ReturnAfter(d _time.Duration, result0 model.User, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
+type UserRepoMockGetMethod struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *UserRepoMockGetMethod) ArgsEqual(id string) *UserRepoMockGetCall {
+	call := m.DependencyMethod.ArgsEqual(id)
+	return &UserRepoMockGetCall{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *UserRepoMockGetMethod) ArgsShould(id any) *UserRepoMockGetCall {
+	_call := m.DependencyMethod.ArgsShould(id)
+	return &UserRepoMockGetCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *UserRepoMockGetMethod) ArgsWhere(predicate func(UserRepoMockGetArgs) error) *UserRepoMockGetCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args UserRepoMockGetArgs
+		args.Id, _ = raw[0].(string)
+		return predicate(args)
+	})
+	return &UserRepoMockGetCall{DependencyCall: call}
+}
+
+type UserRepoMockListArgs struct {
+	Cursor string
+}
+
+type UserRepoMockListCall struct {
+	*_imptest.DependencyCall
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *UserRepoMockListCall) GetArgs() UserRepoMockListArgs {
+	raw := c.RawArgs()
+	return UserRepoMockListArgs{
+		Cursor: raw[0].(string),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *UserRepoMockListCall) Return(result0 store.Page[model.User], result1 error) {
+	c.DependencyCall.Return(result0, result1)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *UserRepoMockListCall) ReturnAfter(d _time.Duration, result0 store.Page[model.User], result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
+type UserRepoMockListMethod struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *UserRepoMockListMethod) ArgsEqual(cursor string) *UserRepoMockListCall {
+	call := m.DependencyMethod.ArgsEqual(cursor)
+	return &UserRepoMockListCall{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *UserRepoMockListMethod) ArgsShould(cursor any) *UserRepoMockListCall {
+	_call := m.DependencyMethod.ArgsShould(cursor)
+	return &UserRepoMockListCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *UserRepoMockListMethod) ArgsWhere(predicate func(UserRepoMockListArgs) error) *UserRepoMockListCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args UserRepoMockListArgs
+		args.Cursor, _ = raw[0].(string)
+		return predicate(args)
+	})
+	return &UserRepoMockListCall{DependencyCall: call}
+}
+
+type UserRepoMockSaveArgs struct {
+	Item model.User
+}
+
+type UserRepoMockSaveCall struct {
+	*_imptest.DependencyCall
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *UserRepoMockSaveCall) GetArgs() UserRepoMockSaveArgs {
+	raw := c.RawArgs()
+	return UserRepoMockSaveArgs{
+		Item: raw[0].(model.User),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *UserRepoMockSaveCall) Return(result0 error) {
+	c.DependencyCall.Return(result0)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *UserRepoMockSaveCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
+type UserRepoMockSaveMethod struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *UserRepoMockSaveMethod) ArgsEqual(item model.User) *UserRepoMockSaveCall {
+	call := m.DependencyMethod.ArgsEqual(item)
+	return &UserRepoMockSaveCall{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *UserRepoMockSaveMethod) ArgsShould(item any) *UserRepoMockSaveCall {
+	_call := m.DependencyMethod.ArgsShould(item)
+	return &UserRepoMockSaveCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *UserRepoMockSaveMethod) ArgsWhere(predicate func(UserRepoMockSaveArgs) error) *UserRepoMockSaveCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args UserRepoMockSaveArgs
+		args.Item, _ = raw[0].(model.User)
+		return predicate(args)
+	})
+	return &UserRepoMockSaveCall{DependencyCall: call}
+}
+
+// MockUserRepo creates a mock Repo and returns (mock, expectation handle).
+func MockUserRepo(t _imptest.TestReporter) (store.Repo[model.User], *UserRepoImp) {
+	ctrl := _imptest.GetOrCreateImp(t)
+	imp := &UserRepoImp{
+		Get:  newUserRepoMockGetMethod(_imptest.NewDependencyMethod(ctrl, "Get").Results(_reflect.TypeFor[model.User](), _reflect.TypeFor[error]())),
+		List: newUserRepoMockListMethod(_imptest.NewDependencyMethod(ctrl, "List").Results(_reflect.TypeFor[store.Page[model.User]](), _reflect.TypeFor[error]())),
+		Save: newUserRepoMockSaveMethod(_imptest.NewDependencyMethod(ctrl, "Save").Results(_reflect.TypeFor[error]())),
+	}
+	imp.Eventually = &UserRepoImpEventually{
+		Get:  newUserRepoMockGetMethod(_imptest.NewDependencyMethod(ctrl, "Get").Results(_reflect.TypeFor[model.User](), _reflect.TypeFor[error]()).AsEventually()),
+		List: newUserRepoMockListMethod(_imptest.NewDependencyMethod(ctrl, "List").Results(_reflect.TypeFor[store.Page[model.User]](), _reflect.TypeFor[error]()).AsEventually()),
+		Save: newUserRepoMockSaveMethod(_imptest.NewDependencyMethod(ctrl, "Save").Results(_reflect.TypeFor[error]()).AsEventually()),
+	}
+	mock := &mockUserRepoImpl{ctrl: ctrl}
+	return mock, imp
+}
+
+type mockUserRepoImpl struct {
+	ctrl *_imptest.Imp
+}
+
+// Get implements store.Repo[model.User].Get.
+func (impl *mockUserRepoImpl) Get(id string) (model.User, error) {
+	call := &_imptest.GenericCall{
+		MethodName:   "Get",
+		Args:         []any{id},
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 model.User
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(model.User); ok {
+			result1 = value
+		}
+	}
+
+	var result2 error
+	if len(resp.ReturnValues) > 1 {
+		if value, ok := resp.ReturnValues[1].(error); ok {
+			result2 = value
+		}
+	}
+
+	return result1, result2
+}
+
+// List implements store.Repo[model.User].List.
+func (impl *mockUserRepoImpl) List(cursor string) (store.Page[model.User], error) {
+	call := &_imptest.GenericCall{
+		MethodName:   "List",
+		Args:         []any{cursor},
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 store.Page[model.User]
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(store.Page[model.User]); ok {
+			result1 = value
+		}
+	}
+
+	var result2 error
+	if len(resp.ReturnValues) > 1 {
+		if value, ok := resp.ReturnValues[1].(error); ok {
+			result2 = value
+		}
+	}
+
+	return result1, result2
+}
+
+// Save implements store.Repo[model.User].Save.
+func (impl *mockUserRepoImpl) Save(item model.User) error {
+	call := &_imptest.GenericCall{
+		MethodName:   "Save",
+		Args:         []any{item},
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 error
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].(error); ok {
+			result1 = value
+		}
+	}
+
+	return result1
+}
+
+// newUserRepoMockGetMethod creates a typed method wrapper.
+func newUserRepoMockGetMethod(dm *_imptest.DependencyMethod) *UserRepoMockGetMethod {
+	return &UserRepoMockGetMethod{DependencyMethod: dm}
+}
+
+// newUserRepoMockListMethod creates a typed method wrapper.
+func newUserRepoMockListMethod(dm *_imptest.DependencyMethod) *UserRepoMockListMethod {
+	return &UserRepoMockListMethod{DependencyMethod: dm}
+}
+
+// newUserRepoMockSaveMethod creates a typed method wrapper.
+func newUserRepoMockSaveMethod(dm *_imptest.DependencyMethod) *UserRepoMockSaveMethod {
+	return &UserRepoMockSaveMethod{DependencyMethod: dm}
+}
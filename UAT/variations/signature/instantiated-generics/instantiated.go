@@ -0,0 +1,27 @@
+// Package instantiated demonstrates generating non-generic mocks and wrappers from generic symbols
+// instantiated with concrete type arguments.
+package instantiated
+
+import (
+	"fmt"
+
+	"github.com/toejough/imptest/UAT/variations/signature/instantiated-generics/model"
+	"github.com/toejough/imptest/UAT/variations/signature/instantiated-generics/store"
+)
+
+// Rename loads the user with the given ID from repo and saves it back under a new name.
+func Rename(repo store.Repo[model.User], id, name string) error {
+	user, err := repo.Get(id)
+	if err != nil {
+		return fmt.Errorf("failed to get user %s: %w", id, err)
+	}
+
+	user.Name = name
+
+	err = repo.Save(user)
+	if err != nil {
+		return fmt.Errorf("failed to save user %s: %w", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package instantiated_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	instantiated "github.com/toejough/imptest/UAT/variations/signature/instantiated-generics"
+	"github.com/toejough/imptest/UAT/variations/signature/instantiated-generics/algo"
+	"github.com/toejough/imptest/UAT/variations/signature/instantiated-generics/model"
+	"github.com/toejough/imptest/UAT/variations/signature/instantiated-generics/store"
+)
+
+// Type arguments are written as they would be in this file, so their packages must be imported here.
+//go:generate impgen store.Repo[model.User] --dependency --name MockUserRepo
+//go:generate impgen store.Repo[string] --dependency
+//go:generate impgen algo.Map[int,string] --target
+//go:generate impgen algo.Map[model.User,string] --target
+//go:generate impgen algo.Predicate[model.User] --dependency
+
+// TestInstantiated_DefaultName demonstrates the default name of an instantiated mock.
+//
+// Key Requirements Met:
+//  1. Without --name, the names of the type arguments are appended to the symbol's name, so each
+//     instantiation of the same generic gets its own mock (store.Repo[string] -> MockRepoString).
+//  2. Generic types in method signatures are instantiated too (store.Page[T] -> store.Page[string]).
+func TestInstantiated_DefaultName(t *testing.T) {
+	t.Parallel()
+
+	repo, repoImp := MockRepoString(t)
+
+	resultChan := make(chan store.Page[string], 1)
+
+	go func() {
+		page, _ := repo.List("")
+		resultChan <- page
+	}()
+
+	repoImp.List.ArgsEqual("").Return(store.Page[string]{Items: []string{"a", "b"}}, nil)
+
+	page := <-resultChan
+	if len(page.Items) != 2 {
+		t.Fatalf("List() = %+v, want two items", page)
+	}
+}
+
+// TestInstantiated_Dependency demonstrates mocking a generic interface instantiated with a type from
+// another package.
+//
+// Key Requirements Met:
+//  1. The mock is not generic: its constructor takes no type arguments and returns the instantiated
+//     interface (store.Repo[model.User]).
+//  2. Args and returns have the concrete type, so no conversions or type assertions are needed.
+//  3. The generated code imports the packages its type arguments come from.
+func TestInstantiated_Dependency(t *testing.T) {
+	t.Parallel()
+
+	repo, repoImp := MockUserRepo(t)
+
+	errChan := make(chan error, 1)
+
+	go func() {
+		errChan <- instantiated.Rename(repo, "u1", "Grace")
+	}()
+
+	repoImp.Get.ArgsEqual("u1").Return(model.User{ID: "u1", Name: "Ada"}, nil)
+
+	repoImp.Save.ArgsEqual(model.User{ID: "u1", Name: "Grace"}).Return(nil)
+
+	err := <-errChan
+	if err != nil {
+		t.Fatalf("Rename() error = %v", err)
+	}
+}
+
+// TestInstantiated_DependencyError demonstrates returning an error from an instantiated mock.
+func TestInstantiated_DependencyError(t *testing.T) {
+	t.Parallel()
+
+	repo, repoImp := MockUserRepo(t)
+	errMissing := errors.New("missing")
+
+	errChan := make(chan error, 1)
+
+	go func() {
+		errChan <- instantiated.Rename(repo, "u2", "Grace")
+	}()
+
+	repoImp.Get.ArgsEqual("u2").Return(model.User{}, errMissing)
+
+	err := <-errChan
+	if !errors.Is(err, errMissing) {
+		t.Fatalf("Rename() error = %v, want %v", err, errMissing)
+	}
+}
+
+// TestInstantiated_FunctionType demonstrates mocking a generic function type instantiated with a type
+// from another package.
+//
+// Key Requirements Met:
+//  1. The mock is a plain func(model.User) bool that can be passed where an algo.Predicate[model.User]
+//     is expected.
+func TestInstantiated_FunctionType(t *testing.T) {
+	t.Parallel()
+
+	keep, keepImp := MockPredicateUser(t)
+
+	users := []model.User{{ID: "u1", Name: "Ada"}, {ID: "u2", Name: "Grace"}}
+	resultChan := make(chan []model.User, 1)
+
+	go func() {
+		resultChan <- algo.Filter(users, keep)
+	}()
+
+	keepImp.ArgsEqual(users[0]).Return(false)
+	keepImp.ArgsEqual(users[1]).Return(true)
+
+	if kept := <-resultChan; len(kept) != 1 || kept[0].ID != "u2" {
+		t.Fatalf("Filter() = %+v, want only u2", kept)
+	}
+}
+
+// TestInstantiated_Target demonstrates wrapping a generic function instantiated with type arguments.
+//
+// Key Requirements Met:
+//  1. The wrapper is not generic: it is started without type arguments, and its parameters and
+//     returns have the concrete types ([]int, func(int) string, []string).
+func TestInstantiated_Target(t *testing.T) {
+	t.Parallel()
+
+	call := StartMapIntString(t, algo.Map[int, string], []int{1, 2}, strconv.Itoa)
+
+	call.ReturnsEqual([]string{"1", "2"})
+}
+
+// TestInstantiated_TargetExternalTypeArg demonstrates wrapping a generic function instantiated with a
+// type from another package.
+//
+// Key Requirements Met:
+//  1. The wrapper imports the package the type argument comes from, even though the generic
+//     function's own package never imports it.
+func TestInstantiated_TargetExternalTypeArg(t *testing.T) {
+	t.Parallel()
+
+	users := []model.User{{ID: "u1", Name: "Ada"}, {ID: "u2", Name: "Grace"}}
+	userName := func(user model.User) string { return user.Name }
+
+	call := StartMapUserString(t, algo.Map[model.User, string], users, userName)
+
+	call.ReturnsEqual([]string{"Ada", "Grace"})
+}
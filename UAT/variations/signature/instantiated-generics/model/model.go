@@ -0,0 +1,8 @@
+// Package model declares the domain types the instantiated-generics demo substitutes as type arguments.
+package model
+
+// User is a stored user.
+type User struct {
+	ID   string
+	Name string
+}
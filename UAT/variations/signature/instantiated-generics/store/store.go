@@ -0,0 +1,15 @@
+// Package store declares a generic repository, independent of any domain type.
+package store
+
+// Page is one page of items from a listing.
+type Page[T any] struct {
+	Items []T
+	Next  string
+}
+
+// Repo stores items of type T by ID.
+type Repo[T any] interface {
+	Get(id string) (T, error)
+	List(cursor string) (Page[T], error)
+	Save(item T) error
+}
@@ -6,6 +6,8 @@ package interfaceliteral_test
 import (
 	_imptest "github.com/toejough/imptest"
 	interfaceliteral "github.com/toejough/imptest/UAT/variations/signature/interface-literal"
+	_reflect "reflect"
+	_time "time"
 )
 
 type DataProcessorImp struct {
@@ -45,6 +47,11 @@ func (c *DataProcessorMockProcessCall) Return(result0 string) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *DataProcessorMockProcessCall) ReturnAfter(d _time.Duration, result0 string) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type DataProcessorMockProcessMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -55,9 +62,21 @@ func (m *DataProcessorMockProcessMethod) ArgsEqual(obj interface{ Get() string }
 	return &DataProcessorMockProcessCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *DataProcessorMockProcessMethod) ArgsShould(matchers ...any) *DataProcessorMockProcessCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *DataProcessorMockProcessMethod) ArgsShould(obj any) *DataProcessorMockProcessCall {
+	_call := m.DependencyMethod.ArgsShould(obj)
+	return &DataProcessorMockProcessCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *DataProcessorMockProcessMethod) ArgsWhere(predicate func(DataProcessorMockProcessArgs) error) *DataProcessorMockProcessCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args DataProcessorMockProcessArgs
+		args.Obj, _ = raw[0].(interface{ Get() string })
+		return predicate(args)
+	})
 	return &DataProcessorMockProcessCall{DependencyCall: call}
 }
 
@@ -82,6 +101,11 @@ func (c *DataProcessorMockProcessWithReturnCall) Return(result0 interface{ Resul
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *DataProcessorMockProcessWithReturnCall) ReturnAfter(d _time.Duration, result0 interface{ Result() string }) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type DataProcessorMockProcessWithReturnMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -92,9 +116,21 @@ func (m *DataProcessorMockProcessWithReturnMethod) ArgsEqual(input string) *Data
 	return &DataProcessorMockProcessWithReturnCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *DataProcessorMockProcessWithReturnMethod) ArgsShould(matchers ...any) *DataProcessorMockProcessWithReturnCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *DataProcessorMockProcessWithReturnMethod) ArgsShould(input any) *DataProcessorMockProcessWithReturnCall {
+	_call := m.DependencyMethod.ArgsShould(input)
+	return &DataProcessorMockProcessWithReturnCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *DataProcessorMockProcessWithReturnMethod) ArgsWhere(predicate func(DataProcessorMockProcessWithReturnArgs) error) *DataProcessorMockProcessWithReturnCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args DataProcessorMockProcessWithReturnArgs
+		args.Input, _ = raw[0].(string)
+		return predicate(args)
+	})
 	return &DataProcessorMockProcessWithReturnCall{DependencyCall: call}
 }
 
@@ -125,6 +161,11 @@ func (c *DataProcessorMockTransformCall) Return(result0 int) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *DataProcessorMockTransformCall) ReturnAfter(d _time.Duration, result0 int) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type DataProcessorMockTransformMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -138,9 +179,24 @@ func (m *DataProcessorMockTransformMethod) ArgsEqual(obj interface {
 	return &DataProcessorMockTransformCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *DataProcessorMockTransformMethod) ArgsShould(matchers ...any) *DataProcessorMockTransformCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *DataProcessorMockTransformMethod) ArgsShould(obj any) *DataProcessorMockTransformCall {
+	_call := m.DependencyMethod.ArgsShould(obj)
+	return &DataProcessorMockTransformCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *DataProcessorMockTransformMethod) ArgsWhere(predicate func(DataProcessorMockTransformArgs) error) *DataProcessorMockTransformCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args DataProcessorMockTransformArgs
+		args.Obj, _ = raw[0].(interface {
+			GetValue() int
+			SetValue(int)
+		})
+		return predicate(args)
+	})
 	return &DataProcessorMockTransformCall{DependencyCall: call}
 }
 
@@ -165,6 +221,11 @@ func (c *DataProcessorMockValidateCall) Return(result0 error) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *DataProcessorMockValidateCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type DataProcessorMockValidateMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -175,9 +236,21 @@ func (m *DataProcessorMockValidateMethod) ArgsEqual(validator interface{ Check(s
 	return &DataProcessorMockValidateCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *DataProcessorMockValidateMethod) ArgsShould(matchers ...any) *DataProcessorMockValidateCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *DataProcessorMockValidateMethod) ArgsShould(validator any) *DataProcessorMockValidateCall {
+	_call := m.DependencyMethod.ArgsShould(validator)
+	return &DataProcessorMockValidateCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *DataProcessorMockValidateMethod) ArgsWhere(predicate func(DataProcessorMockValidateArgs) error) *DataProcessorMockValidateCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args DataProcessorMockValidateArgs
+		args.Validator, _ = raw[0].(interface{ Check(string) error })
+		return predicate(args)
+	})
 	return &DataProcessorMockValidateCall{DependencyCall: call}
 }
 
@@ -185,16 +258,16 @@ func (m *DataProcessorMockValidateMethod) ArgsShould(matchers ...any) *DataProce
 func MockDataProcessor(t _imptest.TestReporter) (interfaceliteral.DataProcessor, *DataProcessorImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &DataProcessorImp{
-		Process:           newDataProcessorMockProcessMethod(_imptest.NewDependencyMethod(ctrl, "Process")),
-		Transform:         newDataProcessorMockTransformMethod(_imptest.NewDependencyMethod(ctrl, "Transform")),
-		Validate:          newDataProcessorMockValidateMethod(_imptest.NewDependencyMethod(ctrl, "Validate")),
-		ProcessWithReturn: newDataProcessorMockProcessWithReturnMethod(_imptest.NewDependencyMethod(ctrl, "ProcessWithReturn")),
+		Process:           newDataProcessorMockProcessMethod(_imptest.NewDependencyMethod(ctrl, "Process").Results(_reflect.TypeFor[string]())),
+		Transform:         newDataProcessorMockTransformMethod(_imptest.NewDependencyMethod(ctrl, "Transform").Results(_reflect.TypeFor[int]())),
+		Validate:          newDataProcessorMockValidateMethod(_imptest.NewDependencyMethod(ctrl, "Validate").Results(_reflect.TypeFor[error]())),
+		ProcessWithReturn: newDataProcessorMockProcessWithReturnMethod(_imptest.NewDependencyMethod(ctrl, "ProcessWithReturn").Results(_reflect.TypeFor[interface{ Result() string }]())),
 	}
 	imp.Eventually = &DataProcessorImpEventually{
-		Process:           newDataProcessorMockProcessMethod(_imptest.NewDependencyMethod(ctrl, "Process").AsEventually()),
-		Transform:         newDataProcessorMockTransformMethod(_imptest.NewDependencyMethod(ctrl, "Transform").AsEventually()),
-		Validate:          newDataProcessorMockValidateMethod(_imptest.NewDependencyMethod(ctrl, "Validate").AsEventually()),
-		ProcessWithReturn: newDataProcessorMockProcessWithReturnMethod(_imptest.NewDependencyMethod(ctrl, "ProcessWithReturn").AsEventually()),
+		Process:           newDataProcessorMockProcessMethod(_imptest.NewDependencyMethod(ctrl, "Process").Results(_reflect.TypeFor[string]()).AsEventually()),
+		Transform:         newDataProcessorMockTransformMethod(_imptest.NewDependencyMethod(ctrl, "Transform").Results(_reflect.TypeFor[int]()).AsEventually()),
+		Validate:          newDataProcessorMockValidateMethod(_imptest.NewDependencyMethod(ctrl, "Validate").Results(_reflect.TypeFor[error]()).AsEventually()),
+		ProcessWithReturn: newDataProcessorMockProcessWithReturnMethod(_imptest.NewDependencyMethod(ctrl, "ProcessWithReturn").Results(_reflect.TypeFor[interface{ Result() string }]()).AsEventually()),
 	}
 	mock := &mockDataProcessorImpl{ctrl: ctrl}
 	return mock, imp
@@ -213,9 +286,7 @@ func (impl *mockDataProcessorImpl) Process(obj interface{ Get() string }) string
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 string
 	if len(resp.ReturnValues) > 0 {
@@ -236,9 +307,7 @@ func (impl *mockDataProcessorImpl) ProcessWithReturn(input string) interface{ Re
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 interface{ Result() string }
 	if len(resp.ReturnValues) > 0 {
@@ -262,9 +331,7 @@ func (impl *mockDataProcessorImpl) Transform(obj interface {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 int
 	if len(resp.ReturnValues) > 0 {
@@ -285,9 +352,7 @@ func (impl *mockDataProcessorImpl) Validate(validator interface{ Check(string) e
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 error
 	if len(resp.ReturnValues) > 0 {
@@ -1,5 +1,5 @@
 // Code generated by impgen. DO NOT EDIT.
-// impgen:hash:7a463d2cfe9dda96
+// impgen:hash:f49f20f03ec34ccc
 
 package named_test
 
@@ -7,6 +7,8 @@ import (
 	context "context"
 	_imptest "github.com/toejough/imptest"
 	named "github.com/toejough/imptest/UAT/variations/signature/named-params"
+	_reflect "reflect"
+	_time "time"
 )
 
 type UserRepositoryImp struct {
@@ -14,6 +16,7 @@ type UserRepositoryImp struct {
 	SaveUser   *UserRepositoryMockSaveUserMethod
 	DeleteUser *UserRepositoryMockDeleteUserMethod
 	CountUsers *UserRepositoryMockCountUsersMethod
+	FindUsers  *UserRepositoryMockFindUsersMethod
 	// Eventually provides async versions of all methods for concurrent code.
 	Eventually *UserRepositoryImpEventually
 }
@@ -23,6 +26,7 @@ type UserRepositoryImpEventually struct {
 	SaveUser   *UserRepositoryMockSaveUserMethod
 	DeleteUser *UserRepositoryMockDeleteUserMethod
 	CountUsers *UserRepositoryMockCountUsersMethod
+	FindUsers  *UserRepositoryMockFindUsersMethod
 }
 
 type UserRepositoryMockCountUsersArgs struct {
@@ -46,6 +50,11 @@ func (c *UserRepositoryMockCountUsersCall) Return(result0 int, result1 error) {
 	c.DependencyCall.Return(result0, result1)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *UserRepositoryMockCountUsersCall) ReturnAfter(d _time.Duration, result0 int, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
 type UserRepositoryMockCountUsersMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -56,9 +65,21 @@ func (m *UserRepositoryMockCountUsersMethod) ArgsEqual(ctx context.Context) *Use
 	return &UserRepositoryMockCountUsersCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *UserRepositoryMockCountUsersMethod) ArgsShould(matchers ...any) *UserRepositoryMockCountUsersCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *UserRepositoryMockCountUsersMethod) ArgsShould(ctx any) *UserRepositoryMockCountUsersCall {
+	_call := m.DependencyMethod.ArgsShould(ctx)
+	return &UserRepositoryMockCountUsersCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *UserRepositoryMockCountUsersMethod) ArgsWhere(predicate func(UserRepositoryMockCountUsersArgs) error) *UserRepositoryMockCountUsersCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args UserRepositoryMockCountUsersArgs
+		args.Ctx, _ = raw[0].(context.Context)
+		return predicate(args)
+	})
 	return &UserRepositoryMockCountUsersCall{DependencyCall: call}
 }
 
@@ -85,6 +106,11 @@ func (c *UserRepositoryMockDeleteUserCall) Return(result0 error) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *UserRepositoryMockDeleteUserCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type UserRepositoryMockDeleteUserMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -95,12 +121,91 @@ func (m *UserRepositoryMockDeleteUserMethod) ArgsEqual(ctx context.Context, user
 	return &UserRepositoryMockDeleteUserCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *UserRepositoryMockDeleteUserMethod) ArgsShould(matchers ...any) *UserRepositoryMockDeleteUserCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *UserRepositoryMockDeleteUserMethod) ArgsShould(ctx any, userID any) *UserRepositoryMockDeleteUserCall {
+	_call := m.DependencyMethod.ArgsShould(ctx, userID)
+	return &UserRepositoryMockDeleteUserCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *UserRepositoryMockDeleteUserMethod) ArgsWhere(predicate func(UserRepositoryMockDeleteUserArgs) error) *UserRepositoryMockDeleteUserCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args UserRepositoryMockDeleteUserArgs
+		args.Ctx, _ = raw[0].(context.Context)
+		args.UserID, _ = raw[1].(int)
+		return predicate(args)
+	})
 	return &UserRepositoryMockDeleteUserCall{DependencyCall: call}
 }
 
+type UserRepositoryMockFindUsersArgs struct {
+	Ctx      context.Context
+	Matchers []string
+}
+
+type UserRepositoryMockFindUsersCall struct {
+	*_imptest.DependencyCall
+}
+
+// GetArgs returns the typed arguments for this call.
+func (c *UserRepositoryMockFindUsersCall) GetArgs() UserRepositoryMockFindUsersArgs {
+	raw := c.RawArgs()
+	return UserRepositoryMockFindUsersArgs{
+		Ctx:      raw[0].(context.Context),
+		Matchers: raw[1].([]string),
+	}
+}
+
+// Return specifies the typed values the mock should return.
+func (c *UserRepositoryMockFindUsersCall) Return(result0 []named.User, result1 error) {
+	c.DependencyCall.Return(result0, result1)
+}
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *UserRepositoryMockFindUsersCall) ReturnAfter(d _time.Duration, result0 []named.User, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
+type UserRepositoryMockFindUsersMethod struct {
+	*_imptest.DependencyMethod
+}
+
+// ArgsEqual waits for a call with exactly the specified arguments.
+func (m *UserRepositoryMockFindUsersMethod) ArgsEqual(ctx context.Context, matchers ...string) *UserRepositoryMockFindUsersCall {
+	callArgs := []any{ctx}
+	for _, v := range matchers {
+		callArgs = append(callArgs, v)
+	}
+	call := m.DependencyMethod.ArgsEqual(callArgs...)
+	return &UserRepositoryMockFindUsersCall{DependencyCall: call}
+}
+
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *UserRepositoryMockFindUsersMethod) ArgsShould(ctx any, matchers ...any) *UserRepositoryMockFindUsersCall {
+	_matchers := []any{ctx}
+	_matchers = append(_matchers, matchers...)
+	_call := m.DependencyMethod.ArgsShould(_matchers...)
+	return &UserRepositoryMockFindUsersCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *UserRepositoryMockFindUsersMethod) ArgsWhere(predicate func(UserRepositoryMockFindUsersArgs) error) *UserRepositoryMockFindUsersCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args UserRepositoryMockFindUsersArgs
+		args.Ctx, _ = raw[0].(context.Context)
+		for _, value := range raw[1:] {
+			elem, _ := value.(string)
+			args.Matchers = append(args.Matchers, elem)
+		}
+		return predicate(args)
+	})
+	return &UserRepositoryMockFindUsersCall{DependencyCall: call}
+}
+
 type UserRepositoryMockGetUserArgs struct {
 	Ctx    context.Context
 	UserID int
@@ -124,6 +229,11 @@ func (c *UserRepositoryMockGetUserCall) Return(result0 named.User, result1 error
 	c.DependencyCall.Return(result0, result1)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *UserRepositoryMockGetUserCall) ReturnAfter(d _time.Duration, result0 named.User, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
 type UserRepositoryMockGetUserMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -134,9 +244,22 @@ func (m *UserRepositoryMockGetUserMethod) ArgsEqual(ctx context.Context, userID
 	return &UserRepositoryMockGetUserCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *UserRepositoryMockGetUserMethod) ArgsShould(matchers ...any) *UserRepositoryMockGetUserCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *UserRepositoryMockGetUserMethod) ArgsShould(ctx any, userID any) *UserRepositoryMockGetUserCall {
+	_call := m.DependencyMethod.ArgsShould(ctx, userID)
+	return &UserRepositoryMockGetUserCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *UserRepositoryMockGetUserMethod) ArgsWhere(predicate func(UserRepositoryMockGetUserArgs) error) *UserRepositoryMockGetUserCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args UserRepositoryMockGetUserArgs
+		args.Ctx, _ = raw[0].(context.Context)
+		args.UserID, _ = raw[1].(int)
+		return predicate(args)
+	})
 	return &UserRepositoryMockGetUserCall{DependencyCall: call}
 }
 
@@ -163,6 +286,11 @@ func (c *UserRepositoryMockSaveUserCall) Return(result0 named.User, result1 erro
 	c.DependencyCall.Return(result0, result1)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *UserRepositoryMockSaveUserCall) ReturnAfter(d _time.Duration, result0 named.User, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
 type UserRepositoryMockSaveUserMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -173,9 +301,22 @@ func (m *UserRepositoryMockSaveUserMethod) ArgsEqual(ctx context.Context, user n
 	return &UserRepositoryMockSaveUserCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *UserRepositoryMockSaveUserMethod) ArgsShould(matchers ...any) *UserRepositoryMockSaveUserCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *UserRepositoryMockSaveUserMethod) ArgsShould(ctx any, user any) *UserRepositoryMockSaveUserCall {
+	_call := m.DependencyMethod.ArgsShould(ctx, user)
+	return &UserRepositoryMockSaveUserCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *UserRepositoryMockSaveUserMethod) ArgsWhere(predicate func(UserRepositoryMockSaveUserArgs) error) *UserRepositoryMockSaveUserCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args UserRepositoryMockSaveUserArgs
+		args.Ctx, _ = raw[0].(context.Context)
+		args.User, _ = raw[1].(named.User)
+		return predicate(args)
+	})
 	return &UserRepositoryMockSaveUserCall{DependencyCall: call}
 }
 
@@ -183,16 +324,18 @@ func (m *UserRepositoryMockSaveUserMethod) ArgsShould(matchers ...any) *UserRepo
 func MockUserRepository(t _imptest.TestReporter) (named.UserRepository, *UserRepositoryImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &UserRepositoryImp{
-		GetUser:    newUserRepositoryMockGetUserMethod(_imptest.NewDependencyMethod(ctrl, "GetUser")),
-		SaveUser:   newUserRepositoryMockSaveUserMethod(_imptest.NewDependencyMethod(ctrl, "SaveUser")),
-		DeleteUser: newUserRepositoryMockDeleteUserMethod(_imptest.NewDependencyMethod(ctrl, "DeleteUser")),
-		CountUsers: newUserRepositoryMockCountUsersMethod(_imptest.NewDependencyMethod(ctrl, "CountUsers")),
+		GetUser:    newUserRepositoryMockGetUserMethod(_imptest.NewDependencyMethod(ctrl, "GetUser").Results(_reflect.TypeFor[named.User](), _reflect.TypeFor[error]())),
+		SaveUser:   newUserRepositoryMockSaveUserMethod(_imptest.NewDependencyMethod(ctrl, "SaveUser").Results(_reflect.TypeFor[named.User](), _reflect.TypeFor[error]())),
+		DeleteUser: newUserRepositoryMockDeleteUserMethod(_imptest.NewDependencyMethod(ctrl, "DeleteUser").Results(_reflect.TypeFor[error]())),
+		CountUsers: newUserRepositoryMockCountUsersMethod(_imptest.NewDependencyMethod(ctrl, "CountUsers").Results(_reflect.TypeFor[int](), _reflect.TypeFor[error]())),
+		FindUsers:  newUserRepositoryMockFindUsersMethod(_imptest.NewDependencyMethod(ctrl, "FindUsers").Results(_reflect.TypeFor[[]named.User](), _reflect.TypeFor[error]())),
 	}
 	imp.Eventually = &UserRepositoryImpEventually{
-		GetUser:    newUserRepositoryMockGetUserMethod(_imptest.NewDependencyMethod(ctrl, "GetUser").AsEventually()),
-		SaveUser:   newUserRepositoryMockSaveUserMethod(_imptest.NewDependencyMethod(ctrl, "SaveUser").AsEventually()),
-		DeleteUser: newUserRepositoryMockDeleteUserMethod(_imptest.NewDependencyMethod(ctrl, "DeleteUser").AsEventually()),
-		CountUsers: newUserRepositoryMockCountUsersMethod(_imptest.NewDependencyMethod(ctrl, "CountUsers").AsEventually()),
+		GetUser:    newUserRepositoryMockGetUserMethod(_imptest.NewDependencyMethod(ctrl, "GetUser").Results(_reflect.TypeFor[named.User](), _reflect.TypeFor[error]()).AsEventually()),
+		SaveUser:   newUserRepositoryMockSaveUserMethod(_imptest.NewDependencyMethod(ctrl, "SaveUser").Results(_reflect.TypeFor[named.User](), _reflect.TypeFor[error]()).AsEventually()),
+		DeleteUser: newUserRepositoryMockDeleteUserMethod(_imptest.NewDependencyMethod(ctrl, "DeleteUser").Results(_reflect.TypeFor[error]()).AsEventually()),
+		CountUsers: newUserRepositoryMockCountUsersMethod(_imptest.NewDependencyMethod(ctrl, "CountUsers").Results(_reflect.TypeFor[int](), _reflect.TypeFor[error]()).AsEventually()),
+		FindUsers:  newUserRepositoryMockFindUsersMethod(_imptest.NewDependencyMethod(ctrl, "FindUsers").Results(_reflect.TypeFor[[]named.User](), _reflect.TypeFor[error]()).AsEventually()),
 	}
 	mock := &mockUserRepositoryImpl{ctrl: ctrl}
 	return mock, imp
@@ -211,9 +354,7 @@ func (impl *mockUserRepositoryImpl) CountUsers(ctx context.Context) (int, error)
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 int
 	if len(resp.ReturnValues) > 0 {
@@ -241,9 +382,7 @@ func (impl *mockUserRepositoryImpl) DeleteUser(ctx context.Context, userID int)
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 error
 	if len(resp.ReturnValues) > 0 {
@@ -255,6 +394,38 @@ func (impl *mockUserRepositoryImpl) DeleteUser(ctx context.Context, userID int)
 	return result1
 }
 
+// FindUsers implements named.UserRepository.FindUsers.
+func (impl *mockUserRepositoryImpl) FindUsers(ctx context.Context, matchers ...string) ([]named.User, error) {
+	callArgs := []any{ctx}
+	for _, v := range matchers {
+		callArgs = append(callArgs, v)
+	}
+	call := &_imptest.GenericCall{
+		MethodName:   "FindUsers",
+		Args:         callArgs,
+		ResponseChan: make(chan _imptest.GenericResponse, 1),
+	}
+	impl.ctrl.CallChan <- call
+	resp := <-call.ResponseChan
+	resp.Resolve()
+
+	var result1 []named.User
+	if len(resp.ReturnValues) > 0 {
+		if value, ok := resp.ReturnValues[0].([]named.User); ok {
+			result1 = value
+		}
+	}
+
+	var result2 error
+	if len(resp.ReturnValues) > 1 {
+		if value, ok := resp.ReturnValues[1].(error); ok {
+			result2 = value
+		}
+	}
+
+	return result1, result2
+}
+
 // GetUser implements named.UserRepository.GetUser.
 func (impl *mockUserRepositoryImpl) GetUser(ctx context.Context, userID int) (named.User, error) {
 	call := &_imptest.GenericCall{
@@ -264,9 +435,7 @@ func (impl *mockUserRepositoryImpl) GetUser(ctx context.Context, userID int) (na
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 named.User
 	if len(resp.ReturnValues) > 0 {
@@ -294,9 +463,7 @@ func (impl *mockUserRepositoryImpl) SaveUser(ctx context.Context, user named.Use
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 named.User
 	if len(resp.ReturnValues) > 0 {
@@ -325,6 +492,11 @@ func newUserRepositoryMockDeleteUserMethod(dm *_imptest.DependencyMethod) *UserR
 	return &UserRepositoryMockDeleteUserMethod{DependencyMethod: dm}
 }
 
+// newUserRepositoryMockFindUsersMethod creates a typed method wrapper.
+func newUserRepositoryMockFindUsersMethod(dm *_imptest.DependencyMethod) *UserRepositoryMockFindUsersMethod {
+	return &UserRepositoryMockFindUsersMethod{DependencyMethod: dm}
+}
+
 // newUserRepositoryMockGetUserMethod creates a typed method wrapper.
 func newUserRepositoryMockGetUserMethod(dm *_imptest.DependencyMethod) *UserRepositoryMockGetUserMethod {
 	return &UserRepositoryMockGetUserMethod{DependencyMethod: dm}
@@ -44,6 +44,10 @@ type UserRepository interface {
 
 	// CountUsers demonstrates named parameter with named return value.
 	CountUsers(ctx context.Context) (count int, err error)
+
+	// FindUsers demonstrates a variadic parameter whose name matches a local of the
+	// generated ArgsShould.
+	FindUsers(ctx context.Context, matchers ...string) (users []User, err error)
 }
 
 // ProcessUser demonstrates a standalone function with named parameters and returns.
@@ -38,6 +38,24 @@ func TestDependencyWithNamedParams(t *testing.T) {
 		Return(named.User{ID: 123, Name: "Alice"}, nil)
 }
 
+// TestDependencyWithVariadicMatchersParam demonstrates that a variadic parameter may
+// share its name with the locals of the generated ArgsShould.
+func TestDependencyWithVariadicMatchersParam(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mock, imp := MockUserRepository(t)
+
+	go func() {
+		users, err := mock.FindUsers(ctx, "ali*", "bo*")
+		_ = users
+		_ = err
+	}()
+
+	imp.FindUsers.ArgsShould(ctx, "ali*", "bo*").
+		Return([]named.User{{ID: 1, Name: "Alice"}}, nil)
+}
+
 // TestFunctionWithNamedParams demonstrates that function wrappers handle
 // named parameters and returns correctly.
 func TestFunctionWithNamedParams(t *testing.T) {
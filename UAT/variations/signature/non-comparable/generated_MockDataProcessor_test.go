@@ -6,6 +6,8 @@ package noncomparable_test
 import (
 	_imptest "github.com/toejough/imptest"
 	noncomparable "github.com/toejough/imptest/UAT/variations/signature/non-comparable"
+	_reflect "reflect"
+	_time "time"
 )
 
 type DataProcessorImp struct {
@@ -41,6 +43,11 @@ func (c *DataProcessorMockProcessMapCall) Return(result0 bool) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *DataProcessorMockProcessMapCall) ReturnAfter(d _time.Duration, result0 bool) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type DataProcessorMockProcessMapMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -51,9 +58,21 @@ func (m *DataProcessorMockProcessMapMethod) ArgsEqual(config map[string]int) *Da
 	return &DataProcessorMockProcessMapCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *DataProcessorMockProcessMapMethod) ArgsShould(matchers ...any) *DataProcessorMockProcessMapCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *DataProcessorMockProcessMapMethod) ArgsShould(config any) *DataProcessorMockProcessMapCall {
+	_call := m.DependencyMethod.ArgsShould(config)
+	return &DataProcessorMockProcessMapCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *DataProcessorMockProcessMapMethod) ArgsWhere(predicate func(DataProcessorMockProcessMapArgs) error) *DataProcessorMockProcessMapCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args DataProcessorMockProcessMapArgs
+		args.Config, _ = raw[0].(map[string]int)
+		return predicate(args)
+	})
 	return &DataProcessorMockProcessMapCall{DependencyCall: call}
 }
 
@@ -65,6 +84,12 @@ type DataProcessorMockProcessSliceCall struct {
 	*_imptest.DependencyCall
 }
 
+// FillData copies values into the caller's Data slice before the mock returns.
+func (c *DataProcessorMockProcessSliceCall) FillData(values []string) *DataProcessorMockProcessSliceCall {
+	c.FillArg(0, values)
+	return c
+}
+
 // GetArgs returns the typed arguments for this call.
 func (c *DataProcessorMockProcessSliceCall) GetArgs() DataProcessorMockProcessSliceArgs {
 	raw := c.RawArgs()
@@ -78,6 +103,11 @@ func (c *DataProcessorMockProcessSliceCall) Return(result0 int) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *DataProcessorMockProcessSliceCall) ReturnAfter(d _time.Duration, result0 int) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type DataProcessorMockProcessSliceMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -88,9 +118,21 @@ func (m *DataProcessorMockProcessSliceMethod) ArgsEqual(data []string) *DataProc
 	return &DataProcessorMockProcessSliceCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *DataProcessorMockProcessSliceMethod) ArgsShould(matchers ...any) *DataProcessorMockProcessSliceCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *DataProcessorMockProcessSliceMethod) ArgsShould(data any) *DataProcessorMockProcessSliceCall {
+	_call := m.DependencyMethod.ArgsShould(data)
+	return &DataProcessorMockProcessSliceCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *DataProcessorMockProcessSliceMethod) ArgsWhere(predicate func(DataProcessorMockProcessSliceArgs) error) *DataProcessorMockProcessSliceCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args DataProcessorMockProcessSliceArgs
+		args.Data, _ = raw[0].([]string)
+		return predicate(args)
+	})
 	return &DataProcessorMockProcessSliceCall{DependencyCall: call}
 }
 
@@ -98,12 +140,12 @@ func (m *DataProcessorMockProcessSliceMethod) ArgsShould(matchers ...any) *DataP
 func MockDataProcessor(t _imptest.TestReporter) (noncomparable.DataProcessor, *DataProcessorImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &DataProcessorImp{
-		ProcessSlice: newDataProcessorMockProcessSliceMethod(_imptest.NewDependencyMethod(ctrl, "ProcessSlice")),
-		ProcessMap:   newDataProcessorMockProcessMapMethod(_imptest.NewDependencyMethod(ctrl, "ProcessMap")),
+		ProcessSlice: newDataProcessorMockProcessSliceMethod(_imptest.NewDependencyMethod(ctrl, "ProcessSlice").Results(_reflect.TypeFor[int]())),
+		ProcessMap:   newDataProcessorMockProcessMapMethod(_imptest.NewDependencyMethod(ctrl, "ProcessMap").Results(_reflect.TypeFor[bool]())),
 	}
 	imp.Eventually = &DataProcessorImpEventually{
-		ProcessSlice: newDataProcessorMockProcessSliceMethod(_imptest.NewDependencyMethod(ctrl, "ProcessSlice").AsEventually()),
-		ProcessMap:   newDataProcessorMockProcessMapMethod(_imptest.NewDependencyMethod(ctrl, "ProcessMap").AsEventually()),
+		ProcessSlice: newDataProcessorMockProcessSliceMethod(_imptest.NewDependencyMethod(ctrl, "ProcessSlice").Results(_reflect.TypeFor[int]()).AsEventually()),
+		ProcessMap:   newDataProcessorMockProcessMapMethod(_imptest.NewDependencyMethod(ctrl, "ProcessMap").Results(_reflect.TypeFor[bool]()).AsEventually()),
 	}
 	mock := &mockDataProcessorImpl{ctrl: ctrl}
 	return mock, imp
@@ -122,9 +164,7 @@ func (impl *mockDataProcessorImpl) ProcessMap(config map[string]int) bool {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 bool
 	if len(resp.ReturnValues) > 0 {
@@ -145,9 +185,7 @@ func (impl *mockDataProcessorImpl) ProcessSlice(data []string) int {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 int
 	if len(resp.ReturnValues) > 0 {
@@ -6,6 +6,8 @@ package parameterized_test
 import (
 	_imptest "github.com/toejough/imptest"
 	parameterized "github.com/toejough/imptest/UAT/variations/signature/parameterized"
+	_reflect "reflect"
+	_time "time"
 )
 
 type DataProcessorImp struct {
@@ -43,6 +45,11 @@ func (c *DataProcessorMockProcessContainerCall) Return(result0 error) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *DataProcessorMockProcessContainerCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type DataProcessorMockProcessContainerMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -53,9 +60,21 @@ func (m *DataProcessorMockProcessContainerMethod) ArgsEqual(data parameterized.C
 	return &DataProcessorMockProcessContainerCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *DataProcessorMockProcessContainerMethod) ArgsShould(matchers ...any) *DataProcessorMockProcessContainerCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *DataProcessorMockProcessContainerMethod) ArgsShould(data any) *DataProcessorMockProcessContainerCall {
+	_call := m.DependencyMethod.ArgsShould(data)
+	return &DataProcessorMockProcessContainerCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *DataProcessorMockProcessContainerMethod) ArgsWhere(predicate func(DataProcessorMockProcessContainerArgs) error) *DataProcessorMockProcessContainerCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args DataProcessorMockProcessContainerArgs
+		args.Data, _ = raw[0].(parameterized.Container[string])
+		return predicate(args)
+	})
 	return &DataProcessorMockProcessContainerCall{DependencyCall: call}
 }
 
@@ -80,6 +99,11 @@ func (c *DataProcessorMockProcessPairCall) Return(result0 string) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *DataProcessorMockProcessPairCall) ReturnAfter(d _time.Duration, result0 string) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type DataProcessorMockProcessPairMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -90,9 +114,21 @@ func (m *DataProcessorMockProcessPairMethod) ArgsEqual(pair parameterized.Pair[i
 	return &DataProcessorMockProcessPairCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *DataProcessorMockProcessPairMethod) ArgsShould(matchers ...any) *DataProcessorMockProcessPairCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *DataProcessorMockProcessPairMethod) ArgsShould(pair any) *DataProcessorMockProcessPairCall {
+	_call := m.DependencyMethod.ArgsShould(pair)
+	return &DataProcessorMockProcessPairCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *DataProcessorMockProcessPairMethod) ArgsWhere(predicate func(DataProcessorMockProcessPairArgs) error) *DataProcessorMockProcessPairCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args DataProcessorMockProcessPairArgs
+		args.Pair, _ = raw[0].(parameterized.Pair[int, bool])
+		return predicate(args)
+	})
 	return &DataProcessorMockProcessPairCall{DependencyCall: call}
 }
 
@@ -105,18 +141,23 @@ func (c *DataProcessorMockReturnContainerCall) Return(result0 parameterized.Cont
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *DataProcessorMockReturnContainerCall) ReturnAfter(d _time.Duration, result0 parameterized.Container[int]) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 // MockDataProcessor creates a mock DataProcessor and returns (mock, expectation handle).
 func MockDataProcessor(t _imptest.TestReporter) (parameterized.DataProcessor, *DataProcessorImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &DataProcessorImp{
-		ProcessContainer: newDataProcessorMockProcessContainerMethod(_imptest.NewDependencyMethod(ctrl, "ProcessContainer")),
-		ProcessPair:      newDataProcessorMockProcessPairMethod(_imptest.NewDependencyMethod(ctrl, "ProcessPair")),
-		ReturnContainer:  _imptest.NewDependencyMethod(ctrl, "ReturnContainer"),
+		ProcessContainer: newDataProcessorMockProcessContainerMethod(_imptest.NewDependencyMethod(ctrl, "ProcessContainer").Results(_reflect.TypeFor[error]())),
+		ProcessPair:      newDataProcessorMockProcessPairMethod(_imptest.NewDependencyMethod(ctrl, "ProcessPair").Results(_reflect.TypeFor[string]())),
+		ReturnContainer:  _imptest.NewDependencyMethod(ctrl, "ReturnContainer").Results(_reflect.TypeFor[parameterized.Container[int]]()),
 	}
 	imp.Eventually = &DataProcessorImpEventually{
-		ProcessContainer: newDataProcessorMockProcessContainerMethod(_imptest.NewDependencyMethod(ctrl, "ProcessContainer").AsEventually()),
-		ProcessPair:      newDataProcessorMockProcessPairMethod(_imptest.NewDependencyMethod(ctrl, "ProcessPair").AsEventually()),
-		ReturnContainer:  _imptest.NewDependencyMethod(ctrl, "ReturnContainer").AsEventually(),
+		ProcessContainer: newDataProcessorMockProcessContainerMethod(_imptest.NewDependencyMethod(ctrl, "ProcessContainer").Results(_reflect.TypeFor[error]()).AsEventually()),
+		ProcessPair:      newDataProcessorMockProcessPairMethod(_imptest.NewDependencyMethod(ctrl, "ProcessPair").Results(_reflect.TypeFor[string]()).AsEventually()),
+		ReturnContainer:  _imptest.NewDependencyMethod(ctrl, "ReturnContainer").Results(_reflect.TypeFor[parameterized.Container[int]]()).AsEventually(),
 	}
 	mock := &mockDataProcessorImpl{ctrl: ctrl}
 	return mock, imp
@@ -135,9 +176,7 @@ func (impl *mockDataProcessorImpl) ProcessContainer(data parameterized.Container
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 error
 	if len(resp.ReturnValues) > 0 {
@@ -158,9 +197,7 @@ func (impl *mockDataProcessorImpl) ProcessPair(pair parameterized.Pair[int, bool
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 string
 	if len(resp.ReturnValues) > 0 {
@@ -181,9 +218,7 @@ func (impl *mockDataProcessorImpl) ReturnContainer() parameterized.Container[int
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 parameterized.Container[int]
 	if len(resp.ReturnValues) > 0 {
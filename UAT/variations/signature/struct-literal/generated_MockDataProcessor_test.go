@@ -6,6 +6,8 @@ package structlit_test
 import (
 	_imptest "github.com/toejough/imptest"
 	structlit "github.com/toejough/imptest/UAT/variations/signature/struct-literal"
+	_reflect "reflect"
+	_time "time"
 )
 
 type DataProcessorImp struct {
@@ -45,6 +47,11 @@ func (c *DataProcessorMockApplyCall) Return(result0 struct{ Status int }) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *DataProcessorMockApplyCall) ReturnAfter(d _time.Duration, result0 struct{ Status int }) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type DataProcessorMockApplyMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -55,9 +62,21 @@ func (m *DataProcessorMockApplyMethod) ArgsEqual(req struct{ Method string }) *D
 	return &DataProcessorMockApplyCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *DataProcessorMockApplyMethod) ArgsShould(matchers ...any) *DataProcessorMockApplyCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *DataProcessorMockApplyMethod) ArgsShould(req any) *DataProcessorMockApplyCall {
+	_call := m.DependencyMethod.ArgsShould(req)
+	return &DataProcessorMockApplyCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *DataProcessorMockApplyMethod) ArgsWhere(predicate func(DataProcessorMockApplyArgs) error) *DataProcessorMockApplyCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args DataProcessorMockApplyArgs
+		args.Req, _ = raw[0].(struct{ Method string })
+		return predicate(args)
+	})
 	return &DataProcessorMockApplyCall{DependencyCall: call}
 }
 
@@ -73,6 +92,14 @@ func (c *DataProcessorMockGetConfigCall) Return(result0 struct {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *DataProcessorMockGetConfigCall) ReturnAfter(d _time.Duration, result0 struct {
+	Host string
+	Port int
+}) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type DataProcessorMockProcessArgs struct {
 	Cfg struct{ Timeout int }
 }
@@ -94,6 +121,11 @@ func (c *DataProcessorMockProcessCall) Return(result0 error) {
 	c.DependencyCall.Return(result0)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *DataProcessorMockProcessCall) ReturnAfter(d _time.Duration, result0 error) {
+	c.DependencyCall.ReturnAfter(d, result0)
+}
+
 type DataProcessorMockProcessMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -104,9 +136,21 @@ func (m *DataProcessorMockProcessMethod) ArgsEqual(cfg struct{ Timeout int }) *D
 	return &DataProcessorMockProcessCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *DataProcessorMockProcessMethod) ArgsShould(matchers ...any) *DataProcessorMockProcessCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *DataProcessorMockProcessMethod) ArgsShould(cfg any) *DataProcessorMockProcessCall {
+	_call := m.DependencyMethod.ArgsShould(cfg)
+	return &DataProcessorMockProcessCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *DataProcessorMockProcessMethod) ArgsWhere(predicate func(DataProcessorMockProcessArgs) error) *DataProcessorMockProcessCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args DataProcessorMockProcessArgs
+		args.Cfg, _ = raw[0].(struct{ Timeout int })
+		return predicate(args)
+	})
 	return &DataProcessorMockProcessCall{DependencyCall: call}
 }
 
@@ -137,6 +181,11 @@ func (c *DataProcessorMockTransformCall) Return(result0 string, result1 error) {
 	c.DependencyCall.Return(result0, result1)
 }
 
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *DataProcessorMockTransformCall) ReturnAfter(d _time.Duration, result0 string, result1 error) {
+	c.DependencyCall.ReturnAfter(d, result0, result1)
+}
+
 type DataProcessorMockTransformMethod struct {
 	*_imptest.DependencyMethod
 }
@@ -150,9 +199,24 @@ func (m *DataProcessorMockTransformMethod) ArgsEqual(opts struct {
 	return &DataProcessorMockTransformCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *DataProcessorMockTransformMethod) ArgsShould(matchers ...any) *DataProcessorMockTransformCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *DataProcessorMockTransformMethod) ArgsShould(opts any) *DataProcessorMockTransformCall {
+	_call := m.DependencyMethod.ArgsShould(opts)
+	return &DataProcessorMockTransformCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *DataProcessorMockTransformMethod) ArgsWhere(predicate func(DataProcessorMockTransformArgs) error) *DataProcessorMockTransformCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args DataProcessorMockTransformArgs
+		args.Opts, _ = raw[0].(struct {
+			Debug bool
+			Level int
+		})
+		return predicate(args)
+	})
 	return &DataProcessorMockTransformCall{DependencyCall: call}
 }
 
@@ -160,16 +224,22 @@ func (m *DataProcessorMockTransformMethod) ArgsShould(matchers ...any) *DataProc
 func MockDataProcessor(t _imptest.TestReporter) (structlit.DataProcessor, *DataProcessorImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &DataProcessorImp{
-		Process:   newDataProcessorMockProcessMethod(_imptest.NewDependencyMethod(ctrl, "Process")),
-		Transform: newDataProcessorMockTransformMethod(_imptest.NewDependencyMethod(ctrl, "Transform")),
-		GetConfig: _imptest.NewDependencyMethod(ctrl, "GetConfig"),
-		Apply:     newDataProcessorMockApplyMethod(_imptest.NewDependencyMethod(ctrl, "Apply")),
+		Process:   newDataProcessorMockProcessMethod(_imptest.NewDependencyMethod(ctrl, "Process").Results(_reflect.TypeFor[error]())),
+		Transform: newDataProcessorMockTransformMethod(_imptest.NewDependencyMethod(ctrl, "Transform").Results(_reflect.TypeFor[string](), _reflect.TypeFor[error]())),
+		GetConfig: _imptest.NewDependencyMethod(ctrl, "GetConfig").Results(_reflect.TypeFor[struct {
+			Host string
+			Port int
+		}]()),
+		Apply: newDataProcessorMockApplyMethod(_imptest.NewDependencyMethod(ctrl, "Apply").Results(_reflect.TypeFor[struct{ Status int }]())),
 	}
 	imp.Eventually = &DataProcessorImpEventually{
-		Process:   newDataProcessorMockProcessMethod(_imptest.NewDependencyMethod(ctrl, "Process").AsEventually()),
-		Transform: newDataProcessorMockTransformMethod(_imptest.NewDependencyMethod(ctrl, "Transform").AsEventually()),
-		GetConfig: _imptest.NewDependencyMethod(ctrl, "GetConfig").AsEventually(),
-		Apply:     newDataProcessorMockApplyMethod(_imptest.NewDependencyMethod(ctrl, "Apply").AsEventually()),
+		Process:   newDataProcessorMockProcessMethod(_imptest.NewDependencyMethod(ctrl, "Process").Results(_reflect.TypeFor[error]()).AsEventually()),
+		Transform: newDataProcessorMockTransformMethod(_imptest.NewDependencyMethod(ctrl, "Transform").Results(_reflect.TypeFor[string](), _reflect.TypeFor[error]()).AsEventually()),
+		GetConfig: _imptest.NewDependencyMethod(ctrl, "GetConfig").Results(_reflect.TypeFor[struct {
+			Host string
+			Port int
+		}]()).AsEventually(),
+		Apply: newDataProcessorMockApplyMethod(_imptest.NewDependencyMethod(ctrl, "Apply").Results(_reflect.TypeFor[struct{ Status int }]()).AsEventually()),
 	}
 	mock := &mockDataProcessorImpl{ctrl: ctrl}
 	return mock, imp
@@ -188,9 +258,7 @@ func (impl *mockDataProcessorImpl) Apply(req struct{ Method string }) struct{ St
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 struct{ Status int }
 	if len(resp.ReturnValues) > 0 {
@@ -214,9 +282,7 @@ func (impl *mockDataProcessorImpl) GetConfig() struct {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 struct {
 		Host string
@@ -243,9 +309,7 @@ func (impl *mockDataProcessorImpl) Process(cfg struct{ Timeout int }) error {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 error
 	if len(resp.ReturnValues) > 0 {
@@ -269,9 +333,7 @@ func (impl *mockDataProcessorImpl) Transform(opts struct {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 	var result1 string
 	if len(resp.ReturnValues) > 0 {
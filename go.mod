@@ -3,29 +3,29 @@ module github.com/toejough/imptest
 go 1.25.5
 
 require (
+	github.com/BurntSushi/toml v1.6.0
 	github.com/akedrou/textdiff v0.1.0
 	github.com/dave/dst v0.27.3
+	github.com/google/go-cmp v0.7.0
 	github.com/gtramontina/ooze v0.2.0
 	github.com/onsi/gomega v1.39.0
 	github.com/toejough/go-reorder v0.0.0-20260117211236-3c8f179f2882
 	github.com/toejough/targ v0.0.0-20260117204654-042d187c17d0
 	github.com/toejough/testredundancy v0.0.0-20260114211127-a9e3ddec91a4
+	go.yaml.in/yaml/v3 v3.0.4
+	golang.org/x/tools v0.40.0
 	pgregory.net/rapid v1.2.0
 )
 
 require (
-	github.com/BurntSushi/toml v1.6.0 // indirect
 	github.com/bmatcuk/doublestar/v4 v4.9.2 // indirect
 	github.com/fatih/color v1.14.1 // indirect
-	github.com/google/go-cmp v0.7.0 // indirect
 	github.com/hexops/gotextdiff v1.0.3 // indirect
 	github.com/mattn/go-colorable v0.1.13 // indirect
 	github.com/mattn/go-isatty v0.0.17 // indirect
-	go.yaml.in/yaml/v3 v3.0.4 // indirect
 	golang.org/x/mod v0.32.0 // indirect
 	golang.org/x/net v0.48.0 // indirect
 	golang.org/x/sync v0.19.0 // indirect
 	golang.org/x/sys v0.39.0 // indirect
 	golang.org/x/text v0.32.0 // indirect
-	golang.org/x/tools v0.40.0 // indirect
 )
@@ -3,7 +3,8 @@
 // and in your test files, add a `//go:generate impgen <interface>` comment to generate a mock for the specified
 // interface. By default, the mocked struct will be named <interface>Imp. Add a `--name <mockname>` flag to specify a
 // custom name for the generated mock struct. The generated mock will be placed in a file named <mockname>_test.go,
-// in the same package as the test file containing the `//go:generate` comment.
+// in the same package as the test file containing the `//go:generate` comment. Run `impgen` with no arguments to
+// generate everything listed in the impgen.toml file at the module root.
 package main
 
 import (
@@ -56,7 +57,7 @@ func (fs *realFileSystem) ReadFile(name string) ([]byte, error) {
 	return data, nil
 }
 
-// WriteFile writes data to the file named by name.
+// WriteFile writes data to the file named by name, creating its directory if needed.
 // Skips writing if the file already exists with identical content.
 func (fs *realFileSystem) WriteFile(name string, data []byte, perm os.FileMode) error {
 	existing, err := os.ReadFile(name) //nolint:gosec // G304: path from trusted caller
@@ -64,6 +65,14 @@ func (fs *realFileSystem) WriteFile(name string, data []byte, perm os.FileMode)
 		return nil // Content unchanged, skip write
 	}
 
+	// --output-dir may name a directory that does not exist yet
+	const dirPermissions = 0o750
+
+	err = os.MkdirAll(filepath.Dir(name), dirPermissions)
+	if err != nil {
+		return fmt.Errorf("failed to create directory for %s: %w", name, err)
+	}
+
 	err = os.WriteFile(name, data, perm)
 	if err != nil {
 		return fmt.Errorf("failed to write file %s: %w", name, err)
@@ -87,3 +96,14 @@ func (pl *realPackageLoader) Load(
 	// Return nil for typesInfo - we use syntax-based type detection instead
 	return files, fset, nil, nil
 }
+
+// LoadTypes type-checks a package by import path. It is only used for symbols that syntax alone cannot resolve
+// precisely, or when --types is given.
+func (pl *realPackageLoader) LoadTypes(importPath string) (*types.Package, error) {
+	pkg, err := load.PackageTypes("", importPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to type-check package %q: %w", importPath, err)
+	}
+
+	return pkg, nil
+}
@@ -8,9 +8,13 @@
 //   - [TestReporter] - interface for test frameworks (usually *testing.T)
 //   - [GetOrCreateImp] - get/create shared coordinator for a test (used by generated code)
 //   - [Wait] - block until all async expectations for a test are satisfied
-//   - [SetTimeout] - configure timeout for blocking operations
+//   - [ExpectReceive], [ExpectNoReceive] - assert on values sent over channels
+//   - [SetTimeout] - configure the timeout for expectations, Wait, and channel receives
+//   - [SetWatchdogThreshold] - configure the deadlock watchdog that dumps state when a test blocks too long
+//   - [SetArgSnapshots] - deep-copy mock call arguments at call time
+//   - [SetEquality] - replace reflect.DeepEqual behind ArgsEqual and ReturnsEqual (e.g. with cmpmatch.Cmp)
 //
-// For matchers (BeAny, Satisfy), import the match package:
+// For matchers (BeAny, Satisfy, Equal, HaveField, ...), import the match package:
 //
 //	import . "github.com/toejough/imptest/match"
 //
@@ -22,10 +26,11 @@
 //   - [Imp] - coordinator for dependency mocks
 //   - [Controller] - manages call queue and synchronization
 //   - [DependencyMethod], [DependencyCall], [DependencyArgs] - mock internals
-//   - [CallableController], [TargetController] - wrapper internals
-//   - [GenericCall], [GenericResponse] - low-level call/response types
+//   - [CallableController], [TargetController], [Completion] - wrapper internals
+//   - [GenericCall], [GenericResponse], [ResponseKind] - low-level call/response types
 //   - [PendingExpectation], [PendingCompletion] - async expectation internals
-//   - [Matcher], [Timer], [Call] - supporting interfaces and types
+//   - [PanicInfo], [FormatPanic], [MatchPanic] - panic values with captured stack traces
+//   - [Matcher], [TypedMatcher], [Timer], [Call] - supporting interfaces and types
 package imptest
 
 import (
@@ -34,10 +39,31 @@ import (
 	"github.com/toejough/imptest/internal/core"
 )
 
+// Exported constants.
+const (
+	// DefaultWatchdogThreshold is how long a blocking operation may wait before
+	// the watchdog dumps state and fails the test. See [SetWatchdogThreshold].
+	DefaultWatchdogThreshold = core.DefaultWatchdogThreshold
+	// ResponseCloseChannels closes channels returned by earlier mock calls, then returns.
+	ResponseCloseChannels = core.ResponseCloseChannels
+	// ResponseGoexit makes the mock call runtime.Goexit.
+	ResponseGoexit = core.ResponseGoexit
+	// ResponseHang makes the mock block until the test ends.
+	ResponseHang = core.ResponseHang
+	// ResponsePanic makes the mock panic.
+	ResponsePanic = core.ResponsePanic
+	// ResponseReturn makes the mock return.
+	ResponseReturn = core.ResponseReturn
+	// ResponseReturnAfter makes the mock return after a delay.
+	ResponseReturnAfter = core.ResponseReturnAfter
+)
+
 type Call = core.Call
 
 type CallableController[T any] = core.CallableController[T]
 
+type Completion = core.Completion
+
 type Controller[T Call] = core.Controller[T]
 
 type DependencyArgs = core.DependencyArgs
@@ -59,10 +85,14 @@ type Imp = core.Imp
 
 type Matcher = core.Matcher
 
+type PanicInfo = core.PanicInfo
+
 type PendingCompletion = core.PendingCompletion
 
 type PendingExpectation = core.PendingExpectation
 
+type ResponseKind = core.ResponseKind
+
 type TargetController = core.TargetController
 
 // NewTargetController creates a new target controller.
@@ -74,6 +104,36 @@ type TestReporter = core.TestReporter
 
 type Timer = core.Timer
 
+type TypedMatcher = core.TypedMatcher
+
+// EqualValue checks if actual equals expected using the test's equality (see [SetEquality]).
+func EqualValue(t TestReporter, actual, expected any) (bool, string) {
+	return core.EqualValue(t, actual, expected)
+}
+
+// ExpectNoReceive fails the test if a value arrives on ch, or ch is closed, within d.
+// The wait is measured with the test's [Timer].
+func ExpectNoReceive[V any](t TestReporter, ch <-chan V, d time.Duration) {
+	core.ExpectNoReceive(t, ch, d)
+}
+
+// ExpectReceive blocks until a value arrives on ch, checks it against expected (a
+// matcher or a plain value), and returns it:
+//
+//	event := imptest.ExpectReceive(t, events, match.HaveField("Type", "start"))
+//
+// The test fails if ch is closed or the timeout set with [SetTimeout] elapses. A receive
+// that blocks past the watchdog threshold fails with the deadlock dump, like any other
+// blocking imptest operation.
+func ExpectReceive[V any](t TestReporter, ch <-chan V, expected any) V {
+	return core.ExpectReceive(t, ch, expected)
+}
+
+// FormatPanic formats a recovered panic value and its stack trace for failure messages.
+func FormatPanic(value any, stack []byte) string {
+	return core.FormatPanic(value, stack)
+}
+
 // GetOrCreateImp returns the Imp for the given test, creating one if needed.
 // Multiple calls with the same TestReporter return the same Imp instance.
 // This enables coordination between mocks and wrappers in the same test.
@@ -84,6 +144,13 @@ func GetOrCreateImp(t TestReporter) *Imp {
 	return core.GetOrCreateImp(t)
 }
 
+// MatchPanic checks a recovered panic against expected. A [TypedMatcher] for [PanicInfo],
+// such as Satisfy(func(PanicInfo) error), receives the value and stack; other matchers
+// receive the value. Failure messages end with the stack trace.
+func MatchPanic(value any, stack []byte, expected any) (bool, string) {
+	return core.MatchPanic(value, stack, expected)
+}
+
 // MatchValue checks if actual matches expected.
 func MatchValue(actual, expected any) (bool, string) {
 	return core.MatchValue(actual, expected)
@@ -94,14 +161,54 @@ func NewCallableController[T any](t TestReporter) *CallableController[T] {
 	return core.NewCallableController[T](t)
 }
 
-// SetTimeout configures the timeout for all blocking operations in the test.
-// A duration of 0 means no timeout (block forever).
+// SetArgSnapshots configures whether mock call arguments are deep-copied when a
+// call is made, so that expectations and GetArgs see the arguments as they were at
+// call time even if the code under test later reuses a buffer or mutates a slice,
+// map, or pointed-to struct. Snapshots are off by default.
+//
+// Slices, maps, arrays, pointers, interfaces, and exported struct fields are copied.
+// Unexported struct fields, funcs, and channels are shared with the caller.
+//
+// If no Imp has been created for t yet, one is created.
+func SetArgSnapshots(t TestReporter, enabled bool) {
+	core.SetArgSnapshots(t, enabled)
+}
+
+// SetEquality configures the comparison behind ArgsEqual and ReturnsEqual for the test,
+// which is reflect.DeepEqual by default. newMatcher builds a matcher for each expected
+// value, and its FailureMessage reports mismatches. To compare with go-cmp:
+//
+//	imptest.SetEquality(t, cmpmatch.CmpEquality(cmpopts.EquateEmpty()))
+//
+// Pass nil to restore reflect.DeepEqual.
+//
+// If no Imp has been created for t yet, one is created.
+func SetEquality(t TestReporter, newMatcher func(expected any) Matcher) {
+	core.SetEquality(t, newMatcher)
+}
+
+// SetTimeout configures how long blocking operations may wait before failing the test:
+// ordered expectations (ArgsEqual, ArgsShould, ArgsWhere, Called), Wait, and
+// ExpectReceive. A duration of 0 means no timeout (block forever).
 //
 // If no Imp has been created for t yet, one is created.
 func SetTimeout(t TestReporter, d time.Duration) {
 	core.SetTimeout(t, d)
 }
 
+// SetWatchdogThreshold configures how long blocking operations (ArgsEqual, Wait,
+// ReturnsEqual, ...) may wait before the watchdog fails the test with a concise
+// dump of pending expectations, queued and blocked mock calls, and target wrappers
+// that have not returned. The watchdog also fires shortly before t.Deadline() so
+// the dump is printed before `go test -timeout` panics.
+//
+// The default is [DefaultWatchdogThreshold]. A duration of 0 disables the watchdog.
+//
+// If no Imp has been created for t yet, one is created.
+func SetWatchdogThreshold(t TestReporter, d time.Duration) {
+	core.SetWatchdogThreshold(t, d)
+}
+
 // Wait blocks until all async expectations registered under t are satisfied.
 // This is the package-level wait that coordinates across all mocks/wrappers
 // sharing the same TestReporter.
@@ -0,0 +1,73 @@
+package core
+
+import (
+	"fmt"
+	"time"
+)
+
+// ExpectNoReceive fails the test if a value arrives on ch, or ch is closed, within d.
+// The wait is measured with the test's Timer.
+func ExpectNoReceive[V any](t TestReporter, ch <-chan V, d time.Duration) {
+	t.Helper()
+
+	imp := GetOrCreateImp(t)
+
+	end := imp.watchdog.begin(fmt.Sprintf("no receive on %T for %v", ch, d))
+	defer end()
+
+	select {
+	case value, ok := <-ch:
+		if !ok {
+			t.Fatalf("expected no receive on %T for %v, but the channel was closed", ch, d)
+
+			return
+		}
+
+		t.Fatalf("expected no receive on %T for %v, got %#v", ch, d, value)
+	case <-imp.Timer.After(d):
+	}
+}
+
+// ExpectReceive blocks until a value arrives on ch and checks it against expected,
+// which may be a matcher or a plain value. It returns the received value.
+//
+// The test fails if ch is closed, if the test's timeout (see SetTimeout) elapses first,
+// or with a watchdog dump if the receive blocks past the watchdog threshold.
+func ExpectReceive[V any](t TestReporter, ch <-chan V, expected any) V {
+	t.Helper()
+
+	imp := GetOrCreateImp(t)
+
+	end := imp.watchdog.begin(fmt.Sprintf("a receive on %T", ch))
+	defer end()
+
+	timeout := imp.blockingTimeout()
+
+	var timeoutChan <-chan time.Time
+	if timeout > 0 {
+		timeoutChan = imp.Timer.After(timeout)
+	}
+
+	var value V
+
+	select {
+	case received, ok := <-ch:
+		if !ok {
+			t.Fatalf("expected to receive from %T, but the channel was closed", ch)
+
+			return value
+		}
+
+		value = received
+
+		if matched, msg := MatchValue(value, expected); !matched {
+			t.Fatalf("received value: %s", msg)
+		}
+	case <-timeoutChan:
+		t.Fatalf("timeout after %v waiting to receive from %T", timeout, ch)
+	case <-imp.watchdog.expired():
+		imp.watchdog.fail()
+	}
+
+	return value
+}
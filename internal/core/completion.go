@@ -0,0 +1,71 @@
+package core
+
+import (
+	"fmt"
+	"reflect"
+)
+
+type Completion struct {
+	Returned    any // the returns struct, or nil if the function didn't return
+	Panicked    any
+	PanicStack  []byte
+	Goexited    bool
+	GoexitStack []byte
+}
+
+// Outcome describes the completion for failure messages.
+func (c Completion) Outcome() string {
+	switch {
+	case c.Panicked != nil:
+		return "it panicked with: " + FormatPanic(c.Panicked, c.PanicStack)
+	case c.Goexited:
+		return fmt.Sprintf("it exited via runtime.Goexit (e.g. t.FailNow) without returning\n\ngoexit stack:\n%s",
+			c.GoexitStack)
+	default:
+		return "it returned"
+	}
+}
+
+type PanicInfo struct {
+	Value any
+	Stack []byte
+}
+
+// String formats the panic value followed by its stack trace.
+func (p PanicInfo) String() string {
+	return FormatPanic(p.Value, p.Stack)
+}
+
+// FormatPanic formats a recovered panic value for failure messages, followed by the
+// stack trace captured where the panic was recovered, if any.
+func FormatPanic(value any, stack []byte) string {
+	if len(stack) == 0 {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return fmt.Sprintf("%v\n\npanic stack:\n%s", value, stack)
+}
+
+// MatchPanic checks a recovered panic against expected, like MatchValue.
+// A TypedMatcher whose ValueType is PanicInfo (e.g. Satisfy(func(PanicInfo) error))
+// receives the value together with its stack trace; every other matcher receives the
+// panic value alone. On failure, the message ends with the stack trace.
+func MatchPanic(value any, stack []byte, expected any) (bool, string) {
+	actual := value
+	if typed, ok := expected.(TypedMatcher); ok && typed.ValueType() == reflect.TypeFor[PanicInfo]() {
+		actual = PanicInfo{Value: value, Stack: stack}
+	}
+
+	ok, msg := MatchValue(actual, expected)
+	if !ok && len(stack) > 0 {
+		msg = fmt.Sprintf("%s\n\npanic stack:\n%s", msg, stack)
+	}
+
+	return ok, msg
+}
+
+// TypedMatcher is implemented by matchers that accept values of a single type, such as
+// those built with Satisfy. MatchPanic uses it to pass a PanicInfo to matchers that ask for one.
+type TypedMatcher interface {
+	ValueType() reflect.Type
+}
@@ -5,7 +5,9 @@ package core
 import (
 	"fmt"
 	"reflect"
+	"runtime/debug"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -15,25 +17,96 @@ type Call interface {
 }
 
 type CallableController[T any] struct {
-	T          TestReporter
-	ReturnChan chan T
-	PanicChan  chan any
-	Returned   *T
-	Panicked   any
+	T           TestReporter
+	ReturnChan  chan T
+	PanicChan   chan any
+	GoexitChan  chan struct{}
+	Returned    *T
+	Panicked    any
+	PanicStack  []byte // stack trace captured where the panic was recovered
+	Goexited    bool   // true if the function exited via runtime.Goexit (e.g. t.FailNow)
+	GoexitStack []byte // stack trace captured as the goroutine exited
+
+	watchdog *watchdog
+	finished atomic.Bool // true once the response has been received
 }
 
-// WaitForResponse blocks until the wrapped function returns or panics.
+// Completion returns how the wrapped function finished, for async expectations.
+// Call it after WaitForResponse.
+func (c *CallableController[T]) Completion() Completion {
+	completion := Completion{
+		Panicked:    c.Panicked,
+		PanicStack:  c.PanicStack,
+		Goexited:    c.Goexited,
+		GoexitStack: c.GoexitStack,
+	}
+
+	if c.Returned != nil {
+		completion.Returned = c.Returned
+	}
+
+	return completion
+}
+
+// Outcome describes how the wrapped function finished, for failure messages:
+// "it returned", "it panicked with: ...", or "it exited via runtime.Goexit ...".
+// Call it after WaitForResponse.
+func (c *CallableController[T]) Outcome() string {
+	return c.Completion().Outcome()
+}
+
+// RecordGoexit records that the wrapped function's goroutine is exiting without
+// returning or panicking, as happens with runtime.Goexit and t.FailNow. Generated
+// wrappers call it from their deferred function.
+func (c *CallableController[T]) RecordGoexit() {
+	c.GoexitStack = debug.Stack()
+	c.GoexitChan <- struct{}{}
+}
+
+// RecordPanic records a value recovered from the wrapped function, along with the
+// current stack trace. Generated wrappers call it from their deferred recover, where
+// the stack still includes the frames that panicked.
+func (c *CallableController[T]) RecordPanic(value any) {
+	c.PanicStack = debug.Stack()
+	c.PanicChan <- value
+}
+
+// WaitForResponse blocks until the wrapped function returns, panics, or exits via
+// runtime.Goexit. If the watchdog fires first, the test fails with a state dump.
 func (c *CallableController[T]) WaitForResponse() {
-	if c.Returned != nil || c.Panicked != nil {
+	if c.Returned != nil || c.Panicked != nil || c.Goexited {
 		return
 	}
 
+	c.T.Helper()
+
+	end := c.watchdog.begin(fmt.Sprintf("%s to return or panic", c.watchName()))
+	defer end()
+
 	select {
 	case ret := <-c.ReturnChan:
 		c.Returned = &ret
 	case p := <-c.PanicChan:
 		c.Panicked = p
+	case <-c.GoexitChan:
+		c.Goexited = true
+	case <-c.watchdog.expired():
+		c.watchdog.fail()
+
+		return
 	}
+
+	c.finished.Store(true)
+}
+
+// running reports whether the wrapped function has not yet returned, panicked, or exited.
+func (c *CallableController[T]) running() bool {
+	return !c.finished.Load() && len(c.ReturnChan) == 0 && len(c.PanicChan) == 0 && len(c.GoexitChan) == 0
+}
+
+// watchName returns the wrapper name used in watchdog dumps.
+func (c *CallableController[T]) watchName() string {
+	return targetName[T]()
 }
 
 type Controller[T Call] struct {
@@ -41,9 +114,12 @@ type Controller[T Call] struct {
 	Timer    Timer
 	CallChan chan T
 
-	mu        sync.Mutex   // Protects callQueue and waiters
+	mu        sync.Mutex   // Protects callQueue, waiters, and inFlight
 	callQueue []T          // Unclaimed calls waiting for future waiters
 	waiters   []*waiter[T] // Goroutines waiting for matching calls
+	inFlight  []T          // Dispatched calls that may still be awaiting a response
+
+	watchdog *watchdog
 
 	// PendingMatcher is called for each incoming call before checking waiters.
 	// If it returns true, the call was handled by a pending expectation.
@@ -87,25 +163,16 @@ func (c *Controller[T]) GetCall(timeout time.Duration, validator func(T) error)
 	case call := <-myWaiter.result:
 		return call
 	case <-timeoutChan:
-		// Remove self from waiters list
-		c.mu.Lock()
-
-		for i, waiter := range c.waiters {
-			if waiter == myWaiter {
-				c.waiters = append(c.waiters[:i], c.waiters[i+1:]...)
-
-				break
-			}
-		}
-
-		c.mu.Unlock()
-
+		c.removeWaiter(myWaiter)
 		c.T.Fatalf("timeout waiting for call matching validator")
+	case <-c.watchdog.expired():
+		c.removeWaiter(myWaiter)
+		c.watchdog.fail()
+	}
 
-		var zero T
+	var zero T
 
-		return zero
-	}
+	return zero
 }
 
 // GetCallEventually waits indefinitely for a call that matches the given validator,
@@ -134,8 +201,18 @@ func (c *Controller[T]) GetCallEventually(validator func(T) error) T {
 	c.waiters = append(c.waiters, myWaiter)
 	c.mu.Unlock()
 
-	// Wait indefinitely for a matching call
-	return <-myWaiter.result
+	// Wait indefinitely for a matching call (or until the watchdog fires)
+	select {
+	case call := <-myWaiter.result:
+		return call
+	case <-c.watchdog.expired():
+		c.removeWaiter(myWaiter)
+		c.watchdog.fail()
+
+		var zero T
+
+		return zero
+	}
 }
 
 // GetCallOrdered waits for a call that matches the given validator, but fails
@@ -195,25 +272,37 @@ func (c *Controller[T]) GetCallOrdered(
 	case call := <-myWaiter.result:
 		return call
 	case <-timeoutChan:
-		// Remove self from waiters list
-		c.mu.Lock()
+		c.removeWaiter(myWaiter)
+		c.T.Fatalf("timeout waiting for call matching validator")
+	case <-c.watchdog.expired():
+		c.removeWaiter(myWaiter)
+		c.watchdog.fail()
+	}
 
-		for i, waiter := range c.waiters {
-			if waiter == myWaiter {
-				c.waiters = append(c.waiters[:i], c.waiters[i+1:]...)
+	var zero T
 
-				break
-			}
-		}
+	return zero
+}
 
-		c.mu.Unlock()
+// awaitingResponse describes dispatched calls that were claimed but not yet responded to.
+func (c *Controller[T]) awaitingResponse() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 
-		c.T.Fatalf("timeout waiting for call matching validator")
+	queued := make(map[any]bool, len(c.callQueue))
+	for _, call := range c.callQueue {
+		queued[call] = true
+	}
 
-		var zero T
+	var lines []string
 
-		return zero
+	for _, call := range c.inFlight {
+		if !call.Done() && !queued[call] {
+			lines = append(lines, fmt.Sprint(call))
+		}
 	}
+
+	return lines
 }
 
 // checkFailFast checks the first waiter for fail-fast mode.
@@ -246,6 +335,8 @@ func (c *Controller[T]) checkFailFast(call T) bool {
 // dispatchLoop receives calls and either matches them to waiters or queues them.
 func (c *Controller[T]) dispatchLoop() {
 	for call := range c.CallChan {
+		c.trackInFlight(call)
+
 		// Check pending expectations FIRST (before taking lock)
 		// This allows async Eventually() to intercept calls
 		if c.PendingMatcher != nil && c.PendingMatcher(call) {
@@ -282,6 +373,49 @@ func (c *Controller[T]) dispatchLoop() {
 	}
 }
 
+// queuedCalls describes calls that arrived but have not been claimed by any expectation.
+func (c *Controller[T]) queuedCalls() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	lines := make([]string, 0, len(c.callQueue))
+	for _, call := range c.callQueue {
+		lines = append(lines, fmt.Sprint(call))
+	}
+
+	return lines
+}
+
+// removeWaiter removes a waiter that gave up waiting.
+func (c *Controller[T]) removeWaiter(myWaiter *waiter[T]) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, waiter := range c.waiters {
+		if waiter == myWaiter {
+			c.waiters = append(c.waiters[:i], c.waiters[i+1:]...)
+
+			return
+		}
+	}
+}
+
+// trackInFlight records a dispatched call for watchdog reporting, dropping calls
+// that have already been responded to.
+func (c *Controller[T]) trackInFlight(call T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	pending := c.inFlight[:0]
+	for _, other := range c.inFlight {
+		if !other.Done() {
+			pending = append(pending, other)
+		}
+	}
+
+	c.inFlight = append(pending, call)
+}
+
 type PendingCompletion struct {
 	t    TestReporter
 	mu   sync.Mutex
@@ -295,9 +429,8 @@ type PendingCompletion struct {
 	useMatchers        bool // true for ExpectReturnsMatch/ExpectPanicMatches
 
 	// Actual values (set when call completes)
-	completed   bool
-	returnedVal any // The Returned struct
-	panickedVal any
+	completed bool
+	result    Completion
 }
 
 // ExpectReturnMatch registers an expectation that the call returns values matching the matchers.
@@ -311,13 +444,12 @@ func (pc *PendingCompletion) ExpectPanic(value any) {
 	pc.expectedPanicVal = value
 	pc.useMatchers = false
 	completed := pc.completed
-	returnedVal := pc.returnedVal
-	panickedVal := pc.panickedVal
+	result := pc.result
 	pc.mu.Unlock()
 
 	// If already completed, check now
 	if completed {
-		pc.checkExpectation(returnedVal, panickedVal)
+		pc.checkExpectation(result)
 	}
 }
 
@@ -328,13 +460,12 @@ func (pc *PendingCompletion) ExpectReturn(values ...any) {
 	pc.expectedReturnVals = values
 	pc.useMatchers = false
 	completed := pc.completed
-	returnedVal := pc.returnedVal
-	panickedVal := pc.panickedVal
+	result := pc.result
 	pc.mu.Unlock()
 
 	// If already completed, check now
 	if completed {
-		pc.checkExpectation(returnedVal, panickedVal)
+		pc.checkExpectation(result)
 	}
 }
 
@@ -342,24 +473,23 @@ func (pc *PendingCompletion) ExpectReturn(values ...any) {
 
 // If already completed, check now
 
-// SetCompleted is called when the call completes with a return value or panic.
-// If an expectation is already registered, it checks the expectation.
-func (pc *PendingCompletion) SetCompleted(returnedVal, panickedVal any) {
+// SetCompleted is called when the call completes with a return value, a panic, or
+// a Goexit. If an expectation is already registered, it checks the expectation.
+func (pc *PendingCompletion) SetCompleted(result Completion) {
 	pc.mu.Lock()
 	pc.completed = true
-	pc.returnedVal = returnedVal
-	pc.panickedVal = panickedVal
+	pc.result = result
 	hasExpectation := pc.expectReturn || pc.expectPanic
 	pc.mu.Unlock()
 
 	// If expectation already registered, check now
 	if hasExpectation {
-		pc.checkExpectation(returnedVal, panickedVal)
+		pc.checkExpectation(result)
 	}
 }
 
 // checkExpectation verifies the actual values against the expected values.
-func (pc *PendingCompletion) checkExpectation(returnedVal, panickedVal any) {
+func (pc *PendingCompletion) checkExpectation(result Completion) {
 	pc.t.Helper()
 
 	pc.mu.Lock()
@@ -371,17 +501,17 @@ func (pc *PendingCompletion) checkExpectation(returnedVal, panickedVal any) {
 	pc.mu.Unlock()
 
 	if expectReturn {
-		if panickedVal != nil {
-			pc.t.Fatalf("expected function to return, but it panicked with: %v", panickedVal)
+		if result.Panicked != nil || result.Goexited {
+			pc.t.Fatalf("expected function to return, but %s", result.Outcome())
 		}
 
-		pc.checkReturnValues(returnedVal, expectedReturnVals, useMatchers)
+		pc.checkReturnValues(result.Returned, expectedReturnVals, useMatchers)
 	} else if expectPanic {
-		if panickedVal == nil {
-			pc.t.Fatalf("expected function to panic, but it returned")
+		if result.Panicked == nil {
+			pc.t.Fatalf("expected function to panic, but %s", result.Outcome())
 		}
 
-		ok, msg := MatchValue(panickedVal, expectedPanicVal)
+		ok, msg := MatchPanic(result.Panicked, result.PanicStack, expectedPanicVal)
 		if !ok {
 			pc.t.Fatalf("panic value: %s", msg)
 		}
@@ -436,8 +566,8 @@ func (pc *PendingCompletion) checkReturnValues(
 			if !ok {
 				pc.t.Fatalf("return value %d: %s", index, msg)
 			}
-		} else if !reflect.DeepEqual(actual, expected) {
-			pc.t.Fatalf("expected return value %d to be %v, got %v", index, expected, actual)
+		} else if ok, msg := EqualValue(pc.t, actual, expected); !ok {
+			pc.t.Fatalf("return value %d: %s", index, msg)
 		}
 	}
 }
@@ -446,15 +576,18 @@ type PendingExpectation struct {
 	mu           sync.Mutex
 	MethodName   string
 	Validator    func([]any) error
-	ReturnValues []any                  // nil until Return called
-	PanicValue   any                    // non-nil if Panic called
-	IsPanic      bool                   // true if this should panic instead of return
-	Matched      bool                   // true when a call matched the validator
-	Injected     bool                   // true when Return/Panic was called
-	responseChan chan<- GenericResponse // set when validator matches
-	done         chan struct{}          // signals when BOTH matched AND injected
-	matchedArgs  []any                  // args from the call that matched
-	matchedChan  chan struct{}          // closed when a call is matched
+	ReturnValues []any             // nil until Return called
+	PanicValue   any               // non-nil if Panic called
+	IsPanic      bool              // true if this should panic instead of return
+	Matched      bool              // true when a call matched the validator
+	Injected     bool              // true when a response was specified
+	call         *GenericCall      // set when validator matches
+	onMatch      func([]any) error // called with the matched args before the match is signalled
+	onMatchErr   error             // returned by onMatch; reported on the test goroutine
+	response     GenericResponse   // set when Return/Panic/... was called
+	done         chan struct{}     // signals when BOTH matched AND injected
+	matchedChan  chan struct{}     // closed when a call is matched
+	imp          *Imp
 }
 
 // GetMatchedArgs returns the args from the matched call.
@@ -463,10 +596,13 @@ func (pe *PendingExpectation) GetMatchedArgs() []any {
 	pe.WaitForMatch()
 
 	pe.mu.Lock()
-	args := pe.matchedArgs
-	pe.mu.Unlock()
+	defer pe.mu.Unlock()
+
+	if pe.call == nil {
+		return nil
+	}
 
-	return args
+	return pe.call.Args
 }
 
 // Panic specifies the value the mock should panic with.
@@ -475,20 +611,12 @@ func (pe *PendingExpectation) Panic(value any) {
 	pe.mu.Lock()
 	pe.PanicValue = value
 	pe.IsPanic = true
-	pe.Injected = true
-	matched := pe.Matched
-	responseChan := pe.responseChan
 	pe.mu.Unlock()
 
-	// If already matched, send response now
-	if matched && responseChan != nil {
-		responseChan <- GenericResponse{
-			Type:       "panic",
-			PanicValue: value,
-		}
-
-		close(pe.done)
-	}
+	pe.respond(GenericResponse{
+		Type:       ResponsePanic,
+		PanicValue: value,
+	})
 }
 
 // Return specifies the values the mock should return.
@@ -496,47 +624,105 @@ func (pe *PendingExpectation) Panic(value any) {
 func (pe *PendingExpectation) Return(values ...any) {
 	pe.mu.Lock()
 	pe.ReturnValues = values
-	pe.Injected = true
-	matched := pe.Matched
-	responseChan := pe.responseChan
 	pe.mu.Unlock()
 
-	// If already matched, send response now
-	if matched && responseChan != nil {
-		responseChan <- GenericResponse{
-			Type:         "return",
-			ReturnValues: values,
-		}
-
-		close(pe.done)
-	}
+	pe.respond(GenericResponse{
+		Type:         ResponseReturn,
+		ReturnValues: values,
+	})
 }
 
 // WaitForMatch blocks until a call matches this expectation.
+// If the watchdog fires first, the test fails with a state dump.
 func (pe *PendingExpectation) WaitForMatch() {
 	pe.mu.Lock()
 	matchedChan := pe.matchedChan
 	pe.mu.Unlock()
 
 	if matchedChan != nil {
-		<-matchedChan
+		await(pe.imp.watchdog, fmt.Sprintf("a call to %q (Eventually)", pe.MethodName), matchedChan)
+	}
+
+	pe.reportMatchError()
+}
+
+// describe summarizes the expectation's progress for watchdog dumps.
+// Returns an empty string once the expectation is satisfied.
+func (pe *PendingExpectation) describe() string {
+	select {
+	case <-pe.done:
+		return ""
+	default:
+	}
+
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+
+	if pe.call == nil {
+		return fmt.Sprintf("%q: waiting for a matching call", pe.MethodName)
+	}
+
+	return fmt.Sprintf("%q: matched %v, awaiting Return or Panic", pe.MethodName, pe.call)
+}
+
+// matchedCall blocks until a call is matched and returns it.
+func (pe *PendingExpectation) matchedCall() *GenericCall {
+	pe.WaitForMatch()
+
+	pe.mu.Lock()
+	defer pe.mu.Unlock()
+
+	return pe.call
+}
+
+// reportMatchError fails the test if committing the matched call's matchers failed.
+// It must be called on the test goroutine.
+func (pe *PendingExpectation) reportMatchError() {
+	pe.mu.Lock()
+	err := pe.onMatchErr
+	pe.mu.Unlock()
+
+	if err != nil {
+		pe.imp.t.Fatalf("%s: %v", pe.MethodName, err)
+	}
+}
+
+// respond records the response to inject.
+// If already matched, sends the response immediately.
+func (pe *PendingExpectation) respond(resp GenericResponse) {
+	pe.mu.Lock()
+	pe.response = resp
+	pe.Injected = true
+	call := pe.call
+	pe.mu.Unlock()
+
+	// If already matched, send response now
+	if call != nil {
+		pe.imp.respond(call, resp)
+		close(pe.done)
 	}
 }
 
 // setMatched is called when a call matches this expectation.
 // If already injected, sends response immediately.
-func (pe *PendingExpectation) setMatched(responseChan chan<- GenericResponse, args []any) {
+func (pe *PendingExpectation) setMatched(call *GenericCall) {
 	pe.mu.Lock()
 	pe.Matched = true
-	pe.responseChan = responseChan
-	pe.matchedArgs = args
+	pe.call = call
 	injected := pe.Injected
-	isPanic := pe.IsPanic
-	returnValues := pe.ReturnValues
-	panicValue := pe.PanicValue
+	response := pe.response
 	matchedChan := pe.matchedChan
 	pe.mu.Unlock()
 
+	// setMatched runs on the dispatch goroutine, so an error is kept for the test goroutine to report
+	if pe.onMatch != nil {
+		err := pe.onMatch(call.Args)
+
+		pe.mu.Lock()
+		pe.onMatchErr = err
+		pe.mu.Unlock()
+	}
+
 	// Signal that a call was matched
 	if matchedChan != nil {
 		close(matchedChan)
@@ -544,18 +730,7 @@ func (pe *PendingExpectation) setMatched(responseChan chan<- GenericResponse, ar
 
 	// If already injected, send response now
 	if injected {
-		if isPanic {
-			responseChan <- GenericResponse{
-				Type:       "panic",
-				PanicValue: panicValue,
-			}
-		} else {
-			responseChan <- GenericResponse{
-				Type:         "return",
-				ReturnValues: returnValues,
-			}
-		}
-
+		pe.imp.respond(call, response)
 		close(pe.done)
 	}
 }
@@ -595,12 +770,20 @@ type Timer interface {
 }
 
 // NewCallableController creates a new callable controller.
+// The controller is registered with the test's Imp so that the watchdog can
+// report wrappers that have not returned.
 func NewCallableController[T any](t TestReporter) *CallableController[T] {
-	return &CallableController[T]{
+	imp := GetOrCreateImp(t)
+	ctrl := &CallableController[T]{
 		T:          t,
 		ReturnChan: make(chan T, 1),
 		PanicChan:  make(chan any, 1),
+		GoexitChan: make(chan struct{}, 1),
+		watchdog:   imp.watchdog,
 	}
+	imp.trackTarget(ctrl)
+
+	return ctrl
 }
 
 // NewController creates a new controller with the default real timer.
@@ -614,7 +797,11 @@ func NewControllerWithTimer[T Call](t TestReporter, timer Timer) *Controller[T]
 		T:        t,
 		Timer:    timer,
 		CallChan: make(chan T, 1),
+		watchdog: newWatchdog(t, timer),
 	}
+	ctrl.watchdog.add("queued calls (not yet claimed by an expectation)", ctrl.queuedCalls)
+	ctrl.watchdog.add("mock calls blocked awaiting a response", ctrl.awaitingResponse)
+
 	go ctrl.dispatchLoop()
 
 	return ctrl
@@ -1,6 +1,10 @@
 package core
 
-import "fmt"
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
 
 type DependencyArgs struct {
 	A1 any
@@ -12,6 +16,7 @@ type DependencyArgs struct {
 }
 
 type DependencyCall struct {
+	method  *DependencyMethod
 	call    *GenericCall        // set in synchronous mode
 	pending *PendingExpectation // set in async mode (Eventually)
 }
@@ -20,6 +25,69 @@ type DependencyCall struct {
 
 // Build the args struct from the call's args
 
+// CloseReturnedChannels closes every channel previously returned by a mock of
+// this test, then makes the mock return the given values.
+// Use it to simulate a dependency shutting down: watch channels, done channels,
+// and subscription streams handed out by earlier calls all close at once.
+// Only bidirectional channels (chan T) can be closed; if an earlier call returned a
+// receive-only or send-only channel, the test fails instead.
+// In async mode, this can be called before or after the call is matched.
+func (dc *DependencyCall) CloseReturnedChannels(values ...any) {
+	dc.checkReturnValues(values)
+	dc.method.imp.checkReturnedChannelsClosable()
+
+	dc.respond(GenericResponse{
+		Type:         ResponseCloseChannels,
+		ReturnValues: values,
+	})
+}
+
+// FillArg writes value into the caller's argument at index before the mock returns,
+// for methods that return results through their arguments (io.Reader.Read,
+// json.Unmarshal, sql.Row.Scan). A slice argument receives a copy of value's
+// elements; a pointer argument (including one passed as any) has value stored
+// through it. A pointer to a value of the pointed-to type is dereferenced first.
+//
+// FillArg must be called before the response (Return, Panic, ...) is specified.
+// In async mode, blocks until a call is matched.
+func (dc *DependencyCall) FillArg(index int, value any) {
+	dc.method.imp.t.Helper()
+
+	call := dc.matchedCall()
+	if call == nil {
+		return
+	}
+
+	if call.Done() {
+		dc.method.imp.t.Fatalf("%s: arg %d: FillArg must be called before the response is specified",
+			dc.method.methodName, index)
+
+		return
+	}
+
+	err := fillArg(call.callerArgs(), index, value)
+	if err != nil {
+		dc.method.imp.t.Fatalf("%s: %v", dc.method.methodName, err)
+	}
+}
+
+// Goexit specifies that the mock should call runtime.Goexit instead of returning.
+// This simulates a dependency that calls t.FailNow or otherwise exits its goroutine;
+// deferred functions in the calling goroutine still run.
+// In async mode, this can be called before or after the call is matched.
+func (dc *DependencyCall) Goexit() {
+	dc.respond(GenericResponse{Type: ResponseGoexit})
+}
+
+// Hang specifies that the mock should block until the test ends instead of returning.
+// This simulates a dependency that never responds, for exercising timeouts and
+// cancellation paths in the code under test. When the test ends, the mock exits its
+// goroutine via runtime.Goexit rather than returning into code the test has finished with.
+// In async mode, this can be called before or after the call is matched.
+func (dc *DependencyCall) Hang() {
+	dc.respond(GenericResponse{Type: ResponseHang})
+}
+
 // Panic specifies that the mock should panic with the given value.
 // This sends a panic response to the mock's response channel, unblocking it.
 // In async mode, this can be called before or after the call is matched.
@@ -31,13 +99,10 @@ func (dc *DependencyCall) Panic(value any) {
 		return
 	}
 
-	// Synchronous mode - send directly
-	dc.call.MarkDone()
-
-	dc.call.ResponseChan <- GenericResponse{
-		Type:       "panic",
+	dc.respond(GenericResponse{
+		Type:       ResponsePanic,
 		PanicValue: value,
-	}
+	})
 }
 
 // RawArgs returns the raw argument slice for use by generated code.
@@ -57,6 +122,8 @@ func (dc *DependencyCall) RawArgs() []any {
 // This sends the response to the mock's response channel, unblocking it.
 // In async mode, this can be called before or after the call is matched.
 func (dc *DependencyCall) Return(values ...any) {
+	dc.checkReturnValues(values)
+
 	if dc.pending != nil {
 		// Async mode - delegate to PendingExpectation
 		dc.pending.Return(values...)
@@ -64,19 +131,64 @@ func (dc *DependencyCall) Return(values ...any) {
 		return
 	}
 
-	// Synchronous mode - send directly
-	dc.call.MarkDone()
+	dc.respond(GenericResponse{
+		Type:         ResponseReturn,
+		ReturnValues: values,
+	})
+}
 
-	dc.call.ResponseChan <- GenericResponse{
-		Type:         "return",
+// ReturnAfter specifies that the mock should return the given values after delay d.
+// The delay is measured with the test's Timer, so tests using a fake timer control
+// when the mock returns.
+// In async mode, this can be called before or after the call is matched.
+func (dc *DependencyCall) ReturnAfter(d time.Duration, values ...any) {
+	dc.checkReturnValues(values)
+
+	dc.respond(GenericResponse{
+		Type:         ResponseReturnAfter,
 		ReturnValues: values,
+		Delay:        d,
+	})
+}
+
+// checkReturnValues fails the test if values can't be returned by the mocked method.
+func (dc *DependencyCall) checkReturnValues(values []any) {
+	err := dc.method.checkResults(values)
+	if err != nil {
+		dc.method.imp.t.Helper()
+		dc.method.imp.t.Fatalf("%s: %v", dc.method.methodName, err)
+	}
+}
+
+// matchedCall returns the call this expectation matched.
+// In async mode, blocks until a call is matched.
+func (dc *DependencyCall) matchedCall() *GenericCall {
+	if dc.pending != nil {
+		return dc.pending.matchedCall()
 	}
+
+	return dc.call
+}
+
+// respond delivers resp to the mock, or records it on the pending expectation.
+func (dc *DependencyCall) respond(resp GenericResponse) {
+	if dc.pending != nil {
+		// Async mode - delegate to PendingExpectation
+		dc.pending.respond(resp)
+
+		return
+	}
+
+	// Synchronous mode - send directly
+	dc.method.imp.respond(dc.call, resp)
 }
 
 type DependencyMethod struct {
-	imp        *Imp
-	methodName string
-	eventually bool
+	imp          *Imp
+	methodName   string
+	eventually   bool
+	resultsKnown bool           // true once Results declared the method's result types
+	resultTypes  []reflect.Type // result types injected return values must match
 }
 
 // NewDependencyMethod creates a new DependencyMethod in synchronous mode.
@@ -90,8 +202,8 @@ func NewDependencyMethod(imp *Imp, methodName string) *DependencyMethod {
 }
 
 // ArgsEqual waits for a call to this method with exactly the specified arguments.
-// Uses reflection-based DeepEqual for argument matching. Returns detailed error messages
-// when arguments don't match.
+// Uses reflection-based DeepEqual for argument matching, or the test's equality if one
+// was configured with SetEquality. Returns detailed error messages when arguments don't match.
 // In eventually mode, this returns immediately (non-blocking) and registers a pending expectation.
 func (dm *DependencyMethod) ArgsEqual(args ...any) *DependencyCall {
 	validator := func(actualArgs []any) error {
@@ -101,9 +213,9 @@ func (dm *DependencyMethod) ArgsEqual(args ...any) *DependencyCall {
 		}
 
 		for i, expected := range args {
-			if !valuesEqual(actualArgs[i], expected) {
+			if ok, msg := dm.imp.equal(actualArgs[i], expected); !ok {
 				//nolint:err113 // validation error with dynamic context
-				return fmt.Errorf("arg %d: expected %#v, got %#v", i, expected, actualArgs[i])
+				return fmt.Errorf("arg %d: %s", i, msg)
 			}
 		}
 
@@ -115,19 +227,21 @@ func (dm *DependencyMethod) ArgsEqual(args ...any) *DependencyCall {
 		pending := dm.imp.RegisterPendingExpectation(dm.methodName, validator)
 
 		return &DependencyCall{
+			method:  dm,
 			pending: pending,
 		}
 	}
 
 	// Synchronous mode - block until call arrives
-	call := dm.imp.GetCallOrdered(0, dm.methodName, validator)
+	call := dm.imp.GetCallOrdered(dm.imp.blockingTimeout(), dm.methodName, validator)
 
-	return newDependencyCall(call)
+	return newDependencyCall(dm, call)
 }
 
 // ArgsShould waits for a call to this method with arguments matching the given matchers.
 // Each matcher should implement the Matcher interface (compatible with gomega matchers).
-// Returns detailed error messages when matchers don't match.
+// Returns detailed error messages when matchers don't match. Matchers implementing
+// Committer are committed once the call has matched.
 // In eventually mode, this returns immediately (non-blocking) and registers a pending expectation.
 func (dm *DependencyMethod) ArgsShould(matchers ...any) *DependencyCall {
 	validator := func(actualArgs []any) error {
@@ -137,7 +251,7 @@ func (dm *DependencyMethod) ArgsShould(matchers ...any) *DependencyCall {
 		}
 
 		for index, m := range matchers {
-			ok, failureMsg := MatchValue(actualArgs[index], m)
+			ok, failureMsg := matchValue(actualArgs[index], m)
 			if !ok {
 				if failureMsg != "" {
 					//nolint:err113 // validation error with dynamic context
@@ -151,19 +265,49 @@ func (dm *DependencyMethod) ArgsShould(matchers ...any) *DependencyCall {
 		return nil
 	}
 
+	commit := commitMatchers(matchers)
+
 	if dm.eventually {
 		// Async mode - register pending expectation and return immediately
-		pending := dm.imp.RegisterPendingExpectation(dm.methodName, validator)
+		pending := dm.imp.registerPendingExpectation(dm.methodName, validator, commit)
 
 		return &DependencyCall{
+			method:  dm,
 			pending: pending,
 		}
 	}
 
 	// Synchronous mode - block until call arrives
-	call := dm.imp.GetCallOrdered(0, dm.methodName, validator)
+	call := dm.imp.GetCallOrdered(dm.imp.blockingTimeout(), dm.methodName, validator)
+	if call != nil {
+		err := commit(call.Args)
+		if err != nil {
+			dm.imp.t.Fatalf("%s: %v", dm.methodName, err)
+		}
+	}
+
+	return newDependencyCall(dm, call)
+}
+
+// ArgsWhere waits for a call to this method whose arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+// Generated wrappers adapt it to a predicate over the method's typed Args struct.
+// In eventually mode, this returns immediately (non-blocking) and registers a pending expectation.
+func (dm *DependencyMethod) ArgsWhere(predicate func(args []any) error) *DependencyCall {
+	if dm.eventually {
+		// Async mode - register pending expectation and return immediately
+		pending := dm.imp.RegisterPendingExpectation(dm.methodName, predicate)
+
+		return &DependencyCall{
+			method:  dm,
+			pending: pending,
+		}
+	}
+
+	// Synchronous mode - block until call arrives
+	call := dm.imp.GetCallOrdered(dm.imp.blockingTimeout(), dm.methodName, predicate)
 
-	return newDependencyCall(call)
+	return newDependencyCall(dm, call)
 }
 
 // AsEventually returns a copy of this DependencyMethod configured for async mode.
@@ -171,9 +315,11 @@ func (dm *DependencyMethod) ArgsShould(matchers ...any) *DependencyCall {
 // pending expectations that are matched when calls arrive.
 func (dm *DependencyMethod) AsEventually() *DependencyMethod {
 	return &DependencyMethod{
-		imp:        dm.imp,
-		methodName: dm.methodName,
-		eventually: true,
+		imp:          dm.imp,
+		methodName:   dm.methodName,
+		eventually:   true,
+		resultsKnown: dm.resultsKnown,
+		resultTypes:  dm.resultTypes,
 	}
 }
 
@@ -191,20 +337,117 @@ func (dm *DependencyMethod) Called() *DependencyCall {
 		pending := dm.imp.RegisterPendingExpectation(dm.methodName, validator)
 
 		return &DependencyCall{
+			method:  dm,
 			pending: pending,
 		}
 	}
 
 	// Synchronous mode - block until call arrives
-	call := dm.imp.GetCallOrdered(0, dm.methodName, validator)
+	call := dm.imp.GetCallOrdered(dm.imp.blockingTimeout(), dm.methodName, validator)
+
+	return newDependencyCall(dm, call)
+}
+
+// Results declares the method's result types, so that injected return values are
+// checked for count and assignability instead of silently becoming zero values.
+// It returns dm for chaining. Generated mocks call it with the mocked signature.
+func (dm *DependencyMethod) Results(types ...reflect.Type) *DependencyMethod {
+	dm.resultsKnown = true
+	dm.resultTypes = types
 
-	return newDependencyCall(call)
+	return dm
+}
+
+// checkResults reports whether values can be returned by the method.
+// Methods whose result types were never declared accept anything.
+func (dm *DependencyMethod) checkResults(values []any) error {
+	if !dm.resultsKnown {
+		return nil
+	}
+
+	if len(values) != len(dm.resultTypes) {
+		//nolint:err113 // validation error with dynamic context
+		return fmt.Errorf("expected %d return values, got %d", len(dm.resultTypes), len(values))
+	}
+
+	for index, resultType := range dm.resultTypes {
+		if !assignableTo(values[index], resultType) {
+			//nolint:err113 // validation error with dynamic context
+			return fmt.Errorf("return value %d: %#v (%T) is not assignable to %s",
+				index, values[index], values[index], resultType)
+		}
+	}
+
+	return nil
+}
+
+// assignableTo reports whether value can be stored in a variable of type target.
+// An untyped nil is assignable to any type that can be nil.
+func assignableTo(value any, target reflect.Type) bool {
+	if value == nil {
+		switch target.Kind() { //nolint:exhaustive // only nillable kinds accept nil
+		case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice:
+			return true
+		default:
+			return false
+		}
+	}
+
+	return reflect.TypeOf(value).AssignableTo(target)
+}
+
+// fillArg stores value into args[index], which must be a slice or a pointer.
+func fillArg(args []any, index int, value any) error {
+	if index < 0 || index >= len(args) {
+		//nolint:err113 // validation error with dynamic context
+		return fmt.Errorf("arg %d: out of range (call has %d args)", index, len(args))
+	}
+
+	target := reflect.ValueOf(args[index])
+	source := reflect.ValueOf(value)
+
+	switch {
+	case target.Kind() == reflect.Slice:
+		if source.Kind() != reflect.Slice || source.Type().Elem() != target.Type().Elem() {
+			//nolint:err113 // validation error with dynamic context
+			return fmt.Errorf("arg %d: cannot fill %s with %T", index, target.Type(), value)
+		}
+
+		if source.Len() > target.Len() {
+			//nolint:err113 // validation error with dynamic context
+			return fmt.Errorf("arg %d: %d values do not fit in a slice of length %d", index, source.Len(), target.Len())
+		}
+
+		reflect.Copy(target, source)
+
+		return nil
+	case target.Kind() == reflect.Pointer && !target.IsNil():
+		elem := target.Elem()
+
+		switch {
+		case value == nil:
+			elem.SetZero()
+		case source.Type().AssignableTo(elem.Type()):
+			elem.Set(source)
+		case source.Kind() == reflect.Pointer && !source.IsNil() && source.Elem().Type().AssignableTo(elem.Type()):
+			elem.Set(source.Elem())
+		default:
+			//nolint:err113 // validation error with dynamic context
+			return fmt.Errorf("arg %d: cannot store %T through %s", index, value, target.Type())
+		}
+
+		return nil
+	default:
+		//nolint:err113 // validation error with dynamic context
+		return fmt.Errorf("arg %d: %T is not a non-nil pointer or slice", index, args[index])
+	}
 }
 
 // newDependencyCall creates a DependencyCall from a GenericCall.
 // This is called by generated mock code after receiving a call from the Controller.
-func newDependencyCall(call *GenericCall) *DependencyCall {
+func newDependencyCall(method *DependencyMethod, call *GenericCall) *DependencyCall {
 	return &DependencyCall{
-		call: call,
+		method: method,
+		call:   call,
 	}
 }
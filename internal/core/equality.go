@@ -0,0 +1,61 @@
+package core
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// SetEquality configures the comparison behind ArgsEqual and ReturnsEqual.
+// newMatcher builds a matcher for each expected value; nil restores reflect.DeepEqual.
+func (i *Imp) SetEquality(newMatcher func(expected any) Matcher) {
+	if newMatcher == nil {
+		i.equality.Store(nil)
+
+		return
+	}
+
+	i.equality.Store(&newMatcher)
+}
+
+// equal compares actual with expected using the configured equality, if any.
+func (i *Imp) equal(actual, expected any) (bool, string) {
+	newMatcher := i.equality.Load()
+	if newMatcher == nil {
+		return deepEqualValue(actual, expected)
+	}
+
+	return matchValue(actual, (*newMatcher)(expected))
+}
+
+// EqualValue reports whether actual equals expected under the equality configured for t
+// (see SetEquality), falling back to reflect.DeepEqual.
+// Returns (success, errorMessage). If success is true, errorMessage is empty.
+func EqualValue(t TestReporter, actual, expected any) (bool, string) {
+	registryMu.Lock()
+	imp, ok := registry[t]
+	registryMu.Unlock()
+
+	if !ok {
+		return deepEqualValue(actual, expected)
+	}
+
+	return imp.equal(actual, expected)
+}
+
+// SetEquality configures the comparison used by ArgsEqual and ReturnsEqual in the test.
+// newMatcher builds a matcher for each expected value, and the matcher's FailureMessage
+// reports mismatches. Pass nil to restore reflect.DeepEqual.
+//
+// If no Imp has been created for t yet, one is created.
+func SetEquality(t TestReporter, newMatcher func(expected any) Matcher) {
+	GetOrCreateImp(t).SetEquality(newMatcher)
+}
+
+// deepEqualValue is the default equality: reflect.DeepEqual with %#v formatted values.
+func deepEqualValue(actual, expected any) (bool, string) {
+	if reflect.DeepEqual(actual, expected) {
+		return true, ""
+	}
+
+	return false, fmt.Sprintf("expected %#v, got %#v", expected, actual)
+}
@@ -52,9 +52,27 @@ func (m *TestReporterMockFatalfMethod) ArgsEqual(format string, args ...any) *Te
 	return &TestReporterMockFatalfCall{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *TestReporterMockFatalfMethod) ArgsShould(matchers ...any) *TestReporterMockFatalfCall {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *TestReporterMockFatalfMethod) ArgsShould(format any, args ...any) *TestReporterMockFatalfCall {
+	_matchers := []any{format}
+	_matchers = append(_matchers, args...)
+	_call := m.DependencyMethod.ArgsShould(_matchers...)
+	return &TestReporterMockFatalfCall{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *TestReporterMockFatalfMethod) ArgsWhere(predicate func(TestReporterMockFatalfArgs) error) *TestReporterMockFatalfCall {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args TestReporterMockFatalfArgs
+		args.Format, _ = raw[0].(string)
+		for _, value := range raw[1:] {
+			elem, _ := value.(any)
+			args.Args = append(args.Args, elem)
+		}
+		return predicate(args)
+	})
 	return &TestReporterMockFatalfCall{DependencyCall: call}
 }
 
@@ -62,12 +80,12 @@ func (m *TestReporterMockFatalfMethod) ArgsShould(matchers ...any) *TestReporter
 func MockTestReporter(t _imptest.TestReporter) (core.TestReporter, *TestReporterImp) {
 	ctrl := _imptest.GetOrCreateImp(t)
 	imp := &TestReporterImp{
-		Helper: _imptest.NewDependencyMethod(ctrl, "Helper"),
-		Fatalf: newTestReporterMockFatalfMethod(_imptest.NewDependencyMethod(ctrl, "Fatalf")),
+		Helper: _imptest.NewDependencyMethod(ctrl, "Helper").Results(),
+		Fatalf: newTestReporterMockFatalfMethod(_imptest.NewDependencyMethod(ctrl, "Fatalf").Results()),
 	}
 	imp.Eventually = &TestReporterImpEventually{
-		Helper: _imptest.NewDependencyMethod(ctrl, "Helper").AsEventually(),
-		Fatalf: newTestReporterMockFatalfMethod(_imptest.NewDependencyMethod(ctrl, "Fatalf").AsEventually()),
+		Helper: _imptest.NewDependencyMethod(ctrl, "Helper").Results().AsEventually(),
+		Fatalf: newTestReporterMockFatalfMethod(_imptest.NewDependencyMethod(ctrl, "Fatalf").Results().AsEventually()),
 	}
 	mock := &mockTestReporterImpl{ctrl: ctrl}
 	return mock, imp
@@ -90,9 +108,7 @@ func (impl *mockTestReporterImpl) Fatalf(format string, args ...any) {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 }
 
@@ -105,9 +121,7 @@ func (impl *mockTestReporterImpl) Helper() {
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 
 }
 
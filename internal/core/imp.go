@@ -3,7 +3,9 @@ package core
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -11,17 +13,18 @@ type GenericCall struct {
 	MethodName   string
 	Args         []any
 	ResponseChan chan GenericResponse
-	done         bool
+	original     []any // the caller's arguments, when Args holds a snapshot
+	done         atomic.Bool
 }
 
 // Done returns whether the call has been responded to.
 func (c *GenericCall) Done() bool {
-	return c.done
+	return c.done.Load()
 }
 
 // MarkDone marks the call as done (called when response is injected).
 func (c *GenericCall) MarkDone() {
-	c.done = true
+	c.done.Store(true)
 }
 
 // Name returns the method name for the Call interface.
@@ -29,10 +32,23 @@ func (c *GenericCall) Name() string {
 	return c.MethodName
 }
 
-type GenericResponse struct {
-	Type         string // "return", "panic"
-	ReturnValues []any
-	PanicValue   any
+// String formats the call as MethodName(arg1, arg2, ...) for diagnostics.
+func (c *GenericCall) String() string {
+	args := make([]string, 0, len(c.Args))
+	for _, arg := range c.Args {
+		args = append(args, fmt.Sprintf("%#v", arg))
+	}
+
+	return c.MethodName + "(" + strings.Join(args, ", ") + ")"
+}
+
+// callerArgs returns the arguments exactly as the caller passed them.
+func (c *GenericCall) callerArgs() []any {
+	if c.original != nil {
+		return c.original
+	}
+
+	return c.Args
 }
 
 type Imp struct {
@@ -43,6 +59,20 @@ type Imp struct {
 	pendingMu           sync.Mutex
 	pendingExpectations []*PendingExpectation
 	cleanupRegistered   bool
+
+	targetsMu sync.Mutex
+	targets   []watchedTarget
+
+	channelsMu       sync.Mutex
+	returnedChannels []reflect.Value // channels returned by mocks, for ResponseCloseChannels
+
+	snapshotArgs atomic.Bool // deep-copy call arguments at dispatch (see SetArgSnapshots)
+
+	equality atomic.Pointer[func(expected any) Matcher] // ArgsEqual/ReturnsEqual comparison (see SetEquality)
+
+	timeout atomic.Int64 // time.Duration for blocking operations; 0 means none (see SetTimeout)
+
+	testDone chan struct{} // closed when the test ends, releasing hanging mocks
 }
 
 // NewImp creates a new Imp coordinator.
@@ -50,10 +80,18 @@ func NewImp(testReporter TestReporter) *Imp {
 	imp := &Imp{
 		Controller: NewController[*GenericCall](testReporter),
 		t:          testReporter,
+		testDone:   make(chan struct{}),
+	}
+
+	if cr, ok := testReporter.(cleanupRegistrar); ok {
+		cr.Cleanup(func() { close(imp.testDone) })
 	}
 
 	// Set up pending matcher to intercept calls for async Eventually()
-	imp.PendingMatcher = imp.matchPendingExpectation
+	imp.PendingMatcher = imp.interceptCall
+
+	imp.watchdog.add("pending Eventually expectations", imp.unsatisfiedExpectations)
+	imp.watchdog.add("target wrappers that have not returned", imp.runningTargets)
 
 	return imp
 }
@@ -82,6 +120,9 @@ func (i *Imp) GetCallEventually(methodName string, validator func([]any) error)
 		return nil
 	}
 
+	end := i.watchdog.begin(fmt.Sprintf("a call to %q", methodName))
+	defer end()
+
 	return i.Controller.GetCallEventually(combinedValidator)
 }
 
@@ -115,6 +156,9 @@ func (i *Imp) GetCallOrdered(
 		return nil
 	}
 
+	end := i.watchdog.begin(fmt.Sprintf("an ordered call to %q", methodName))
+	defer end()
+
 	return i.Controller.GetCallOrdered(timeout, combinedValidator)
 }
 
@@ -134,12 +178,112 @@ func (i *Imp) Helper() {
 func (i *Imp) RegisterPendingExpectation(
 	methodName string,
 	validator func([]any) error,
+) *PendingExpectation {
+	return i.registerPendingExpectation(methodName, validator, nil)
+}
+
+// SetTimeout configures how long ordered expectations, Wait, and channel receives
+// may block before failing the test. A duration of 0 means no timeout (block forever).
+func (i *Imp) SetTimeout(d time.Duration) {
+	i.timeout.Store(int64(d))
+}
+
+// SetWatchdogThreshold configures how long blocking operations may wait before
+// the watchdog fails the test with a state dump. A duration of 0 disables the watchdog.
+func (i *Imp) SetWatchdogThreshold(d time.Duration) {
+	i.watchdog.setThreshold(d)
+}
+
+// Wait blocks until all pending expectations are satisfied.
+// Call this after registering expectations with Eventually().
+func (i *Imp) Wait() {
+	i.pendingMu.Lock()
+	expectations := make([]*PendingExpectation, len(i.pendingExpectations))
+	copy(expectations, i.pendingExpectations)
+	i.pendingMu.Unlock()
+
+	timeout := i.blockingTimeout()
+
+	var timeoutChan <-chan time.Time
+	if timeout > 0 && len(expectations) > 0 {
+		timeoutChan = i.Timer.After(timeout)
+	}
+
+	// Wait for each pending expectation to complete
+	for _, pe := range expectations {
+		desc := fmt.Sprintf("Eventually expectation for %q", pe.MethodName)
+		end := i.watchdog.begin(desc)
+
+		select {
+		case <-pe.done:
+		case <-timeoutChan:
+			end()
+			i.t.Fatalf("timeout after %v waiting for %s", timeout, desc)
+
+			return
+		case <-i.watchdog.expired():
+			i.watchdog.fail()
+		}
+
+		end()
+		pe.reportMatchError()
+	}
+}
+
+// blockingTimeout returns the duration set with SetTimeout, or 0 for none.
+func (i *Imp) blockingTimeout() time.Duration {
+	return time.Duration(i.timeout.Load())
+}
+
+// matchPendingExpectation checks if a call matches any pending expectation.
+// Returns true if matched (call was handled), false otherwise.
+func (i *Imp) matchPendingExpectation(call *GenericCall) bool {
+	i.pendingMu.Lock()
+	defer i.pendingMu.Unlock()
+
+	for _, pending := range i.pendingExpectations {
+		// Skip already matched expectations
+		pending.mu.Lock()
+		alreadyMatched := pending.Matched
+		pending.mu.Unlock()
+
+		if alreadyMatched {
+			continue
+		}
+
+		// Check method name first
+		if pending.MethodName != call.MethodName {
+			continue
+		}
+
+		// Check validator
+		if pending.Validator(call.Args) != nil {
+			continue
+		}
+
+		// Match found - set the response channel and args
+		pending.setMatched(call)
+
+		return true
+	}
+
+	return false
+}
+
+// registerPendingExpectation is RegisterPendingExpectation with a hook that receives
+// the matched call's args before the match is signalled.
+func (i *Imp) registerPendingExpectation(
+	methodName string,
+	validator func([]any) error,
+	onMatch func([]any) error,
 ) *PendingExpectation {
 	pending := &PendingExpectation{
 		MethodName:  methodName,
 		Validator:   validator,
+		onMatch:     onMatch,
 		done:        make(chan struct{}),
 		matchedChan: make(chan struct{}),
+		imp:         i,
 	}
 
 	i.pendingMu.Lock()
@@ -169,7 +313,7 @@ func (i *Imp) RegisterPendingExpectation(
 				i.callQueue[idx+1:]...,
 			)
 			i.mu.Unlock()
-			pending.setMatched(call.ResponseChan, call.Args)
+			pending.setMatched(call)
 
 			return pending
 		}
@@ -180,63 +324,49 @@ func (i *Imp) RegisterPendingExpectation(
 	return pending
 }
 
-// SetTimeout configures the timeout for all blocking operations.
-// A duration of 0 means no timeout (block forever).
-func (i *Imp) SetTimeout(d time.Duration) {
-	// TODO: implement timeout tracking
-	_ = d
-}
+// runningTargets lists target wrappers that have not returned or panicked,
+// dropping finished wrappers from tracking.
+func (i *Imp) runningTargets() []string {
+	i.targetsMu.Lock()
+	defer i.targetsMu.Unlock()
 
-// Wait blocks until all pending expectations are satisfied.
-// Call this after registering expectations with Eventually().
-func (i *Imp) Wait() {
-	i.pendingMu.Lock()
-	expectations := make([]*PendingExpectation, len(i.pendingExpectations))
-	copy(expectations, i.pendingExpectations)
-	i.pendingMu.Unlock()
+	var names []string
 
-	// Wait for each pending expectation to complete
-	for _, pe := range expectations {
-		<-pe.done
-	}
-}
+	running := i.targets[:0]
 
-// matchPendingExpectation checks if a call matches any pending expectation.
-// Returns true if matched (call was handled), false otherwise.
-func (i *Imp) matchPendingExpectation(call *GenericCall) bool {
-	i.pendingMu.Lock()
-	defer i.pendingMu.Unlock()
+	for _, target := range i.targets {
+		if target.running() {
+			running = append(running, target)
+			names = append(names, target.watchName())
+		}
+	}
 
-	for _, pending := range i.pendingExpectations {
-		// Skip already matched expectations
-		pending.mu.Lock()
-		alreadyMatched := pending.Matched
-		pending.mu.Unlock()
+	i.targets = running
 
-		if alreadyMatched {
-			continue
-		}
+	return names
+}
 
-		// Check method name first
-		if pending.MethodName != call.MethodName {
-			continue
-		}
+// trackTarget registers a target wrapper for watchdog reporting.
+func (i *Imp) trackTarget(target watchedTarget) {
+	i.targetsMu.Lock()
+	i.targets = append(i.targets, target)
+	i.targetsMu.Unlock()
+}
 
-		// Check validator
-		if pending.Validator(call.Args) != nil {
-			continue
-		}
+// unsatisfiedExpectations describes Eventually expectations that are not yet satisfied.
+func (i *Imp) unsatisfiedExpectations() []string {
+	i.pendingMu.Lock()
+	expectations := make([]*PendingExpectation, len(i.pendingExpectations))
+	copy(expectations, i.pendingExpectations)
+	i.pendingMu.Unlock()
 
-		// Match found - set the response channel and args
-		pending.setMatched(call.ResponseChan, call.Args)
+	var lines []string
 
-		return true
+	for _, pe := range expectations {
+		if desc := pe.describe(); desc != "" {
+			lines = append(lines, desc)
+		}
 	}
 
-	return false
-}
-
-// valuesEqual checks if two values are equal using reflect.DeepEqual.
-func valuesEqual(a, b any) bool {
-	return reflect.DeepEqual(a, b)
+	return lines
 }
@@ -12,6 +12,15 @@ var (
 	BeAny Matcher = anyMatcher{} //nolint:gochecknoglobals // BeAny is an exported sentinel value
 )
 
+// Committer is implemented by matchers with side effects, such as argument captors,
+// that must only take effect for the call that actually matched. Commit receives the
+// matched argument after every matcher for the call has succeeded. An error from Commit
+// fails the test; it may be called on a goroutine other than the test's, so it must
+// report failures by returning them, never by panicking.
+type Committer interface {
+	Commit(actual any) error
+}
+
 type Matcher interface {
 	Match(actual any) (success bool, err error)
 	FailureMessage(actual any) string
@@ -66,11 +75,62 @@ func (m *satisfyMatcher[T]) Match(actual any) (bool, error) {
 	return m.lastErr == nil, nil
 }
 
+// ValueType returns the type the predicate accepts.
+func (m *satisfyMatcher[T]) ValueType() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
 // MatchValue checks if actual matches expected.
 // If expected implements the Matcher interface, uses its Match method.
 // Otherwise, uses reflect.DeepEqual for comparison.
 // Returns (success, errorMessage). If success is true, errorMessage is empty.
+// A single value has nothing else left to match, so on success an expected Committer
+// is committed with actual; if that fails, the value is reported as not matching.
 func MatchValue(actual, expected any) (bool, string) {
+	ok, msg := matchValue(actual, expected)
+	if !ok {
+		return false, msg
+	}
+
+	err := commitValue(actual, expected)
+	if err != nil {
+		return false, err.Error()
+	}
+
+	return true, ""
+}
+
+// commitMatchers returns a hook that commits each Committer among matchers with the
+// corresponding argument of the matched call. It returns the first commit error.
+func commitMatchers(matchers []any) func(args []any) error {
+	return func(args []any) error {
+		for index, m := range matchers {
+			if index >= len(args) {
+				break
+			}
+
+			err := commitValue(args[index], m)
+			if err != nil {
+				return fmt.Errorf("arg %d: %w", index, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+// commitValue commits expected with actual if it is a Committer.
+func commitValue(actual, expected any) error {
+	if committer, ok := expected.(Committer); ok {
+		return committer.Commit(actual)
+	}
+
+	return nil
+}
+
+// matchValue is MatchValue without the commit, for values that are only one part of
+// a larger match, such as a single argument of a call.
+func matchValue(actual, expected any) (bool, string) {
 	// Check if expected is a Matcher
 	if matcher, ok := expected.(Matcher); ok {
 		success, err := matcher.Match(actual)
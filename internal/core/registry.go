@@ -34,8 +34,8 @@ func GetOrCreateImp(t TestReporter) *Imp {
 	return imp
 }
 
-// SetTimeout configures the timeout for all blocking operations in the test.
-// A duration of 0 means no timeout (block forever).
+// SetTimeout configures how long ordered expectations, Wait, and channel receives in
+// the test may block before failing it. A duration of 0 means no timeout (block forever).
 //
 // If no Imp has been created for t yet, one is created.
 func SetTimeout(t TestReporter, d time.Duration) {
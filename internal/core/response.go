@@ -0,0 +1,134 @@
+package core
+
+import (
+	"reflect"
+	"runtime"
+	"time"
+)
+
+// Exported constants.
+const (
+	// ResponseCloseChannels closes every channel previously returned by the test's
+	// mocks, then makes the mock return ReturnValues. The channels must be bidirectional.
+	ResponseCloseChannels ResponseKind = "close-channels"
+	// ResponseGoexit makes the mock call runtime.Goexit in the caller's goroutine.
+	ResponseGoexit ResponseKind = "goexit"
+	// ResponseHang makes the mock block until the test ends, simulating a hung dependency.
+	ResponseHang ResponseKind = "hang"
+	// ResponsePanic makes the mock panic with PanicValue.
+	ResponsePanic ResponseKind = "panic"
+	// ResponseReturn makes the mock return ReturnValues.
+	ResponseReturn ResponseKind = "return"
+	// ResponseReturnAfter makes the mock return ReturnValues once Delay has elapsed
+	// on the controller's Timer.
+	ResponseReturnAfter ResponseKind = "return-after"
+)
+
+type GenericResponse struct {
+	Type         ResponseKind
+	ReturnValues []any
+	PanicValue   any
+	Delay        time.Duration   // used by ResponseReturnAfter
+	testDone     <-chan struct{} // used by ResponseHang; closed when the test ends
+}
+
+// Resolve applies the response in the mock's goroutine: it panics, exits the
+// goroutine, or blocks, as the response kind requires. For kinds that return
+// values, Resolve does nothing and the caller returns ReturnValues.
+//
+// A hanging mock blocks until the test ends (forever, if the TestReporter has no
+// Cleanup), then exits its goroutine via runtime.Goexit so it doesn't outlive the test.
+func (r GenericResponse) Resolve() {
+	switch r.Type {
+	case ResponsePanic:
+		panic(r.PanicValue)
+	case ResponseGoexit:
+		runtime.Goexit()
+	case ResponseHang:
+		<-r.testDone
+		runtime.Goexit()
+	case ResponseReturn, ResponseReturnAfter, ResponseCloseChannels:
+		// Nothing to do - the mock returns ReturnValues.
+	}
+}
+
+// checkReturnedChannelsClosable fails the test if a channel previously returned by this
+// test's mocks is send- or receive-only, since reflect can only close bidirectional
+// channels. It must be called on the test goroutine.
+func (i *Imp) checkReturnedChannelsClosable() {
+	i.channelsMu.Lock()
+	defer i.channelsMu.Unlock()
+
+	for _, ch := range i.returnedChannels {
+		if ch.Type().ChanDir() != reflect.BothDir {
+			i.t.Fatalf("CloseReturnedChannels: cannot close a %s returned by a mock; "+
+				"return a chan %s, or close the channel in the test", ch.Type(), ch.Type().Elem())
+		}
+	}
+}
+
+// closeReturnedChannels closes every bidirectional channel previously returned by this
+// test's mocks. It may run on the dispatch goroutine, so it can't fail the test:
+// checkReturnedChannelsClosable has already rejected the channels it can't close.
+func (i *Imp) closeReturnedChannels() {
+	i.channelsMu.Lock()
+	channels := i.returnedChannels
+	i.returnedChannels = nil
+	i.channelsMu.Unlock()
+
+	closed := make(map[uintptr]bool, len(channels))
+
+	for _, ch := range channels {
+		if ch.Type().ChanDir() == reflect.BothDir && !closed[ch.Pointer()] {
+			closed[ch.Pointer()] = true
+
+			ch.Close()
+		}
+	}
+}
+
+// respond delivers resp to the mock call, applying the parts of the response kind
+// that happen on the test side (closing channels, delaying the response).
+func (i *Imp) respond(call *GenericCall, resp GenericResponse) {
+	if resp.Type == ResponseCloseChannels {
+		i.closeReturnedChannels()
+	}
+
+	if resp.Type == ResponseHang {
+		resp.testDone = i.testDone
+	}
+
+	i.trackReturnedChannels(resp.ReturnValues)
+	call.MarkDone()
+
+	if resp.Type == ResponseReturnAfter {
+		timer := i.Timer
+
+		go func() {
+			<-timer.After(resp.Delay)
+
+			call.ResponseChan <- resp
+		}()
+
+		return
+	}
+
+	call.ResponseChan <- resp
+}
+
+// trackReturnedChannels records channel return values so that a later
+// ResponseCloseChannels response can close them.
+func (i *Imp) trackReturnedChannels(values []any) {
+	for _, value := range values {
+		rv := reflect.ValueOf(value)
+		if rv.Kind() != reflect.Chan || rv.IsNil() {
+			continue
+		}
+
+		i.channelsMu.Lock()
+		i.returnedChannels = append(i.returnedChannels, rv)
+		i.channelsMu.Unlock()
+	}
+}
+
+type ResponseKind string
@@ -0,0 +1,132 @@
+package core
+
+import "reflect"
+
+// SetArgSnapshots configures whether mock call arguments are deep-copied at dispatch.
+func (i *Imp) SetArgSnapshots(enabled bool) {
+	i.snapshotArgs.Store(enabled)
+}
+
+// interceptCall runs for every mock call before it is matched or queued.
+// It snapshots the arguments if enabled, then offers the call to pending expectations.
+func (i *Imp) interceptCall(call *GenericCall) bool {
+	if i.snapshotArgs.Load() {
+		call.original = call.Args
+		call.Args = snapshotArgs(call.Args)
+	}
+
+	return i.matchPendingExpectation(call)
+}
+
+// SetArgSnapshots configures whether mock call arguments are deep-copied when the
+// call is dispatched. With snapshots enabled, ArgsEqual, ArgsShould, GetArgs, and
+// Eventually expectations see the arguments as they were at call time, even if the
+// code under test later reuses a buffer or mutates a slice, map, or pointed-to struct.
+//
+// Snapshots copy slices, maps, arrays, pointers, interfaces, and exported struct
+// fields. Unexported struct fields, funcs, and channels are shared with the caller.
+//
+// If no Imp has been created for t yet, one is created.
+func SetArgSnapshots(t TestReporter, enabled bool) {
+	GetOrCreateImp(t).SetArgSnapshots(enabled)
+}
+
+type pointerKey struct {
+	addr uintptr
+	typ  reflect.Type
+}
+
+// deepCopy returns a copy of value that shares no slices, maps, or pointed-to values
+// with the original. seen maps original pointers to their copies, so that cyclic
+// and shared structures are copied once.
+func deepCopy(value reflect.Value, seen map[pointerKey]reflect.Value) reflect.Value {
+	//nolint:exhaustive // every other kind is copied by value
+	switch value.Kind() {
+	case reflect.Pointer:
+		if value.IsNil() {
+			return value
+		}
+
+		key := pointerKey{addr: value.Pointer(), typ: value.Type()}
+		if copied, ok := seen[key]; ok {
+			return copied
+		}
+
+		copied := reflect.New(value.Type().Elem())
+		seen[key] = copied
+		copied.Elem().Set(deepCopy(value.Elem(), seen))
+
+		return copied
+	case reflect.Interface:
+		if value.IsNil() {
+			return value
+		}
+
+		copied := reflect.New(value.Type()).Elem()
+		copied.Set(deepCopy(value.Elem(), seen))
+
+		return copied
+	case reflect.Slice:
+		if value.IsNil() {
+			return value
+		}
+
+		copied := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+		for index := range value.Len() {
+			copied.Index(index).Set(deepCopy(value.Index(index), seen))
+		}
+
+		return copied
+	case reflect.Array:
+		copied := reflect.New(value.Type()).Elem()
+		for index := range value.Len() {
+			copied.Index(index).Set(deepCopy(value.Index(index), seen))
+		}
+
+		return copied
+	case reflect.Map:
+		if value.IsNil() {
+			return value
+		}
+
+		copied := reflect.MakeMapWithSize(value.Type(), value.Len())
+
+		iter := value.MapRange()
+		for iter.Next() {
+			copied.SetMapIndex(iter.Key(), deepCopy(iter.Value(), seen))
+		}
+
+		return copied
+	case reflect.Struct:
+		// Copy the whole struct first so unexported fields are carried over, then
+		// replace each exported field with its deep copy.
+		copied := reflect.New(value.Type()).Elem()
+		copied.Set(value)
+
+		for index := range value.NumField() {
+			if copied.Field(index).CanSet() {
+				copied.Field(index).Set(deepCopy(value.Field(index), seen))
+			}
+		}
+
+		return copied
+	default:
+		return value
+	}
+}
+
+// snapshotArgs deep-copies call arguments so later mutation by the caller is not observed.
+func snapshotArgs(args []any) []any {
+	seen := make(map[pointerKey]reflect.Value)
+	snapshot := make([]any, len(args))
+
+	for index, arg := range args {
+		if arg == nil {
+			continue
+		}
+
+		snapshot[index] = deepCopy(reflect.ValueOf(arg), seen).Interface()
+	}
+
+	return snapshot
+}
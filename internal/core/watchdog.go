@@ -0,0 +1,215 @@
+package core
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Exported constants.
+const (
+	// DefaultWatchdogThreshold is how long an imptest operation may block before
+	// the watchdog dumps the test's mock and wrapper state and fails the test.
+	DefaultWatchdogThreshold = time.Minute
+)
+
+// SetWatchdogThreshold configures how long blocking operations may wait before
+// the watchdog fails the test with a state dump. A duration of 0 disables the
+// watchdog entirely.
+//
+// If no Imp has been created for t yet, one is created.
+func SetWatchdogThreshold(t TestReporter, d time.Duration) {
+	GetOrCreateImp(t).SetWatchdogThreshold(d)
+}
+
+// unexported constants.
+const (
+	// watchdogDeadlineFraction caps the margin for short deadlines: the watchdog
+	// fires no earlier than the last 1/watchdogDeadlineFraction of the remaining time.
+	watchdogDeadlineFraction = 10
+	// watchdogDeadlineMargin is how long before the test's deadline the watchdog fires,
+	// so that the dump is printed before the global `go test -timeout` panic.
+	watchdogDeadlineMargin = 5 * time.Second
+)
+
+type deadliner interface {
+	Deadline() (deadline time.Time, ok bool)
+}
+
+type watchdog struct {
+	t     TestReporter
+	timer Timer
+
+	mu        sync.Mutex
+	threshold time.Duration
+	waits     []*watchdogWait
+	sections  []watchdogSection
+}
+
+// add registers a state section to include in the dump.
+func (w *watchdog) add(title string, lines func() []string) {
+	w.mu.Lock()
+	w.sections = append(w.sections, watchdogSection{title: title, lines: lines})
+	w.mu.Unlock()
+}
+
+// begin records that the caller is about to block on desc.
+// The returned function must be called when the caller unblocks.
+func (w *watchdog) begin(desc string) func() {
+	wait := &watchdogWait{desc: desc, since: time.Now()}
+
+	w.mu.Lock()
+	w.waits = append(w.waits, wait)
+	w.mu.Unlock()
+
+	return func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+
+		for i, other := range w.waits {
+			if other == wait {
+				w.waits = append(w.waits[:i], w.waits[i+1:]...)
+
+				return
+			}
+		}
+	}
+}
+
+// dump renders the current state of everything the watchdog knows about.
+func (w *watchdog) dump() string {
+	w.mu.Lock()
+	waits := make([]*watchdogWait, len(w.waits))
+	copy(waits, w.waits)
+	sections := make([]watchdogSection, len(w.sections))
+	copy(sections, w.sections)
+	w.mu.Unlock()
+
+	var builder strings.Builder
+
+	builder.WriteString("test goroutine is waiting on:\n")
+
+	if len(waits) == 0 {
+		builder.WriteString("  (unknown)\n")
+	}
+
+	for _, wait := range waits {
+		fmt.Fprintf(&builder, "  - %s (blocked %v)\n", wait.desc, time.Since(wait.since).Round(time.Millisecond))
+	}
+
+	for _, section := range sections {
+		lines := section.lines()
+		if len(lines) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(&builder, "%s:\n", section.title)
+
+		for _, line := range lines {
+			fmt.Fprintf(&builder, "  - %s\n", line)
+		}
+	}
+
+	return builder.String()
+}
+
+// expired returns a channel that fires when the current blocking operation has
+// waited too long. It returns nil (never fires) if the watchdog is disabled.
+//
+// The watchdog fires after the configured threshold, or shortly before the test's
+// deadline (see testing.T.Deadline), whichever comes first.
+func (w *watchdog) expired() <-chan time.Time {
+	w.mu.Lock()
+	wait := w.threshold
+	w.mu.Unlock()
+
+	if wait == 0 {
+		return nil
+	}
+
+	if dl, ok := w.t.(deadliner); ok {
+		if deadline, hasDeadline := dl.Deadline(); hasDeadline {
+			remaining := time.Until(deadline)
+			untilDump := remaining - min(watchdogDeadlineMargin, remaining/watchdogDeadlineFraction)
+
+			if untilDump < wait {
+				wait = max(untilDump, 0)
+			}
+		}
+	}
+
+	return w.timer.After(wait)
+}
+
+// fail fails the test with the state dump.
+func (w *watchdog) fail() {
+	w.t.Helper()
+	w.t.Fatalf("imptest watchdog: test blocked too long\n%s", w.dump())
+}
+
+// setThreshold sets the watchdog threshold. A duration of 0 disables the watchdog.
+func (w *watchdog) setThreshold(d time.Duration) {
+	w.mu.Lock()
+	w.threshold = d
+	w.mu.Unlock()
+}
+
+type watchdogSection struct {
+	title string
+	lines func() []string
+}
+
+type watchdogWait struct {
+	desc  string
+	since time.Time
+}
+
+type watchedTarget interface {
+	running() bool
+	watchName() string
+}
+
+// await receives from ch, failing the test with a watchdog dump if the watchdog fires first.
+func await[V any](w *watchdog, desc string, ch <-chan V) V {
+	w.t.Helper()
+
+	end := w.begin(desc)
+	defer end()
+
+	select {
+	case value := <-ch:
+		return value
+	case <-w.expired():
+		w.fail()
+
+		var zero V
+
+		return zero
+	}
+}
+
+// newWatchdog creates a watchdog with the default threshold.
+func newWatchdog(t TestReporter, timer Timer) *watchdog {
+	return &watchdog{
+		t:         t,
+		timer:     timer,
+		threshold: DefaultWatchdogThreshold,
+	}
+}
+
+// targetName derives a readable wrapper name from its generated returns type,
+// e.g. "StartAddReturnsReturn" -> "StartAdd".
+func targetName[T any]() string {
+	name := reflect.TypeFor[T]().Name()
+	name, _, _ = strings.Cut(name, "[")
+	name = strings.TrimSuffix(name, "Return")
+	name = strings.TrimSuffix(name, "Returns")
+
+	if name == "" {
+		return "target"
+	}
+
+	return name
+}
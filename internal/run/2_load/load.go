@@ -1,4 +1,4 @@
-// Package load provides package loading functionality using DST parsing.
+// Package load provides package loading functionality using DST parsing, with optional type checking.
 package load
 
 import (
@@ -6,12 +6,14 @@ import (
 	"fmt"
 	"go/build"
 	"go/token"
+	"go/types"
 	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/dave/dst"
 	"github.com/dave/dst/decorator"
+	"golang.org/x/tools/go/packages"
 )
 
 // PackageDST loads a package by import path and returns its DST files and FileSet.
@@ -113,6 +115,43 @@ func PackageDST(importPath string) ([]*dst.File, *token.FileSet, error) {
 	return allFiles, fset, nil
 }
 
+// PackageTypes loads and type-checks a package by import path, resolved from dir (the working directory if empty).
+// It is much slower than PackageDST, so callers use it only when syntax alone cannot resolve a symbol precisely.
+// Test files are not included.
+func PackageTypes(dir, importPath string) (*types.Package, error) {
+	pattern := importPath
+
+	// Local subdirectory packages shadow stdlib packages, as in PackageDST
+	if dir == "" {
+		if resolvedPath := ResolveLocalPackagePath(importPath); resolvedPath != importPath {
+			pattern = resolvedPath
+		}
+	}
+
+	// Dependencies are type-checked from source too, rather than read from export data, so the result does not
+	// depend on the export data format of the installed toolchain
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedTypes | packages.NeedSyntax | packages.NeedImports | packages.NeedDeps,
+		Dir:  dir,
+	}
+
+	pkgs, err := packages.Load(cfg, pattern)
+	if err != nil {
+		return nil, fmt.Errorf("failed to type-check package %q: %w", importPath, err)
+	}
+
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("%w: %q matched %d packages", errNoPackagesFound, importPath, len(pkgs))
+	}
+
+	pkg := pkgs[0]
+	if len(pkg.Errors) > 0 {
+		return nil, fmt.Errorf("failed to type-check package %q: %w", importPath, pkg.Errors[0])
+	}
+
+	return pkg.Types, nil
+}
+
 // ResolveLocalPackagePath checks if importPath refers to a local subdirectory package.
 // For simple package names (no slashes), it checks if there's a local subdirectory
 // with that name containing .go files. This handles cases where local packages
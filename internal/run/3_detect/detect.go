@@ -10,6 +10,7 @@ import (
 	"go/types"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 
@@ -37,6 +38,7 @@ type FuncTypeWithDetails struct {
 type IfaceWithDetails struct {
 	Iface         *dst.InterfaceType
 	TypeParams    *dst.FieldList
+	TypeArgs      []dst.Expr        // type arguments the interface was instantiated with, if any
 	SourceImports []*dst.ImportSpec // imports from the file containing the interface
 	IsStructType  bool              // true if this was synthesized from a struct type
 }
@@ -47,8 +49,12 @@ type PackageLoader interface {
 
 type StructWithDetails struct {
 	TypeParams    *dst.FieldList
+	TypeArgs      []dst.Expr        // type arguments the struct was instantiated with, if any
 	TypeName      string            // The name of the struct type (e.g., "Calculator")
 	SourceImports []*dst.ImportSpec // imports from the file containing the struct
+	// Methods is the full method set, promoted methods included, when resolved by the type checker.
+	// When nil, methods are collected from syntax with CollectStructMethods.
+	Methods map[string]*dst.FuncType
 }
 
 type SymbolDetails struct {
@@ -61,6 +67,15 @@ type SymbolDetails struct {
 	// PkgPath tracks which package the symbol was found in.
 	// For symbols found via dot imports, this differs from the search package.
 	PkgPath string
+	// FuncImports lists the imports needed by a FuncDecl or FuncType synthesized by the type checker, which has no file
+	// of its own.
+	FuncImports []*dst.ImportSpec
+}
+
+// TypesLoader is implemented by package loaders that can also type-check packages. It is optional: without it,
+// symbols are resolved from syntax alone.
+type TypesLoader interface {
+	LoadTypes(importPath string) (*types.Package, error)
 }
 
 // CollectStructMethods collects all methods for a given struct type from AST files.
@@ -73,6 +88,47 @@ func CollectStructMethods(
 	return collectStructMethodsRecursive(astFiles, fset, structName, visited)
 }
 
+// ExportedSymbols returns the sorted names of the exported interface types declared in astFiles. Type-constraint
+// interfaces (e.g. "interface{ ~int | ~float64 }" or "interface{ comparable }") cannot be mocked and are skipped;
+// types they embed from other packages are resolved with pkgLoader. When includeFuncTypes is true, exported function
+// types are included as well.
+func ExportedSymbols(astFiles []*dst.File, includeFuncTypes bool, pkgLoader PackageLoader) []string {
+	var names []string
+
+	checker := constraintChecker{pkgLoader: pkgLoader, decided: make(map[*dst.TypeSpec]bool)}
+
+	for _, file := range astFiles {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*dst.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				typeSpec, ok := spec.(*dst.TypeSpec)
+				if !ok || typeSpec.Assign || !dst.IsExported(typeSpec.Name.Name) {
+					continue
+				}
+
+				switch typ := typeSpec.Type.(type) {
+				case *dst.InterfaceType:
+					if !checker.isConstraintInterface(typ, file, astFiles) {
+						names = append(names, typeSpec.Name.Name)
+					}
+				case *dst.FuncType:
+					if includeFuncTypes {
+						names = append(names, typeSpec.Name.Name)
+					}
+				}
+			}
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
 // ExtractPackageName extracts the package name from a fully qualified name.
 func ExtractPackageName(qualifiedName string) string {
 	parts := strings.Split(qualifiedName, ".")
@@ -354,6 +410,108 @@ var (
 	}
 )
 
+// constraintChecker decides whether interfaces can only be used as type constraints, resolving the types they embed
+// by name.
+type constraintChecker struct {
+	pkgLoader PackageLoader
+	// decided memoizes the result for each embedded type declaration. An entry is false while it is being decided,
+	// which ends cyclic embedding.
+	decided map[*dst.TypeSpec]bool
+}
+
+// isConstraintEmbed reports whether the embedded element expr, found in file of the package made of pkgFiles, makes
+// its interface a constraint: a union or approximation, comparable, any type but an interface, or a constraint
+// interface does.
+//
+//nolint:cyclop // One case per kind of embedded element
+func (c constraintChecker) isConstraintEmbed(expr dst.Expr, file *dst.File, pkgFiles []*dst.File) bool {
+	switch embed := expr.(type) {
+	case *dst.BinaryExpr, *dst.UnaryExpr:
+		return true
+	case *dst.IndexExpr:
+		return c.isConstraintEmbed(embed.X, file, pkgFiles)
+	case *dst.IndexListExpr:
+		return c.isConstraintEmbed(embed.X, file, pkgFiles)
+	case *dst.Ident:
+		if spec, specFile := findTypeSpec(pkgFiles, embed.Name); spec != nil {
+			return c.isConstraintTypeSpec(spec, specFile, pkgFiles)
+		}
+
+		predeclared, ok := types.Universe.Lookup(embed.Name).(*types.TypeName)
+		if !ok {
+			return false
+		}
+
+		iface, isIface := predeclared.Type().Underlying().(*types.Interface)
+
+		return !isIface || !iface.IsMethodSet()
+	case *dst.SelectorExpr:
+		pkgName, ok := embed.X.(*dst.Ident)
+		if !ok || c.pkgLoader == nil {
+			return false
+		}
+
+		for _, imp := range file.Imports {
+			path, err := checkImport(imp, pkgName.Name, c.pkgLoader)
+			if err != nil {
+				continue
+			}
+
+			files, _, _, err := c.pkgLoader.Load(path)
+			if err != nil {
+				return false
+			}
+
+			spec, specFile := findTypeSpec(files, embed.Sel.Name)
+
+			return spec != nil && c.isConstraintTypeSpec(spec, specFile, files)
+		}
+	}
+
+	return false
+}
+
+// isConstraintInterface reports whether iface, declared in file of the package made of pkgFiles, can only be used as
+// a type constraint because of an element it embeds.
+func (c constraintChecker) isConstraintInterface(iface *dst.InterfaceType, file *dst.File, pkgFiles []*dst.File) bool {
+	if iface.Methods == nil {
+		return false
+	}
+
+	for _, field := range iface.Methods.List {
+		if len(field.Names) == 0 && c.isConstraintEmbed(field.Type, file, pkgFiles) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isConstraintTypeSpec reports whether embedding the type declared by spec makes an interface a constraint. A named
+// type is decided by the type it is defined from.
+func (c constraintChecker) isConstraintTypeSpec(spec *dst.TypeSpec, file *dst.File, pkgFiles []*dst.File) bool {
+	if result, ok := c.decided[spec]; ok {
+		return result
+	}
+
+	c.decided[spec] = false
+
+	var result bool
+
+	switch typ := spec.Type.(type) {
+	case *dst.InterfaceType:
+		result = c.isConstraintInterface(typ, file, pkgFiles)
+	case *dst.Ident, *dst.SelectorExpr, *dst.IndexExpr, *dst.IndexListExpr:
+		result = c.isConstraintEmbed(typ, file, pkgFiles)
+	default:
+		result = true
+	}
+
+	c.decided[spec] = result
+
+	return result
+}
+
 type symbolFinder func(
 	astFiles []*dst.File,
 	fset *token.FileSet,
@@ -708,6 +866,26 @@ func findStructTypeInAST(
 	}, nil
 }
 
+// findTypeSpec returns the declaration of the type named name in astFiles, and the file that declares it.
+func findTypeSpec(astFiles []*dst.File, name string) (*dst.TypeSpec, *dst.File) {
+	for _, file := range astFiles {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*dst.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				if typeSpec, ok := spec.(*dst.TypeSpec); ok && typeSpec.Name.Name == name {
+					return typeSpec, file
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
 // getDotImportPaths collects all dot-imported package paths from AST files.
 func getDotImportPaths(astFiles []*dst.File) []string {
 	var dotImports []string
@@ -723,3 +901,5 @@ func getDotImportPaths(astFiles []*dst.File) []string {
 
 	return dotImports
 }
+
+
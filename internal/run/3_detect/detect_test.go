@@ -2,13 +2,92 @@
 package detect_test
 
 import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
 
+	"github.com/dave/dst"
+	"github.com/dave/dst/decorator"
+
 	detect "github.com/toejough/imptest/internal/run/3_detect"
 )
 
+func TestExportedSymbols(t *testing.T) {
+	t.Parallel()
+
+	const src = `package ports
+
+import (
+	"io"
+	"time"
+)
+
+type Repo interface{ Get(id int) string }
+type Notifier interface{ Notify(msg string) }
+type internalStore interface{ Put() }
+type Number interface{ ~int | ~float64 }
+type Handler func(msg string) error
+type Alias = Repo
+type Config struct{}
+type Key interface{ comparable }
+type Celsius float64
+type Temperature interface{ Celsius }
+type KeyedRepo interface {
+	Key
+	Repo
+}
+type Span interface{ time.Duration }
+type ReadCloser interface {
+	Repo
+	io.Closer
+}
+type Failure interface{ error }
+`
+
+	loader := sourceLoader{
+		"io":   "package io\n\ntype Closer interface{ Close() error }\n",
+		"time": "package time\n\ntype Duration int64\n",
+	}
+
+	file, err := decorator.Parse(src)
+	if err != nil {
+		t.Fatalf("failed to parse source: %v", err)
+	}
+
+	tests := []struct {
+		name             string
+		includeFuncTypes bool
+		want             []string
+	}{
+		{
+			name: "interfaces only",
+			want: []string{"Failure", "Notifier", "ReadCloser", "Repo"},
+		},
+		{
+			name:             "with function types",
+			includeFuncTypes: true,
+			want:             []string{"Failure", "Handler", "Notifier", "ReadCloser", "Repo"},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := detect.ExportedSymbols([]*dst.File{file}, testCase.includeFuncTypes, loader)
+			if !slices.Equal(got, testCase.want) {
+				t.Errorf("ExportedSymbols() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestExtractPackageName(t *testing.T) {
 	t.Parallel()
 
@@ -56,6 +135,227 @@ func TestExtractPackageName(t *testing.T) {
 	}
 }
 
+func TestFindTypedSymbol(t *testing.T) {
+	t.Parallel()
+
+	const src = `package shapes
+
+type Base struct{}
+
+func (*Base) Close() error { return nil }
+
+type Conn struct{ *Base }
+
+func (Conn) Hits() int { return 0 }
+
+type Repo[T any] interface{ Load(id string) (T, error) }
+type Users = Repo[string]
+type Key interface{ ~string | ~int }
+type Cache[K Key, V any] interface{ Get(key K) (V, bool) }
+type User struct{}
+type Check func(id string) error
+type Mapper[T any] func(in T) T
+type Sum[K Key] func(values ...K) K
+
+func Map[T, U any](in []T, fn func(T) U) []U { return nil }
+`
+
+	fset := token.NewFileSet()
+
+	file, err := parser.ParseFile(fset, "shapes.go", src, 0)
+	if err != nil {
+		t.Fatalf("failed to parse source: %v", err)
+	}
+
+	pkg, err := (&types.Config{}).Check("example.com/shapes", fset, []*ast.File{file}, nil)
+	if err != nil {
+		t.Fatalf("failed to type-check source: %v", err)
+	}
+
+	t.Run("promoted methods", func(t *testing.T) {
+		t.Parallel()
+
+		details, err := detect.FindTypedSymbol(pkg, "Conn", nil, ".")
+		if err != nil {
+			t.Fatalf("FindTypedSymbol() error = %v", err)
+		}
+
+		methods := slices.Sorted(maps.Keys(details.StructType.Methods))
+		if details.Kind != detect.SymbolStructType || !slices.Equal(methods, []string{"Close", "Hits"}) {
+			t.Errorf("got kind %v with methods %v, want a struct with Close and Hits", details.Kind, methods)
+		}
+	})
+
+	t.Run("alias of an instantiated generic", func(t *testing.T) {
+		t.Parallel()
+
+		details, err := detect.FindTypedSymbol(pkg, "Users", nil, ".")
+		if err != nil {
+			t.Fatalf("FindTypedSymbol() error = %v", err)
+		}
+
+		load := details.Iface.Iface.Methods.List[0]
+		result, _ := load.Type.(*dst.FuncType).Results.List[0].Type.(*dst.Ident)
+
+		if details.Kind != detect.SymbolInterface || details.Iface.TypeParams != nil ||
+			result == nil || result.Name != "string" {
+			t.Errorf("got %+v, want a non-generic interface whose Load returns string", details.Iface)
+		}
+	})
+
+	t.Run("constraint interface in type parameters", func(t *testing.T) {
+		t.Parallel()
+
+		details, err := detect.FindTypedSymbol(pkg, "Cache", nil, ".")
+		if err != nil {
+			t.Fatalf("FindTypedSymbol() error = %v", err)
+		}
+
+		constraint, _ := details.Iface.TypeParams.List[0].Type.(*dst.Ident)
+		if constraint == nil || constraint.Name != "Key" {
+			t.Errorf("first type parameter constraint = %v, want Key", details.Iface.TypeParams.List[0].Type)
+		}
+	})
+
+	t.Run("constraint interface itself", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := detect.FindTypedSymbol(pkg, "Key", nil, ".")
+		if err == nil {
+			t.Error("FindTypedSymbol(Key) succeeded, want an error for a constraint interface")
+		}
+	})
+
+	t.Run("function type", func(t *testing.T) {
+		t.Parallel()
+
+		details, err := detect.FindTypedSymbol(pkg, "Check", nil, ".")
+		if err != nil {
+			t.Fatalf("FindTypedSymbol() error = %v", err)
+		}
+
+		if details.Kind != detect.SymbolFunctionType || details.FuncType.TypeName != "Check" ||
+			len(details.FuncType.FuncType.Params.List) != 1 {
+			t.Errorf("got kind %v with %+v, want the function type Check", details.Kind, details.FuncType)
+		}
+	})
+
+	t.Run("promoted method", func(t *testing.T) {
+		t.Parallel()
+
+		details, err := detect.FindTypedSymbol(pkg, "Conn.Close", nil, ".")
+		if err != nil {
+			t.Fatalf("FindTypedSymbol() error = %v", err)
+		}
+
+		if details.Kind != detect.SymbolFunction || details.FuncDecl.Name.Name != "Close" ||
+			len(details.FuncDecl.Type.Results.List) != 1 {
+			t.Errorf("got kind %v with %+v, want the method Close", details.Kind, details.FuncDecl)
+		}
+	})
+
+	t.Run("missing method", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := detect.FindTypedSymbol(pkg, "Conn.Open", nil, ".")
+		if err == nil {
+			t.Error("FindTypedSymbol(Conn.Open) succeeded, want an error")
+		}
+	})
+
+	t.Run("instantiated interface", func(t *testing.T) {
+		t.Parallel()
+
+		typeArgs := []types.Type{pkg.Scope().Lookup("User").Type()}
+
+		details, err := detect.FindTypedSymbol(pkg, "Repo", typeArgs, ".")
+		if err != nil {
+			t.Fatalf("FindTypedSymbol() error = %v", err)
+		}
+
+		load := details.Iface.Iface.Methods.List[0]
+		result, _ := load.Type.(*dst.FuncType).Results.List[0].Type.(*dst.Ident)
+		typeArg, _ := details.Iface.TypeArgs[0].(*dst.Ident)
+
+		if details.Iface.TypeParams != nil || result == nil || result.Name != "User" ||
+			typeArg == nil || typeArg.Name != "User" {
+			t.Errorf("got %+v, want Repo instantiated with User", details.Iface)
+		}
+	})
+
+	t.Run("instantiated function", func(t *testing.T) {
+		t.Parallel()
+
+		typeArgs := []types.Type{types.Typ[types.Int], types.Typ[types.String]}
+
+		details, err := detect.FindTypedSymbol(pkg, "Map", typeArgs, ".")
+		if err != nil {
+			t.Fatalf("FindTypedSymbol() error = %v", err)
+		}
+
+		funcType := details.FuncDecl.Type
+		result, _ := funcType.Results.List[0].Type.(*dst.ArrayType)
+
+		if details.Kind != detect.SymbolFunction || funcType.TypeParams != nil ||
+			result == nil || result.Elt.(*dst.Ident).Name != "string" {
+			t.Errorf("got %+v, want Map instantiated to return []string", funcType)
+		}
+	})
+
+	t.Run("instantiated function type", func(t *testing.T) {
+		t.Parallel()
+
+		details, err := detect.FindTypedSymbol(pkg, "Mapper", []types.Type{types.Typ[types.String]}, ".")
+		if err != nil {
+			t.Fatalf("FindTypedSymbol() error = %v", err)
+		}
+
+		funcType := details.FuncType.FuncType
+		param, _ := funcType.Params.List[0].Type.(*dst.Ident)
+
+		if details.Kind != detect.SymbolFunctionType || details.FuncType.TypeParams != nil ||
+			param == nil || param.Name != "string" {
+			t.Errorf("got %+v, want Mapper instantiated to take a string", details.FuncType)
+		}
+	})
+
+	t.Run("type argument violating its constraint", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := detect.FindTypedSymbol(pkg, "Sum", []types.Type{types.Typ[types.Bool]}, ".")
+		if err == nil {
+			t.Error("FindTypedSymbol(Sum[bool]) succeeded, want an error for bool not satisfying Key")
+		}
+	})
+
+	t.Run("wrong number of type arguments", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := detect.FindTypedSymbol(pkg, "Cache", []types.Type{types.Typ[types.String]}, ".")
+		if err == nil {
+			t.Error("FindTypedSymbol(Cache[string]) succeeded, want an error")
+		}
+	})
+
+	t.Run("type arguments for a non-generic symbol", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := detect.FindTypedSymbol(pkg, "Conn", []types.Type{types.Typ[types.String]}, ".")
+		if err == nil {
+			t.Error("FindTypedSymbol(Conn[string]) succeeded, want an error")
+		}
+	})
+
+	t.Run("missing symbol", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := detect.FindTypedSymbol(pkg, "Missing", nil, ".")
+		if err == nil {
+			t.Error("FindTypedSymbol(Missing) succeeded, want an error")
+		}
+	})
+}
+
 func TestInferImportPathFromTestFile(t *testing.T) {
 	t.Parallel()
 
@@ -146,3 +446,175 @@ import (
 		})
 	}
 }
+
+func TestNeedsTypeCheck(t *testing.T) {
+	t.Parallel()
+
+	const src = `package shapes
+
+import (
+	"io"
+
+	"example.com/base"
+)
+
+type Plain interface{ Close() error }
+type Reader interface{ io.Reader }
+type Nested interface{ Reader }
+type Audited interface{ base.Audited }
+type NestedAudited interface{ Audited }
+type Missing interface{ gone.Thing }
+type Local struct{ inner }
+type inner struct{}
+type Promoted struct{ *base.Conn }
+`
+
+	file, err := decorator.Parse(src)
+	if err != nil {
+		t.Fatalf("failed to parse source: %v", err)
+	}
+
+	astFiles := []*dst.File{file}
+	loader := sourceLoader{
+		"io": "package io\n\ntype Reader interface{ Read(p []byte) (int, error) }\n",
+		"example.com/base": "package base\n\nimport \"example.com/audit\"\n\n" +
+			"type Conn struct{}\ntype Trail interface{ audit.Trail }\ntype Audited interface{ Trail }\n",
+	}
+
+	tests := []struct {
+		symbol string
+		want   bool
+	}{
+		{symbol: "Plain", want: false},
+		{symbol: "Reader", want: false},
+		{symbol: "Nested", want: false},
+		{symbol: "Audited", want: true},
+		{symbol: "NestedAudited", want: true},
+		{symbol: "Missing", want: true},
+		{symbol: "Local", want: false},
+		{symbol: "Promoted", want: true},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.symbol, func(t *testing.T) {
+			t.Parallel()
+
+			details, err := detect.FindSymbol(astFiles, nil, testCase.symbol, ".", nil)
+			if err != nil {
+				t.Fatalf("FindSymbol() error = %v", err)
+			}
+
+			if got := detect.NeedsTypeCheck(astFiles, details, loader); got != testCase.want {
+				t.Errorf("NeedsTypeCheck(%s) = %v, want %v", testCase.symbol, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestResolveTypeArgs(t *testing.T) {
+	t.Parallel()
+
+	const (
+		modelSrc = `package model
+
+type User struct{}
+
+type Page[T any] struct{ Items []T }
+`
+		storeSrc = `package store
+
+type Widget struct{}
+`
+	)
+
+	check := func(path, src string) *types.Package {
+		fset := token.NewFileSet()
+
+		file, err := parser.ParseFile(fset, path+".go", src, 0)
+		if err != nil {
+			t.Fatalf("failed to parse source: %v", err)
+		}
+
+		pkg, err := (&types.Config{}).Check(path, fset, []*ast.File{file}, nil)
+		if err != nil {
+			t.Fatalf("failed to type-check source: %v", err)
+		}
+
+		return pkg
+	}
+
+	model := check("example.com/model", modelSrc)
+	store := check("example.com/store", storeSrc)
+
+	lookupPackage := func(name string) (*types.Package, error) {
+		if name == "model" {
+			return model, nil
+		}
+
+		return nil, os.ErrNotExist
+	}
+
+	tests := []struct {
+		typeArgs string
+		want     []string
+		wantErr  bool
+	}{
+		{typeArgs: "int,string", want: []string{"int", "string"}},
+		{typeArgs: "model.User", want: []string{"example.com/model.User"}},
+		{typeArgs: "Widget", want: []string{"example.com/store.Widget"}},
+		{
+			typeArgs: "*model.User, []byte, map[string]error",
+			want:     []string{"*example.com/model.User", "[]byte", "map[string]error"},
+		},
+		{typeArgs: "model.Page[model.User]", want: []string{"example.com/model.Page[example.com/model.User]"}},
+		{typeArgs: "<-chan [4]int, any", want: []string{"<-chan [4]int", "any"}},
+		{typeArgs: "Missing", wantErr: true},
+		{typeArgs: "other.User", wantErr: true},
+		{typeArgs: "func()", wantErr: true},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.typeArgs, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := detect.ResolveTypeArgs(testCase.typeArgs, store, lookupPackage)
+			if testCase.wantErr {
+				if err == nil {
+					t.Errorf("ResolveTypeArgs(%q) = %v, want an error", testCase.typeArgs, got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ResolveTypeArgs(%q) error = %v", testCase.typeArgs, err)
+			}
+
+			gotStrings := make([]string, 0, len(got))
+			for _, typ := range got {
+				gotStrings = append(gotStrings, typ.String())
+			}
+
+			if !slices.Equal(gotStrings, testCase.want) {
+				t.Errorf("ResolveTypeArgs(%q) = %v, want %v", testCase.typeArgs, gotStrings, testCase.want)
+			}
+		})
+	}
+}
+
+// sourceLoader is a PackageLoader that parses package sources keyed by import path.
+type sourceLoader map[string]string
+
+func (l sourceLoader) Load(importPath string) ([]*dst.File, *token.FileSet, *types.Info, error) {
+	src, ok := l[importPath]
+	if !ok {
+		return nil, nil, nil, os.ErrNotExist
+	}
+
+	file, err := decorator.Parse(src)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return []*dst.File{file}, token.NewFileSet(), nil, nil
+}
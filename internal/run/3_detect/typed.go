@@ -0,0 +1,869 @@
+package detect
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dave/dst"
+)
+
+// FindTypedSymbol looks up a type, function, or Type.Method in a type-checked package and synthesizes its details
+// from go/types instead of syntax. Interfaces come back flattened to their full method set, with embedded interfaces
+// from any package expanded; other named types (structs included) carry their full method set, promoted methods
+// included. Aliases, generic aliases included, resolve to the type they stand for. Every type outside pkg is
+// qualified, and SourceImports (FuncImports for functions, methods, and function types) lists the imports those
+// qualifiers need.
+//
+// With typeArgs, a generic symbol is instantiated: its type parameters are substituted throughout, and the details
+// describe a non-generic symbol.
+func FindTypedSymbol(
+	pkg *types.Package, symbolName string, typeArgs []types.Type, pkgImportPath string,
+) (SymbolDetails, error) {
+	builder := newTypeExprBuilder(pkg)
+	details := SymbolDetails{PkgPath: pkgImportPath}
+
+	if typeName, methodName, isMethod := strings.Cut(symbolName, "."); isMethod {
+		return typedMethodDetails(builder, details, pkg, typeName, methodName, typeArgs)
+	}
+
+	switch obj := pkg.Scope().Lookup(symbolName).(type) {
+	case *types.Func:
+		signature, _ := obj.Type().(*types.Signature)
+
+		instance, typeParams, err := instantiate(signature, signature.TypeParams(), typeArgs)
+		if err != nil {
+			return SymbolDetails{}, fmt.Errorf("%s: %w", symbolName, err)
+		}
+
+		instanceSignature, _ := instance.(*types.Signature)
+		funcType := builder.funcType(instanceSignature)
+		funcType.TypeParams = builder.typeParams(typeParams)
+
+		details.Kind = SymbolFunction
+		details.FuncDecl = &dst.FuncDecl{Name: dst.NewIdent(symbolName), Type: funcType}
+		details.FuncImports = builder.importSpecs()
+
+		return details, nil
+	case *types.TypeName:
+		return typedTypeDetails(builder, details, obj, typeArgs)
+	}
+
+	return SymbolDetails{}, fmt.Errorf("%w: %s in package %s", errTypeNotFound, symbolName, pkg.Path())
+}
+
+// NeedsTypeCheck reports whether syntax alone may have resolved the symbol's method set imprecisely. Syntax expands
+// interfaces embedded from another package, but not the interfaces those embed from yet other packages, whose names it
+// cannot qualify; and it cannot see the methods a struct promotes from another package's types. Everything else,
+// including interfaces that embed io.Reader and the like, stays on the fast syntax path.
+func NeedsTypeCheck(astFiles []*dst.File, symbol SymbolDetails, pkgLoader PackageLoader) bool {
+	visited := make(map[string]bool)
+
+	switch symbol.Kind {
+	case SymbolInterface:
+		return symbol.Iface.Iface != nil &&
+			embedsAcrossPackages(astFiles, symbol.Iface.Iface.Methods, pkgLoader, visited)
+	case SymbolStructType:
+		if symbol.StructType.Methods != nil {
+			return false
+		}
+
+		return embedsQualified(astFiles, embeddableFields(astFiles, symbol.StructType.TypeName), visited)
+	case SymbolFunction, SymbolFunctionType:
+	}
+
+	return false
+}
+
+// ResolveTypeArgs parses a comma-separated list of type arguments, as written between the brackets of a symbol like
+// "Repo[model.User]", and resolves them. Unqualified names are predeclared or declared in pkg; qualified names are
+// looked up in the package lookupPackage returns for their qualifier.
+func ResolveTypeArgs(
+	typeArgs string, pkg *types.Package, lookupPackage func(name string) (*types.Package, error),
+) ([]types.Type, error) {
+	expr, err := parser.ParseExpr("_[" + typeArgs + "]")
+	if err != nil {
+		return nil, fmt.Errorf("%w: [%s]: %w", errInvalidTypeArgs, typeArgs, err)
+	}
+
+	var indices []ast.Expr
+
+	switch index := expr.(type) {
+	case *ast.IndexExpr:
+		indices = []ast.Expr{index.Index}
+	case *ast.IndexListExpr:
+		indices = index.Indices
+	default:
+		return nil, fmt.Errorf("%w: [%s]", errInvalidTypeArgs, typeArgs)
+	}
+
+	resolver := typeArgResolver{pkg: pkg, lookupPackage: lookupPackage}
+	resolved := make([]types.Type, 0, len(indices))
+
+	for _, index := range indices {
+		typ, err := resolver.resolve(index)
+		if err != nil {
+			return nil, err
+		}
+
+		resolved = append(resolved, typ)
+	}
+
+	return resolved, nil
+}
+
+// unexported variables.
+var (
+	errConstraintInterface = errors.New("constraint interfaces have no method set to mock")
+	errInvalidTypeArgs     = errors.New("invalid type arguments")
+	errMethodNotFound      = errors.New("method not found")
+	errNotGeneric          = errors.New("type arguments given for a symbol that is not generic")
+	errTypeArgCount        = errors.New("wrong number of type arguments")
+	errTypeNotFound        = errors.New("type not found")
+)
+
+// typeArgResolver resolves type argument expressions to types.
+type typeArgResolver struct {
+	pkg           *types.Package
+	lookupPackage func(name string) (*types.Package, error)
+}
+
+// lookup returns the type named name in the first scope that declares it.
+func (r typeArgResolver) lookup(scope, fallback *types.Scope, name string) (types.Type, error) {
+	typeName, ok := scope.Lookup(name).(*types.TypeName)
+	if !ok && fallback != nil {
+		typeName, ok = fallback.Lookup(name).(*types.TypeName)
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errTypeNotFound, name)
+	}
+
+	return typeName.Type(), nil
+}
+
+// resolve returns the type expr denotes.
+//
+//nolint:cyclop // One case per kind of type expression
+func (r typeArgResolver) resolve(expr ast.Expr) (types.Type, error) {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		return r.lookup(types.Universe, r.pkg.Scope(), expr.Name)
+	case *ast.SelectorExpr:
+		qualifier, ok := expr.X.(*ast.Ident)
+		if !ok {
+			break
+		}
+
+		pkg, err := r.lookupPackage(qualifier.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		return r.lookup(pkg.Scope(), nil, expr.Sel.Name)
+	case *ast.StarExpr:
+		elem, err := r.resolve(expr.X)
+		if err != nil {
+			return nil, err
+		}
+
+		return types.NewPointer(elem), nil
+	case *ast.ArrayType:
+		return r.resolveArray(expr)
+	case *ast.MapType:
+		key, err := r.resolve(expr.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := r.resolve(expr.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		return types.NewMap(key, value), nil
+	case *ast.ChanType:
+		elem, err := r.resolve(expr.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		dir := types.SendRecv
+
+		switch expr.Dir {
+		case ast.SEND:
+			dir = types.SendOnly
+		case ast.RECV:
+			dir = types.RecvOnly
+		}
+
+		return types.NewChan(dir, elem), nil
+	case *ast.IndexExpr:
+		return r.resolveInstance(expr.X, []ast.Expr{expr.Index})
+	case *ast.IndexListExpr:
+		return r.resolveInstance(expr.X, expr.Indices)
+	case *ast.InterfaceType:
+		if expr.Methods == nil || len(expr.Methods.List) == 0 {
+			return types.NewInterfaceType(nil, nil), nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: unsupported type argument %s", errInvalidTypeArgs, types.ExprString(expr))
+}
+
+// resolveArray returns the slice or array type expr denotes.
+func (r typeArgResolver) resolveArray(expr *ast.ArrayType) (types.Type, error) {
+	elem, err := r.resolve(expr.Elt)
+	if err != nil {
+		return nil, err
+	}
+
+	if expr.Len == nil {
+		return types.NewSlice(elem), nil
+	}
+
+	length, ok := expr.Len.(*ast.BasicLit)
+	if !ok || length.Kind != token.INT {
+		return nil, fmt.Errorf("%w: array length must be a literal: %s", errInvalidTypeArgs, types.ExprString(expr))
+	}
+
+	size, err := strconv.ParseInt(length.Value, 0, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidTypeArgs, err)
+	}
+
+	return types.NewArray(elem, size), nil
+}
+
+// resolveInstance returns the instantiation of the generic type generic with the type arguments args.
+func (r typeArgResolver) resolveInstance(generic ast.Expr, args []ast.Expr) (types.Type, error) {
+	orig, err := r.resolve(generic)
+	if err != nil {
+		return nil, err
+	}
+
+	typeArgs := make([]types.Type, 0, len(args))
+
+	for _, arg := range args {
+		typ, err := r.resolve(arg)
+		if err != nil {
+			return nil, err
+		}
+
+		typeArgs = append(typeArgs, typ)
+	}
+
+	var typeParams *types.TypeParamList
+
+	switch orig := orig.(type) {
+	case *types.Alias:
+		typeParams = orig.TypeParams()
+	case *types.Named:
+		typeParams = orig.TypeParams()
+	}
+
+	instance, _, err := instantiate(orig, typeParams, typeArgs)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", types.ExprString(generic), err)
+	}
+
+	return instance, nil
+}
+
+// typeExprBuilder converts go/types types into DST type expressions, as they would be written in the source package,
+// recording the imports the expressions need.
+type typeExprBuilder struct {
+	pkg *types.Package
+	// imports maps import paths to the name their package is referred to by.
+	imports map[string]string
+	// names maps the names in use to the import path they refer to.
+	names map[string]string
+}
+
+// expr returns the DST expression for typ.
+//
+//nolint:cyclop,funlen // One case per kind of type
+func (b *typeExprBuilder) expr(typ types.Type) dst.Expr {
+	switch typ := typ.(type) {
+	case *types.Basic:
+		return dst.NewIdent(typ.Name())
+	case *types.Alias:
+		return b.typeName(typ.Obj(), typ.TypeArgs())
+	case *types.Named:
+		return b.typeName(typ.Obj(), typ.TypeArgs())
+	case *types.TypeParam:
+		return dst.NewIdent(typ.Obj().Name())
+	case *types.Pointer:
+		return &dst.StarExpr{X: b.expr(typ.Elem())}
+	case *types.Slice:
+		return &dst.ArrayType{Elt: b.expr(typ.Elem())}
+	case *types.Array:
+		return &dst.ArrayType{
+			Len: &dst.BasicLit{Kind: token.INT, Value: strconv.FormatInt(typ.Len(), 10)},
+			Elt: b.expr(typ.Elem()),
+		}
+	case *types.Map:
+		return &dst.MapType{Key: b.expr(typ.Key()), Value: b.expr(typ.Elem())}
+	case *types.Chan:
+		dir := dst.SEND | dst.RECV
+
+		switch typ.Dir() {
+		case types.SendOnly:
+			dir = dst.SEND
+		case types.RecvOnly:
+			dir = dst.RECV
+		case types.SendRecv:
+		}
+
+		return &dst.ChanType{Dir: dir, Value: b.expr(typ.Elem())}
+	case *types.Signature:
+		return b.funcType(typ)
+	case *types.Struct:
+		fields := &dst.FieldList{}
+
+		for i := range typ.NumFields() {
+			field := typ.Field(i)
+			entry := &dst.Field{Type: b.expr(field.Type())}
+
+			if !field.Embedded() {
+				entry.Names = []*dst.Ident{dst.NewIdent(field.Name())}
+			}
+
+			if tag := typ.Tag(i); tag != "" {
+				entry.Tag = &dst.BasicLit{Kind: token.STRING, Value: strconv.Quote(tag)}
+			}
+
+			fields.List = append(fields.List, entry)
+		}
+
+		return &dst.StructType{Fields: fields}
+	case *types.Interface:
+		// Implicit interfaces are constraints written without "interface{...}", e.g. [T ~int | ~string]
+		if typ.IsImplicit() && typ.NumEmbeddeds() == 1 {
+			return b.expr(typ.EmbeddedType(0))
+		}
+
+		methods := &dst.FieldList{}
+
+		for i := range typ.NumEmbeddeds() {
+			methods.List = append(methods.List, &dst.Field{Type: b.expr(typ.EmbeddedType(i))})
+		}
+
+		for i := range typ.NumExplicitMethods() {
+			method := typ.ExplicitMethod(i)
+			signature, _ := method.Type().(*types.Signature)
+			methods.List = append(methods.List, &dst.Field{
+				Names: []*dst.Ident{dst.NewIdent(method.Name())},
+				Type:  b.funcType(signature),
+			})
+		}
+
+		return &dst.InterfaceType{Methods: methods}
+	case *types.Union:
+		var union dst.Expr
+
+		for i := range typ.Len() {
+			term := typ.Term(i)
+			termExpr := b.expr(term.Type())
+
+			if term.Tilde() {
+				termExpr = &dst.UnaryExpr{Op: token.TILDE, X: termExpr}
+			}
+
+			if union == nil {
+				union = termExpr
+			} else {
+				union = &dst.BinaryExpr{X: union, Op: token.OR, Y: termExpr}
+			}
+		}
+
+		return union
+	}
+
+	return dst.NewIdent(typ.String())
+}
+
+// funcType returns the DST function type for a signature, dropping its receiver.
+func (b *typeExprBuilder) funcType(signature *types.Signature) *dst.FuncType {
+	params := b.tupleFields(signature.Params())
+
+	if signature.Variadic() && len(params.List) > 0 {
+		last := params.List[len(params.List)-1]
+		if slice, ok := last.Type.(*dst.ArrayType); ok {
+			last.Type = &dst.Ellipsis{Elt: slice.Elt}
+		}
+	}
+
+	funcType := &dst.FuncType{Params: params}
+
+	if signature.Results().Len() > 0 {
+		funcType.Results = b.tupleFields(signature.Results())
+	}
+
+	return funcType
+}
+
+// importSpecs returns import specs for every package the built expressions refer to, sorted by path.
+func (b *typeExprBuilder) importSpecs() []*dst.ImportSpec {
+	paths := make([]string, 0, len(b.imports))
+	for importPath := range b.imports {
+		paths = append(paths, importPath)
+	}
+
+	sort.Strings(paths)
+
+	specs := make([]*dst.ImportSpec, 0, len(paths))
+
+	for _, importPath := range paths {
+		spec := &dst.ImportSpec{Path: &dst.BasicLit{Kind: token.STRING, Value: strconv.Quote(importPath)}}
+
+		// Name the import whenever its last path element is not the name it is referred to by
+		if name := b.imports[importPath]; name != path.Base(importPath) {
+			spec.Name = dst.NewIdent(name)
+		}
+
+		specs = append(specs, spec)
+	}
+
+	return specs
+}
+
+// methodFields returns interface method fields for methods, skipping unexported methods from other packages, which
+// cannot be implemented outside them.
+func (b *typeExprBuilder) methodFields(pkg *types.Package, methods []*types.Func) *dst.FieldList {
+	fields := &dst.FieldList{List: make([]*dst.Field, 0, len(methods))}
+
+	for _, method := range methods {
+		if !method.Exported() && method.Pkg() != pkg {
+			continue
+		}
+
+		signature, _ := method.Type().(*types.Signature)
+		fields.List = append(fields.List, &dst.Field{
+			Names: []*dst.Ident{dst.NewIdent(method.Name())},
+			Type:  b.funcType(signature),
+		})
+	}
+
+	return fields
+}
+
+// qualifier returns the name to refer to pkg by, registering its import on first use. A package whose name is
+// already taken by another import is given a numbered name.
+func (b *typeExprBuilder) qualifier(pkg *types.Package) string {
+	if name, ok := b.imports[pkg.Path()]; ok {
+		return name
+	}
+
+	name := pkg.Name()
+	for suffix := 2; b.names[name] != ""; suffix++ {
+		name = pkg.Name() + strconv.Itoa(suffix)
+	}
+
+	b.imports[pkg.Path()] = name
+	b.names[name] = pkg.Path()
+
+	return name
+}
+
+// tupleFields returns a field list for a parameter or result tuple, keeping the names it declares.
+func (b *typeExprBuilder) tupleFields(tuple *types.Tuple) *dst.FieldList {
+	fields := &dst.FieldList{List: make([]*dst.Field, 0, tuple.Len())}
+
+	for i := range tuple.Len() {
+		variable := tuple.At(i)
+		field := &dst.Field{Type: b.expr(variable.Type())}
+
+		if variable.Name() != "" {
+			field.Names = []*dst.Ident{dst.NewIdent(variable.Name())}
+		}
+
+		fields.List = append(fields.List, field)
+	}
+
+	// A tuple either names all of its entries or none of them
+	for _, field := range fields.List {
+		if len(field.Names) == 0 {
+			for _, unnamed := range fields.List {
+				unnamed.Names = nil
+			}
+
+			break
+		}
+	}
+
+	return fields
+}
+
+// typeArgs returns the expressions for the type arguments of an instantiated symbol, or nil if there are none.
+func (b *typeExprBuilder) typeArgs(typeArgs []types.Type) []dst.Expr {
+	if len(typeArgs) == 0 {
+		return nil
+	}
+
+	exprs := make([]dst.Expr, 0, len(typeArgs))
+	for _, typeArg := range typeArgs {
+		exprs = append(exprs, b.expr(typeArg))
+	}
+
+	return exprs
+}
+
+// typeName returns the expression for a named type or alias, qualified unless it belongs to the source package,
+// with its type arguments, if any.
+func (b *typeExprBuilder) typeName(obj *types.TypeName, typeArgs *types.TypeList) dst.Expr {
+	var expr dst.Expr = dst.NewIdent(obj.Name())
+
+	if obj.Pkg() != nil && obj.Pkg() != b.pkg {
+		expr = &dst.SelectorExpr{X: dst.NewIdent(b.qualifier(obj.Pkg())), Sel: dst.NewIdent(obj.Name())}
+	}
+
+	if typeArgs == nil || typeArgs.Len() == 0 {
+		return expr
+	}
+
+	indices := make([]dst.Expr, 0, typeArgs.Len())
+	for i := range typeArgs.Len() {
+		indices = append(indices, b.expr(typeArgs.At(i)))
+	}
+
+	if len(indices) == 1 {
+		return &dst.IndexExpr{X: expr, Index: indices[0]}
+	}
+
+	return &dst.IndexListExpr{X: expr, Indices: indices}
+}
+
+// typeParams returns the type parameter list for params, or nil if there are none.
+func (b *typeExprBuilder) typeParams(params *types.TypeParamList) *dst.FieldList {
+	if params == nil || params.Len() == 0 {
+		return nil
+	}
+
+	fields := &dst.FieldList{List: make([]*dst.Field, 0, params.Len())}
+
+	for i := range params.Len() {
+		param := params.At(i)
+		fields.List = append(fields.List, &dst.Field{
+			Names: []*dst.Ident{dst.NewIdent(param.Obj().Name())},
+			Type:  b.expr(param.Constraint()),
+		})
+	}
+
+	return fields
+}
+
+// declaredMethods returns the full method set of iface in declaration order, as syntax would expand it: each embedded
+// interface's methods in place, before the interface's own methods.
+func declaredMethods(iface *types.Interface) []*types.Func {
+	methods := make([]*types.Func, 0, iface.NumMethods())
+	seen := make(map[string]bool, iface.NumMethods())
+
+	var walk func(iface *types.Interface)
+
+	walk = func(iface *types.Interface) {
+		for i := range iface.NumEmbeddeds() {
+			if embedded, ok := iface.EmbeddedType(i).Underlying().(*types.Interface); ok {
+				walk(embedded)
+			}
+		}
+
+		for i := range iface.NumExplicitMethods() {
+			if method := iface.ExplicitMethod(i); !seen[method.Name()] {
+				seen[method.Name()] = true
+				methods = append(methods, method)
+			}
+		}
+	}
+
+	walk(iface)
+
+	return methods
+}
+
+// embeddableFields returns the fields (or interface elements) of the local struct or interface type named name, or
+// nil if there is none.
+func embeddableFields(astFiles []*dst.File, name string) *dst.FieldList {
+	for _, file := range astFiles {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*dst.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				typeSpec, ok := spec.(*dst.TypeSpec)
+				if !ok || typeSpec.Name.Name != name {
+					continue
+				}
+
+				switch typ := typeSpec.Type.(type) {
+				case *dst.InterfaceType:
+					return typ.Methods
+				case *dst.StructType:
+					return typ.Fields
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// embeddedType returns the type named by an embedded field, without any pointer or type arguments.
+func embeddedType(field *dst.Field) dst.Expr {
+	embedded := field.Type
+	if star, ok := embedded.(*dst.StarExpr); ok {
+		embedded = star.X
+	}
+
+	switch generic := embedded.(type) {
+	case *dst.IndexExpr:
+		return generic.X
+	case *dst.IndexListExpr:
+		return generic.X
+	}
+
+	return embedded
+}
+
+// embedsAcrossPackages reports whether fields embed, directly or through the local types they embed, an interface from
+// another package that itself embeds a type from a further package. Packages are read with pkgLoader, as syntax; one
+// that cannot be found or read is assumed to need the type checker.
+func embedsAcrossPackages(
+	astFiles []*dst.File, fields *dst.FieldList, pkgLoader PackageLoader, visited map[string]bool,
+) bool {
+	if fields == nil {
+		return false
+	}
+
+	for _, field := range fields.List {
+		if len(field.Names) > 0 {
+			continue
+		}
+
+		switch embedded := embeddedType(field).(type) {
+		case *dst.SelectorExpr:
+			files, ok := importedFiles(astFiles, embedded.X, pkgLoader)
+			if !ok || embedsQualified(files, embeddableFields(files, embedded.Sel.Name), make(map[string]bool)) {
+				return true
+			}
+		case *dst.Ident:
+			if visited[embedded.Name] {
+				continue
+			}
+
+			visited[embedded.Name] = true
+
+			if embedsAcrossPackages(astFiles, embeddableFields(astFiles, embedded.Name), pkgLoader, visited) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// embedsQualified reports whether fields embed a type from another package, directly or through the local types
+// they embed.
+func embedsQualified(astFiles []*dst.File, fields *dst.FieldList, visited map[string]bool) bool {
+	if fields == nil {
+		return false
+	}
+
+	for _, field := range fields.List {
+		if len(field.Names) > 0 {
+			continue
+		}
+
+		switch embedded := embeddedType(field).(type) {
+		case *dst.SelectorExpr:
+			return true
+		case *dst.Ident:
+			if visited[embedded.Name] {
+				continue
+			}
+
+			visited[embedded.Name] = true
+
+			if embedsQualified(astFiles, embeddableFields(astFiles, embedded.Name), visited) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// importedFiles loads the syntax of the package that qualifier names in astFiles' imports.
+func importedFiles(astFiles []*dst.File, qualifier dst.Expr, pkgLoader PackageLoader) ([]*dst.File, bool) {
+	ident, ok := qualifier.(*dst.Ident)
+	if !ok || pkgLoader == nil {
+		return nil, false
+	}
+
+	for _, file := range astFiles {
+		for _, spec := range file.Imports {
+			path, err := checkImport(spec, ident.Name, pkgLoader)
+			if err != nil {
+				continue
+			}
+
+			files, _, _, err := pkgLoader.Load(path)
+
+			return files, err == nil && len(files) > 0
+		}
+	}
+
+	return nil, false
+}
+
+// instantiate substitutes typeArgs for typeParams in orig, a generic type or signature, returning the instance and
+// the type parameters it still declares: none once instantiated, or all of them when there are no type arguments.
+func instantiate(
+	orig types.Type, typeParams *types.TypeParamList, typeArgs []types.Type,
+) (types.Type, *types.TypeParamList, error) {
+	if len(typeArgs) == 0 {
+		return orig, typeParams, nil
+	}
+
+	if typeParams.Len() == 0 {
+		return nil, nil, errNotGeneric
+	}
+
+	if len(typeArgs) != typeParams.Len() {
+		return nil, nil, fmt.Errorf("%w: got %d, want %d", errTypeArgCount, len(typeArgs), typeParams.Len())
+	}
+
+	// Constraints are checked here, so a type argument that doesn't satisfy them is an error rather than a mock that
+	// doesn't compile. Type arguments from packages orig's package imports resolve to the same types its constraints
+	// use (see ResolveTypeArgs' lookupPackage), so identity-based constraints hold.
+	instance, err := types.Instantiate(nil, orig, typeArgs, true)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", errInvalidTypeArgs, err)
+	}
+
+	return instance, nil, nil
+}
+
+func newTypeExprBuilder(pkg *types.Package) *typeExprBuilder {
+	return &typeExprBuilder{pkg: pkg, imports: make(map[string]string), names: make(map[string]string)}
+}
+
+// typedMethodDetails synthesizes the details of typeName's method methodName as a function, with the receiver type
+// instantiated with typeArgs if given. Methods promoted from embedded fields are found too.
+func typedMethodDetails(
+	builder *typeExprBuilder, details SymbolDetails, pkg *types.Package, typeName, methodName string,
+	typeArgs []types.Type,
+) (SymbolDetails, error) {
+	obj, ok := pkg.Scope().Lookup(typeName).(*types.TypeName)
+	if !ok {
+		return SymbolDetails{}, fmt.Errorf("%w: %s in package %s", errTypeNotFound, typeName, pkg.Path())
+	}
+
+	var typeParams *types.TypeParamList
+	if named, isNamed := types.Unalias(obj.Type()).(*types.Named); isNamed {
+		typeParams = named.TypeParams()
+	}
+
+	instance, typeParams, err := instantiate(types.Unalias(obj.Type()), typeParams, typeArgs)
+	if err != nil {
+		return SymbolDetails{}, fmt.Errorf("%s: %w", typeName, err)
+	}
+
+	selection := types.NewMethodSet(types.NewPointer(instance)).Lookup(pkg, methodName)
+	if selection == nil {
+		return SymbolDetails{}, fmt.Errorf("%w: %s.%s in package %s", errMethodNotFound, typeName, methodName, pkg.Path())
+	}
+
+	signature, _ := selection.Type().(*types.Signature)
+	funcType := builder.funcType(signature)
+	funcType.TypeParams = builder.typeParams(typeParams)
+
+	details.Kind = SymbolFunction
+	details.FuncDecl = &dst.FuncDecl{Name: dst.NewIdent(methodName), Type: funcType}
+	details.FuncImports = builder.importSpecs()
+
+	return details, nil
+}
+
+// typedTypeDetails synthesizes the details of a named type (or alias), instantiated with typeArgs if given.
+//
+//nolint:funlen // Interfaces and other named types are synthesized differently
+func typedTypeDetails(
+	builder *typeExprBuilder, details SymbolDetails, obj *types.TypeName, typeArgs []types.Type,
+) (SymbolDetails, error) {
+	var typeParams *types.TypeParamList
+
+	switch typ := obj.Type().(type) {
+	case *types.Alias:
+		typeParams = typ.TypeParams()
+	case *types.Named:
+		typeParams = typ.TypeParams()
+	}
+
+	instance, typeParams, err := instantiate(obj.Type(), typeParams, typeArgs)
+	if err != nil {
+		return SymbolDetails{}, fmt.Errorf("%s: %w", obj.Name(), err)
+	}
+
+	typ := types.Unalias(instance)
+
+	switch underlying := typ.Underlying().(type) {
+	case *types.Interface:
+		if !underlying.IsMethodSet() {
+			return SymbolDetails{}, fmt.Errorf("%w: %s", errConstraintInterface, obj.Name())
+		}
+
+		details.Kind = SymbolInterface
+		details.Iface = IfaceWithDetails{
+			Iface:      &dst.InterfaceType{Methods: builder.methodFields(obj.Pkg(), declaredMethods(underlying))},
+			TypeParams: builder.typeParams(typeParams),
+			TypeArgs:   builder.typeArgs(typeArgs),
+		}
+		details.Iface.SourceImports = builder.importSpecs()
+	case *types.Signature:
+		details.Kind = SymbolFunctionType
+		details.FuncType = FuncTypeWithDetails{
+			FuncType:   builder.funcType(underlying),
+			TypeParams: builder.typeParams(typeParams),
+			TypeName:   obj.Name(),
+		}
+		details.FuncImports = builder.importSpecs()
+	default:
+		methodSet := types.NewMethodSet(types.NewPointer(typ))
+
+		methods := make([]*types.Func, 0, methodSet.Len())
+		for i := range methodSet.Len() {
+			method, _ := methodSet.At(i).Obj().(*types.Func)
+			methods = append(methods, method)
+		}
+
+		// Struct methods are generated in name order, so their order here does not matter
+		fields := builder.methodFields(obj.Pkg(), methods)
+		funcTypes := make(map[string]*dst.FuncType, len(fields.List))
+
+		for _, field := range fields.List {
+			funcType, _ := field.Type.(*dst.FuncType)
+			funcTypes[field.Names[0].Name] = funcType
+		}
+
+		details.Kind = SymbolStructType
+		details.StructType = StructWithDetails{
+			TypeParams: builder.typeParams(typeParams),
+			TypeArgs:   builder.typeArgs(typeArgs),
+			TypeName:   obj.Name(),
+			Methods:    funcTypes,
+		}
+		details.StructType.SourceImports = builder.importSpecs()
+	}
+
+	return details, nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"go/token"
+	"regexp"
 	"sort"
 	"strings"
 	"unicode"
@@ -27,16 +28,27 @@ const (
 // Exported variables.
 var (
 	ErrNotPackageReference = errors.New("not a package reference")
+	ErrUnknownMethod       = errors.New("unknown method")
+	ErrUnknownTemplate     = errors.New("unknown template")
 )
 
 type GeneratorInfo struct {
 	PkgName            string
 	InterfaceName      string
 	LocalInterfaceName string
+	TypeArgs           string // type arguments to instantiate a generic symbol with, e.g. "model.User"
 	ImpName            string
 	Mode               NamingMode
 	ImportPathFlag     string
-	NameProvided       bool // true if --name was explicitly provided
+	NameProvided       bool   // true if --name was explicitly provided
+	NamePattern        string // --name-pattern, e.g. "Fake{name}"; empty for default naming
+	// DirectivePkgName is the package of the directive when generating into another package (--output-dir); empty
+	// when generating next to the directive, into PkgName.
+	DirectivePkgName string
+	OutputDir        string            // --output-dir, relative to the directive; empty to generate next to it
+	Templates        map[string]string // --templates overrides, by template name; nil for the built-in templates
+	Methods          []string          // --methods: the only methods to mock; empty to mock every method
+	ExcludeMethods   *regexp.Regexp    // --exclude-methods: methods to leave unmocked; nil to leave none
 }
 
 type ResultData struct {
@@ -177,10 +189,12 @@ type baseGenerator struct {
 	pkgPath        string
 	qualifier      string
 	typeParams     *dst.FieldList
+	typeArgs       []dst.Expr // type arguments of an instantiated generic symbol
 	needsFmt       bool
 	needsImptest   bool
 	needsReflect   bool
 	needsQualifier bool
+	templates      map[string]string // template overrides, by template name
 }
 
 // buildParamStrings builds the parameter string and collects parameter names from a function type.
@@ -220,6 +234,22 @@ func (baseGen *baseGenerator) buildResultStrings(
 	return resultsStr, resultTypes
 }
 
+// checkIfTypeParamsNeedQualifier pre-scans type parameter constraints and type arguments, which may name types from
+// the source package.
+func (baseGen *baseGenerator) checkIfTypeParamsNeedQualifier() {
+	for _, typeArg := range baseGen.typeArgs {
+		baseGen.checkIfQualifierNeeded(typeArg)
+	}
+
+	if baseGen.typeParams == nil {
+		return
+	}
+
+	for _, field := range baseGen.typeParams.List {
+		baseGen.checkIfQualifierNeeded(field.Type)
+	}
+}
+
 // checkIfQualifierNeeded pre-scans to determine if the package qualifier is needed.
 func (baseGen *baseGenerator) checkIfQualifierNeeded(expr dst.Expr) {
 	if baseGen.qualifier == "" {
@@ -272,6 +302,21 @@ func (baseGen *baseGenerator) collectAdditionalImportsFromInterface(
 		},
 	)
 
+	// Type arguments and type parameter constraints may name types from other packages
+	for _, typeArg := range baseGen.typeArgs {
+		for _, imp := range collectExternalImports(typeArg, sourceImports) {
+			allImports[imp.Path] = imp
+		}
+	}
+
+	if baseGen.typeParams != nil {
+		for _, field := range baseGen.typeParams.List {
+			for _, imp := range collectExternalImports(field.Type, sourceImports) {
+				allImports[imp.Path] = imp
+			}
+		}
+	}
+
 	// Convert map to slice and sort for deterministic output
 	result := make([]importInfo, 0, len(allImports))
 	for _, imp := range allImports {
@@ -320,9 +365,24 @@ func (baseGen *baseGenerator) formatResultTypes(results *dst.FieldList, types []
 	return " " + joined
 }
 
-// formatTypeParamsDecl formats type parameters for declaration.
+// formatTypeArgs formats the type arguments of an instantiated generic symbol, e.g. "[pkg.User]", or "" if there are
+// none.
+func (baseGen *baseGenerator) formatTypeArgs() string {
+	if len(baseGen.typeArgs) == 0 {
+		return ""
+	}
+
+	typeArgs := make([]string, 0, len(baseGen.typeArgs))
+	for _, typeArg := range baseGen.typeArgs {
+		typeArgs = append(typeArgs, baseGen.typeWithQualifier(typeArg))
+	}
+
+	return "[" + strings.Join(typeArgs, ", ") + "]"
+}
+
+// formatTypeParamsDecl formats type parameters for declaration, qualifying their constraints.
 func (baseGen *baseGenerator) formatTypeParamsDecl() string {
-	return formatTypeParamsDecl(baseGen.fset, baseGen.typeParams)
+	return formatTypeParamsDecl(baseGen.typeParams, baseGen.typeWithQualifier)
 }
 
 // formatTypeParamsUse formats type parameters for instantiation.
@@ -347,6 +407,15 @@ func (baseGen *baseGenerator) isTypeParameter(name string) bool {
 	return false
 }
 
+// templateRegistry returns the templates to generate with: the built-in ones, with any overrides applied.
+func (baseGen *baseGenerator) templateRegistry() (*TemplateRegistry, error) {
+	if len(baseGen.templates) == 0 {
+		return NewTemplateRegistry(), nil
+	}
+
+	return NewTemplateRegistryWithOverrides(baseGen.templates)
+}
+
 type codeWriter struct {
 	buf bytes.Buffer
 }
@@ -362,6 +431,9 @@ type fieldInfo struct {
 	Field *dst.Field // The original AST field (use Field.Type with typeWithQualifier)
 }
 
+// templateWriter writes one template section into buf, like the TemplateRegistry Write methods.
+type templateWriter func(buf *bytes.Buffer, data any) error
+
 type typeExprWalker[T any] struct {
 	visitIdent    func(*dst.Ident) T
 	visitSelector func(*dst.SelectorExpr) T
@@ -390,6 +462,10 @@ func (w *typeExprWalker[T]) walk(expr dst.Expr) T {
 		return w.walkFieldList(typeExpr.Fields)
 	case *dst.IndexExpr, *dst.IndexListExpr:
 		return w.walkIndexType(expr)
+	case *dst.BinaryExpr: // constraint union, e.g. ~int | ~string
+		return w.combine(w.walk(typeExpr.X), w.walk(typeExpr.Y))
+	case *dst.UnaryExpr: // constraint term, e.g. ~int
+		return w.walk(typeExpr.X)
 	}
 
 	return w.zero
@@ -505,6 +581,10 @@ func (tf *typeFormatter) typeWithQualifierComposite(expr dst.Expr) string {
 		return tf.typeWithQualifierIndex(typeExpr)
 	case *dst.IndexListExpr:
 		return tf.typeWithQualifierIndexList(typeExpr)
+	case *dst.BinaryExpr: // constraint union, e.g. ~int | ~string
+		return tf.typeWithQualifier(typeExpr.X) + " " + typeExpr.Op.String() + " " + tf.typeWithQualifier(typeExpr.Y)
+	case *dst.UnaryExpr: // constraint term, e.g. ~int
+		return typeExpr.Op.String() + tf.typeWithQualifier(typeExpr.X)
 	default:
 		return exprToString(tf.fset, expr)
 	}
@@ -584,6 +664,21 @@ type variadicArgsResult struct {
 	allArgs         string
 }
 
+// buildMatcherParams builds the ArgsShould parameter list: one any per parameter,
+// with a variadic ...any tail for variadic functions.
+func buildMatcherParams(paramNames []string, hasVariadic bool) string {
+	parts := make([]string, len(paramNames))
+	for i, name := range paramNames {
+		parts[i] = name + " any"
+	}
+
+	if hasVariadic && len(parts) > 0 {
+		parts[len(parts)-1] = paramNames[len(paramNames)-1] + " ...any"
+	}
+
+	return strings.Join(parts, ", ")
+}
+
 // buildResultReturnList builds the return type list from result types.
 func buildResultReturnList(resultTypes []string) string {
 	if len(resultTypes) == 0 {
@@ -714,6 +809,7 @@ func collectImportsFromFuncDecl(funcDecl *dst.FuncDecl, astFiles []*dst.File) []
 	sourceImports := findSourceImports(astFiles)
 	allImports := make(map[string]importInfo)
 
+	collectImportsFromFieldList(funcDecl.Type.TypeParams, sourceImports, allImports)
 	collectImportsFromFieldList(funcDecl.Type.Params, sourceImports, allImports)
 	collectImportsFromFieldList(funcDecl.Type.Results, sourceImports, allImports)
 
@@ -856,9 +952,9 @@ func findSourceImports(astFiles []*dst.File) []*dst.ImportSpec {
 // startIndex: starting index (0 for r0-based, 1 for v1-based)
 // typeFormatter: function to format each result's type.
 
-// formatTypeParamsDecl formats type parameters for declaration (e.g., "[T any, U comparable]").
-// Returns empty string if there are no type parameters.
-func formatTypeParamsDecl(fset *token.FileSet, typeParams *dst.FieldList) string {
+// formatTypeParamsDecl formats type parameters for declaration (e.g., "[T any, U comparable]"), formatting each
+// constraint with formatType. Returns empty string if there are no type parameters.
+func formatTypeParamsDecl(typeParams *dst.FieldList, formatType func(dst.Expr) string) string {
 	if typeParams == nil || len(typeParams.List) == 0 {
 		return ""
 	}
@@ -883,7 +979,7 @@ func formatTypeParamsDecl(fset *token.FileSet, typeParams *dst.FieldList) string
 		// Write constraint
 		if field.Type != nil {
 			buf.WriteString(" ")
-			buf.WriteString(exprToString(fset, field.Type))
+			buf.WriteString(formatType(field.Type))
 		}
 	}
 
@@ -932,8 +1028,9 @@ func hasExportedIdent(expr dst.Expr, isTypeParam func(string) bool) bool {
 				!isBuiltinType(ident.Name) &&
 				!isTypeParam(ident.Name)
 		},
+		// Already qualified with another package, so not in need of the source package's qualifier
 		visitSelector: func(*dst.SelectorExpr) bool {
-			return true
+			return false
 		},
 		combine: func(a, b bool) bool {
 			return a || b
@@ -970,6 +1067,12 @@ func hasFieldNames(field *dst.Field) bool {
 
 // Unknown type → use DeepEqual to be safe
 
+// importsOwnPackage reports whether the generated code lives outside the package the directive's unqualified
+// symbols are declared in, and so must import it: from an external test package, or from another package entirely.
+func importsOwnPackage(info GeneratorInfo) bool {
+	return info.DirectivePkgName != "" || strings.HasSuffix(info.PkgName, "_test")
+}
+
 // isBuiltinType checks if a type name is a Go builtin.
 func isBuiltinType(name string) bool {
 	switch name {
@@ -1189,7 +1292,7 @@ func resolveImportPath(alias string, imports []*dst.ImportSpec) string {
 // generators (mock_interface, wrap_interface). It handles three scenarios:
 //   - External qualified name (e.g., "basic.Ops") - resolves via pkgLoader
 //   - External unqualified name from dot import - uses pkgImportPath directly
-//   - Test package referencing non-test package - resolves via pkgLoader
+//   - Test or other package referencing the directive's package - resolves via pkgLoader
 //
 // Note: resolvePackageInfo converts all ErrNotPackageReference errors to empty returns,
 // and GetPackageInfo only returns nil or ErrNotPackageReference, so this cannot fail.
@@ -1208,8 +1311,8 @@ func resolveInterfaceGeneratorPackage(
 		return pkgImportPath, extractPkgNameFromPath(pkgImportPath)
 	}
 
-	if strings.HasSuffix(info.PkgName, "_test") {
-		// Test package referencing non-test version
+	if importsOwnPackage(info) {
+		// Test or other package referencing the directive's package
 		pkgPath, qualifier, _ = resolvePackageInfo(info, pkgLoader)
 		return pkgPath, qualifier
 	}
@@ -1241,6 +1344,18 @@ func sortedImportSlice(imports map[string]importInfo) []importInfo {
 // stringifyStructType delegates to astutil.StringifyExpr.
 
 // typeWithQualifierFunc handles function types.
+// timeQualifier returns the name generated code uses for package time: the alias of an
+// existing import of time, so that the file doesn't import it twice, or pkgTime.
+func timeQualifier(imports []importInfo) string {
+	for _, imp := range imports {
+		if imp.Path == "time" {
+			return imp.Alias
+		}
+	}
+
+	return pkgTime
+}
+
 func typeWithQualifierFunc(
 	_ *token.FileSet,
 	funcType *dst.FuncType,
@@ -1274,3 +1389,25 @@ func typeWithQualifierFunc(
 
 	return buf.String()
 }
+
+// variadicElemType returns the element type of a variadic type string ("...T" -> "T"),
+// or "" if the type is not variadic.
+func variadicElemType(typeStr string) string {
+	if strings.HasPrefix(typeStr, "...") {
+		return typeStr[3:]
+	}
+
+	return ""
+}
+
+// writeTemplates writes each section in order with the same data, stopping at the first error.
+func writeTemplates(buf *bytes.Buffer, data any, writers ...templateWriter) error {
+	for _, write := range writers {
+		err := write(buf, data)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -539,11 +539,16 @@ func resolvePackageInfo(
 		return "", "", err
 	}
 
-	// Special case: when in a test package (e.g., "imptest_test") and the interface
-	// has no package qualifier (GetPackageInfo returned empty), the interface is from
-	// the non-test version of this package. We need to import it with its full path.
-	if qualifier == "" && strings.HasSuffix(info.PkgName, "_test") {
-		basePkgPath, baseQualifier := resolveTestPackageImport(pkgLoader, info.PkgName)
+	// Special case: when in a test package (e.g., "imptest_test") or generating into another package, and the
+	// interface has no package qualifier (GetPackageInfo returned empty), the interface is from the directive's
+	// (non-test) package. We need to import it with its full path.
+	if qualifier == "" && importsOwnPackage(info) {
+		directivePkgName := info.PkgName
+		if info.DirectivePkgName != "" {
+			directivePkgName = info.DirectivePkgName
+		}
+
+		basePkgPath, baseQualifier := resolveTestPackageImport(pkgLoader, directivePkgName)
 		if basePkgPath != "" {
 			return basePkgPath, baseQualifier, nil
 		}
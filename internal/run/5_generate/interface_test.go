@@ -53,6 +53,33 @@ func TestDependencyCode_Error(t *testing.T) {
 	}
 }
 
+func TestOutParamKind(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name, typeStr, wantKind, wantElem string
+	}{
+		{name: "P", typeStr: "[]byte", wantKind: "slice", wantElem: "[]byte"},
+		{name: "Into", typeStr: "*Record", wantKind: "pointer", wantElem: "Record"},
+		{name: "Args", typeStr: "[]any", wantKind: "", wantElem: ""},
+		{name: "V", typeStr: "any", wantKind: "", wantElem: ""},
+		{name: "Dest", typeStr: "...*int", wantKind: "", wantElem: ""},
+		{name: "Arg", typeStr: "*Record", wantKind: "", wantElem: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			kind, elem := outParamKind(tt.name, tt.typeStr)
+			if kind != tt.wantKind || elem != tt.wantElem {
+				t.Errorf("outParamKind(%q, %q) = (%q, %q), want (%q, %q)",
+					tt.name, tt.typeStr, kind, elem, tt.wantKind, tt.wantElem)
+			}
+		})
+	}
+}
+
 type mockPkgLoader struct {
 	files []*dst.File
 	fset  *token.FileSet
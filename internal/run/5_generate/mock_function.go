@@ -123,6 +123,7 @@ func (gen *functionDependencyGenerator) buildMethodTemplateData() depMethodTempl
 		ArgNames:          variadicResult.allArgs,
 		HasResults:        len(resultTypes) > 0,
 		ResultVars:        resultVars,
+		ResultTypes:       buildResultTypeExprs(resultTypes),
 		ReturnList:        returnList,
 		ReturnStatement:   "return " + returnList,
 		ParamFields:       paramFields,
@@ -131,8 +132,10 @@ func (gen *functionDependencyGenerator) buildMethodTemplateData() depMethodTempl
 		CallTypeName:      gen.mockTypeName + "Call",
 		MethodTypeName:    gen.mockTypeName + "Method",
 		TypedParams:       paramsStr,
+		MatcherParams:     buildMatcherParams(paramNames, variadicResult.hasVariadic),
 		TypedReturnParams: typedReturnParams,
 		ReturnParamNames:  returnParamNames,
+		ClosesChannels:    hasChanResult(ftype),
 	}
 }
 
@@ -149,10 +152,16 @@ func (gen *functionDependencyGenerator) buildParamFields(ftype *dst.FuncType) []
 			fieldName = strings.ToUpper(string(fieldName[0])) + fieldName[1:]
 		}
 
+		typeStr := gen.typeWithQualifier(pinfo.Field.Type)
+		outKind, elemType := outParamKind(fieldName, typeStr)
+
 		paramFields = append(paramFields, paramField{
-			Name:  fieldName,
-			Type:  normalizeVariadicType(gen.typeWithQualifier(pinfo.Field.Type)),
-			Index: pinfo.Index,
+			Name:         fieldName,
+			Type:         normalizeVariadicType(typeStr),
+			Index:        pinfo.Index,
+			OutKind:      outKind,
+			ElemType:     elemType,
+			VariadicElem: variadicElemType(typeStr),
 		})
 	}
 
@@ -162,6 +171,7 @@ func (gen *functionDependencyGenerator) buildParamFields(ftype *dst.FuncType) []
 // checkIfQualifierNeeded determines if we need a package qualifier.
 func (gen *functionDependencyGenerator) checkIfQualifierNeeded() {
 	gen.baseGenerator.checkIfQualifierNeeded(gen.funcDecl.Type)
+	gen.checkIfTypeParamsNeedQualifier()
 }
 
 // collectAdditionalImports collects imports needed for function parameter/return types.
@@ -179,6 +189,15 @@ func (gen *functionDependencyGenerator) collectAdditionalImports() []importInfo
 		}
 	}
 
+	// Collect external types from type parameter constraints
+	if gen.funcDecl.Type.TypeParams != nil {
+		for _, field := range gen.funcDecl.Type.TypeParams.List {
+			imports = append(
+				imports,
+				gen.collectImportsFromExpr(field.Type, sourceImports, seenPaths)...)
+		}
+	}
+
 	// Collect external types from parameters
 	if gen.funcDecl.Type.Params != nil {
 		for _, field := range gen.funcDecl.Type.Params.List {
@@ -280,11 +299,16 @@ func (gen *functionDependencyGenerator) generate() (string, error) {
 	// Pre-scan to determine what imports are needed
 	gen.checkIfQualifierNeeded()
 
-	// Get the global template registry (initialized at package load time)
-	templates := NewTemplateRegistry()
-
 	// Generate using templates
-	gen.generateWithTemplates(templates)
+	templates, err := gen.templateRegistry()
+	if err != nil {
+		return "", err
+	}
+
+	err = gen.generateWithTemplates(templates)
+	if err != nil {
+		return "", err
+	}
 
 	formatted, err := format.Source(gen.bytes())
 	if err != nil {
@@ -295,8 +319,10 @@ func (gen *functionDependencyGenerator) generate() (string, error) {
 }
 
 // generateWithTemplates generates code using templates.
-func (gen *functionDependencyGenerator) generateWithTemplates(templates *TemplateRegistry) {
+func (gen *functionDependencyGenerator) generateWithTemplates(templates *TemplateRegistry) error {
 	methodData := gen.buildMethodTemplateData()
+	additionalImports := gen.collectAdditionalImports()
+	methodData.PkgTime = timeQualifier(additionalImports)
 
 	// Build base template data
 	base := baseTemplateData{
@@ -310,12 +336,13 @@ func (gen *functionDependencyGenerator) generateWithTemplates(templates *Templat
 		PkgTesting:        pkgTesting,
 		PkgFmt:            pkgFmt,
 		PkgImptest:        pkgImptest,
-		PkgTime:           pkgTime,
+		PkgTime:           methodData.PkgTime,
 		PkgReflect:        pkgReflect,
 		NeedsFmt:          gen.needsFmt,
-		NeedsReflect:      gen.needsReflect,
+		NeedsReflect:      methodData.HasResults, // result types are declared via reflect
 		NeedsImptest:      gen.needsImptest,
-		AdditionalImports: gen.collectAdditionalImports(),
+		NeedsTime:         methodData.HasResults && methodData.PkgTime == pkgTime, // typed ReturnAfter takes a time.Duration
+		AdditionalImports: additionalImports,
 	}
 
 	// Build function dependency template data
@@ -329,18 +356,25 @@ func (gen *functionDependencyGenerator) generateWithTemplates(templates *Templat
 	}
 
 	// Write header
-	templates.WriteDepHeader(&gen.buf, data)
+	err := templates.WriteDepHeader(&gen.buf, data)
+	if err != nil {
+		return err
+	}
 
 	// Write type-safe wrappers first (Args, Call, Method types) - needed before Handle struct
-	templates.WriteDepArgsStruct(&gen.buf, methodData)
-	templates.WriteDepCallWrapper(&gen.buf, methodData)
-	templates.WriteFuncDepMethodWrapper(&gen.buf, data)
-
-	// Write function mock handle struct
-	templates.WriteFuncDepMockStruct(&gen.buf, data)
+	err = writeTemplates(&gen.buf, methodData, templates.WriteDepArgsStruct, templates.WriteDepCallWrapper)
+	if err != nil {
+		return err
+	}
 
-	// Write constructor (creates Handle with inlined Mock function)
-	templates.WriteFuncDepConstructor(&gen.buf, data)
+	// Write method wrapper, function mock handle struct, and constructor (creates Handle with inlined Mock function)
+	return writeTemplates(
+		&gen.buf,
+		data,
+		templates.WriteFuncDepMethodWrapper,
+		templates.WriteFuncDepMockStruct,
+		templates.WriteFuncDepConstructor,
+	)
 }
 
 // newFunctionDependencyGenerator creates a new function dependency mock generator.
@@ -357,7 +391,7 @@ func newFunctionDependencyGenerator(
 	// Convert MockXxx -> XxxMock for the struct type name
 	mockTypeName := strings.TrimPrefix(info.ImpName, "Mock") + "Mock"
 
-	return &functionDependencyGenerator{
+	gen := &functionDependencyGenerator{
 		baseGenerator: newBaseGenerator(fset, info.PkgName, info.ImpName, pkgPath, qualifier, nil),
 		mockName:      info.ImpName,
 		mockTypeName:  mockTypeName,
@@ -365,6 +399,9 @@ func newFunctionDependencyGenerator(
 		astFiles:      astFiles,
 		funcDecl:      funcDecl,
 	}
+	gen.templates = info.Templates
+
+	return gen
 }
 
 // resolveFunctionPackageInfo determines the package path and qualifier for a function.
@@ -387,8 +424,8 @@ func resolveFunctionPackageInfo(
 		return pkgPath, parts[len(parts)-1]
 	}
 
-	// Test package needs to import the main package
-	if strings.HasSuffix(info.PkgName, "_test") {
+	// Test or other package needs to import the directive's package
+	if importsOwnPackage(info) {
 		pkgPath, qualifier, _ := resolvePackageInfo(info, pkgLoader)
 		return pkgPath, qualifier
 	}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"go/format"
 	"go/token"
+	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/dave/dst"
@@ -47,6 +49,8 @@ type dependencyGenerator struct {
 	pkgLoader           detect.PackageLoader
 	methodNames         []string
 	identifiedInterface detect.IfaceWithDetails // full interface details including source imports
+	mockedMethods       []string                // --methods; empty to mock every method
+	excludeMethods      *regexp.Regexp          // --exclude-methods; nil to exclude none
 }
 
 // buildDependencyTemplateData constructs the template data for dependency mock generation.
@@ -75,16 +79,39 @@ func (gen *dependencyGenerator) buildDependencyTemplateData() depTemplateData {
 	interfaceType := gen.formatQualifiedInterfaceType()
 
 	// Collect method data for all methods first (needed for typed wrappers)
-	var methods []depMethodTemplateData
+	var (
+		methods, unmockedMethods []depMethodTemplateData
+		returnsChannels          bool
+	)
 
 	_ = forEachInterfaceMethod(
 		gen.identifiedInterface.Iface, gen.astFiles, gen.fset, gen.pkgImportPath, gen.pkgLoader,
 		func(methodName string, ftype *dst.FuncType) {
 			methodData := gen.buildMethodTemplateData(methodName, ftype, interfaceType)
+			if !gen.isMocked(methodName) {
+				unmockedMethods = append(unmockedMethods, methodData)
+				return
+			}
+
 			methods = append(methods, methodData)
+			returnsChannels = returnsChannels || hasChanResult(ftype)
 		},
 	)
 
+	// Typed ReturnAfter wrappers take a time.Duration; result types are declared via reflect.
+	// CloseReturnedChannels is only offered when one of the mocked methods returns a channel.
+	base.PkgTime = timeQualifier(base.AdditionalImports)
+
+	for index, method := range methods {
+		methods[index].PkgTime = base.PkgTime
+		methods[index].ClosesChannels = returnsChannels
+
+		if method.HasResults {
+			base.NeedsTime = base.PkgTime == pkgTime
+			base.NeedsReflect = true
+		}
+	}
+
 	baseName := strings.TrimPrefix(gen.mockName, "Mock")
 
 	return depTemplateData{
@@ -99,6 +126,7 @@ func (gen *dependencyGenerator) buildDependencyTemplateData() depTemplateData {
 		MethodNames:      gen.methodNames,
 		Methods:          methods,
 		IsStructType:     gen.identifiedInterface.IsStructType,
+		UnmockedMethods:  unmockedMethods,
 	}
 }
 
@@ -146,6 +174,7 @@ func (gen *dependencyGenerator) buildMethodTemplateData(
 		ArgNames:          variadicResult.allArgs,
 		HasResults:        len(resultTypes) > 0,
 		ResultVars:        resultVars,
+		ResultTypes:       buildResultTypeExprs(resultTypes),
 		ReturnList:        returnList,
 		ReturnStatement:   "return " + returnList,
 		ParamFields:       paramFields,
@@ -154,6 +183,7 @@ func (gen *dependencyGenerator) buildMethodTemplateData(
 		CallTypeName:      fmt.Sprintf("%s%sCall", gen.mockTypeName, methodName),
 		MethodTypeName:    fmt.Sprintf("%s%sMethod", gen.mockTypeName, methodName),
 		TypedParams:       paramsStr,
+		MatcherParams:     buildMatcherParams(paramNames, variadicResult.hasVariadic),
 		TypedReturnParams: typedReturnParams,
 		ReturnParamNames:  returnParamNames,
 	}
@@ -176,10 +206,16 @@ func (gen *dependencyGenerator) buildParamFields(ftype *dst.FuncType) []paramFie
 			fieldName = strings.ToUpper(string(fieldName[0])) + fieldName[1:]
 		}
 
+		typeStr := gen.typeWithQualifier(pinfo.Field.Type)
+		outKind, elemType := outParamKind(fieldName, typeStr)
+
 		paramFields = append(paramFields, paramField{
-			Name:  fieldName,
-			Type:  normalizeVariadicType(gen.typeWithQualifier(pinfo.Field.Type)),
-			Index: pinfo.Index,
+			Name:         fieldName,
+			Type:         normalizeVariadicType(typeStr),
+			Index:        pinfo.Index,
+			OutKind:      outKind,
+			ElemType:     elemType,
+			VariadicElem: variadicElemType(typeStr),
 		})
 	}
 
@@ -194,6 +230,8 @@ func (gen *dependencyGenerator) checkIfQualifierNeeded() {
 			gen.baseGenerator.checkIfQualifierNeeded(ftype)
 		},
 	)
+
+	gen.checkIfTypeParamsNeedQualifier()
 }
 
 // collectAdditionalImports collects imports needed for interface method signatures.
@@ -234,11 +272,13 @@ func (gen *dependencyGenerator) formatQualifiedInterfaceType() string {
 		interfaceType = qualifierToUse + "." + gen.interfaceName
 	}
 
-	// Add type parameters to interface type if present
+	// Add type parameters, or the type arguments of an instantiation, to interface type if present
 	if gen.formatTypeParamsUse() != "" {
 		interfaceType += gen.formatTypeParamsUse()
 	}
 
+	interfaceType += gen.formatTypeArgs()
+
 	return interfaceType
 }
 
@@ -254,11 +294,16 @@ func (gen *dependencyGenerator) generate() (string, error) {
 		gen.needsQualifier = true
 	}
 
-	// Get the global template registry (initialized at package load time)
-	templates := NewTemplateRegistry()
-
 	// Generate using templates
-	gen.generateWithTemplates(templates)
+	templates, err := gen.templateRegistry()
+	if err != nil {
+		return "", err
+	}
+
+	err = gen.generateWithTemplates(templates)
+	if err != nil {
+		return "", err
+	}
 
 	formatted, err := format.Source(gen.bytes())
 	if err != nil {
@@ -269,23 +314,66 @@ func (gen *dependencyGenerator) generate() (string, error) {
 }
 
 // generateWithTemplates generates code using templates instead of direct code generation.
-func (gen *dependencyGenerator) generateWithTemplates(templates *TemplateRegistry) {
+func (gen *dependencyGenerator) generateWithTemplates(templates *TemplateRegistry) error {
 	data := gen.buildDependencyTemplateData()
 
 	// Generate each section using templates
-	templates.WriteDepHeader(&gen.buf, data)
-	templates.WriteDepMockStruct(&gen.buf, data)
-	templates.WriteDepInterfaceMethod(&gen.buf, data)
-	templates.WriteDepConstructor(&gen.buf, data)
-	templates.WriteDepImplStruct(&gen.buf, data)
+	err := writeTemplates(
+		&gen.buf,
+		data,
+		templates.WriteDepHeader,
+		templates.WriteDepMockStruct,
+		templates.WriteDepInterfaceMethod,
+		templates.WriteDepConstructor,
+		templates.WriteDepImplStruct,
+	)
+	if err != nil {
+		return err
+	}
 
 	// Generate implementation methods and type-safe wrappers for each interface method
 	for _, methodData := range data.Methods {
-		templates.WriteDepImplMethod(&gen.buf, methodData)
-		templates.WriteDepArgsStruct(&gen.buf, methodData)
-		templates.WriteDepCallWrapper(&gen.buf, methodData)
-		templates.WriteDepMethodWrapper(&gen.buf, methodData)
+		err = writeTemplates(
+			&gen.buf,
+			methodData,
+			templates.WriteDepImplMethod,
+			templates.WriteDepArgsStruct,
+			templates.WriteDepCallWrapper,
+			templates.WriteDepMethodWrapper,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Methods left unmocked still implement the interface, but only panic
+	for _, methodData := range data.UnmockedMethods {
+		err = templates.WriteDepUnmockedMethod(&gen.buf, methodData)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isMocked reports whether methodName is mocked, rather than left out by --methods or --exclude-methods.
+func (gen *dependencyGenerator) isMocked(methodName string) bool {
+	if len(gen.mockedMethods) > 0 && !slices.Contains(gen.mockedMethods, methodName) {
+		return false
+	}
+
+	return gen.excludeMethods == nil || !gen.excludeMethods.MatchString(methodName)
+}
+
+// buildResultTypeExprs builds the reflect.Type expressions passed to DependencyMethod.Results.
+func buildResultTypeExprs(resultTypes []string) string {
+	exprs := make([]string, 0, len(resultTypes))
+	for _, resultType := range resultTypes {
+		exprs = append(exprs, pkgReflect+".TypeFor["+resultType+"]()")
 	}
+
+	return strings.Join(exprs, ", ")
 }
 
 // buildResultVars builds result variables and return list from result types.
@@ -336,6 +424,26 @@ func buildTypedReturnParams(resultTypes []string) (typedParams, paramNames strin
 	return typedBuilder.String(), namesBuilder.String()
 }
 
+// hasChanResult reports whether ftype returns a channel.
+func hasChanResult(ftype *dst.FuncType) bool {
+	if ftype.Results == nil {
+		return false
+	}
+
+	for _, field := range ftype.Results.List {
+		if _, ok := field.Type.(*dst.ChanType); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isAnyType reports whether typeStr is the empty interface.
+func isAnyType(typeStr string) bool {
+	return typeStr == "any" || typeStr == "interface{}"
+}
+
 // newDependencyGenerator creates a new dependency mock generator.
 func newDependencyGenerator(
 	astFiles []*dst.File,
@@ -380,7 +488,39 @@ func newDependencyGenerator(
 		return nil, err
 	}
 
+	// A misspelled --methods name would otherwise silently leave the method unmocked
+	for _, name := range info.Methods {
+		if !slices.Contains(methodNames, name) {
+			return nil, fmt.Errorf("%w in --methods: %s has no method %s", ErrUnknownMethod, info.LocalInterfaceName, name)
+		}
+	}
+
 	gen.methodNames = methodNames
+	gen.typeArgs = ifaceWithDetails.TypeArgs
+	gen.templates = info.Templates
+	gen.mockedMethods = info.Methods
+	gen.excludeMethods = info.ExcludeMethods
 
 	return gen, nil
 }
+
+// outParamKind classifies a parameter for out-parameter helpers: slices are filled by
+// copying and pointers are stored through. Slices of any, lone anys, and variadics get
+// no helper, since most such parameters are inputs; DependencyCall.FillArg still fills
+// them by index. A parameter named Arg gets none either, as FillArg would shadow it.
+func outParamKind(fieldName, typeStr string) (kind, elemType string) {
+	switch {
+	case fieldName == "Arg":
+		return "", ""
+	case strings.HasPrefix(typeStr, "[]"):
+		if isAnyType(typeStr[2:]) {
+			return "", ""
+		}
+
+		return "slice", typeStr
+	case strings.HasPrefix(typeStr, "*"):
+		return "pointer", typeStr[1:]
+	default:
+		return "", ""
+	}
+}
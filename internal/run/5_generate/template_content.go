@@ -13,7 +13,19 @@ type {{.ArgsTypeName}}{{.TypeParamsDecl}} struct {
 type {{.CallTypeName}}{{.TypeParamsDecl}} struct {
 	*{{.PkgImptest}}.DependencyCall
 }
-{{if .HasParams}}
+{{range .ParamFields}}{{if eq .OutKind "slice"}}
+// Fill{{.Name}} copies values into the caller's {{.Name}} slice before the mock returns.
+func (c *{{$.CallTypeName}}{{$.TypeParamsUse}}) Fill{{.Name}}(values {{.ElemType}}) *{{$.CallTypeName}}{{$.TypeParamsUse}} {
+	c.FillArg({{.Index}}, values)
+	return c
+}
+{{else if eq .OutKind "pointer"}}
+// Fill{{.Name}} stores value through the caller's {{.Name}} pointer before the mock returns.
+func (c *{{$.CallTypeName}}{{$.TypeParamsUse}}) Fill{{.Name}}(value {{.ElemType}}) *{{$.CallTypeName}}{{$.TypeParamsUse}} {
+	c.FillArg({{.Index}}, value)
+	return c
+}
+{{end}}{{end}}{{if .HasParams}}
 // GetArgs returns the typed arguments for this call.
 func (c *{{.CallTypeName}}{{.TypeParamsUse}}) GetArgs() {{.ArgsTypeName}}{{.TypeParamsUse}} {
 	raw := c.RawArgs()
@@ -21,23 +33,33 @@ func (c *{{.CallTypeName}}{{.TypeParamsUse}}) GetArgs() {{.ArgsTypeName}}{{.Type
 {{range .ParamFields}}		{{.Name}}: raw[{{.Index}}].({{.Type}}),
 {{end}}	}
 }
-{{end}}{{if .HasResults}}
+{{end}}{{if .HasResults}}{{if .ClosesChannels}}
+// CloseReturnedChannels closes the channels returned by earlier mock calls, then returns the typed values.
+func (c *{{.CallTypeName}}{{.TypeParamsUse}}) CloseReturnedChannels({{.TypedReturnParams}}) {
+	c.DependencyCall.CloseReturnedChannels({{.ReturnParamNames}})
+}
+{{end}}
 // Return specifies the typed values the mock should return.
 func (c *{{.CallTypeName}}{{.TypeParamsUse}}) Return({{.TypedReturnParams}}) {
 	c.DependencyCall.Return({{.ReturnParamNames}})
 }
+
+// ReturnAfter specifies the typed values the mock should return once d has elapsed.
+func (c *{{.CallTypeName}}{{.TypeParamsUse}}) ReturnAfter(d {{.PkgTime}}.Duration, {{.TypedReturnParams}}) {
+	c.DependencyCall.ReturnAfter(d, {{.ReturnParamNames}})
+}
 {{end}}{{end}}
 `
 	tmplDepConstructor = `// {{.MockName}} creates a mock {{.InterfaceName}} and returns (mock, expectation handle).
 func {{.MockName}}{{.TypeParamsDecl}}(t {{.PkgImptest}}.TestReporter) ({{if .IsStructType}}{{.MockTypeName}}Interface{{.TypeParamsUse}}{{else}}{{.InterfaceType}}{{end}}, *{{.ImpTypeName}}{{.TypeParamsUse}}) {
 	ctrl := {{.PkgImptest}}.GetOrCreateImp(t)
 	imp := &{{.ImpTypeName}}{{.TypeParamsUse}}{
-{{range .Methods}}{{if .HasParams}}		{{.MethodName}}: new{{.MethodTypeName}}{{$.TypeParamsUse}}({{$.PkgImptest}}.NewDependencyMethod(ctrl, "{{.MethodName}}")),
-{{else}}		{{.MethodName}}: {{$.PkgImptest}}.NewDependencyMethod(ctrl, "{{.MethodName}}"),
+{{range .Methods}}{{if .HasParams}}		{{.MethodName}}: new{{.MethodTypeName}}{{$.TypeParamsUse}}({{$.PkgImptest}}.NewDependencyMethod(ctrl, "{{.MethodName}}").Results({{.ResultTypes}})),
+{{else}}		{{.MethodName}}: {{$.PkgImptest}}.NewDependencyMethod(ctrl, "{{.MethodName}}").Results({{.ResultTypes}}),
 {{end}}{{end}}	}
 	imp.Eventually = &{{.ImpTypeName}}Eventually{{.TypeParamsUse}}{
-{{range .Methods}}{{if .HasParams}}		{{.MethodName}}: new{{.MethodTypeName}}{{$.TypeParamsUse}}({{$.PkgImptest}}.NewDependencyMethod(ctrl, "{{.MethodName}}").AsEventually()),
-{{else}}		{{.MethodName}}: {{$.PkgImptest}}.NewDependencyMethod(ctrl, "{{.MethodName}}").AsEventually(),
+{{range .Methods}}{{if .HasParams}}		{{.MethodName}}: new{{.MethodTypeName}}{{$.TypeParamsUse}}({{$.PkgImptest}}.NewDependencyMethod(ctrl, "{{.MethodName}}").Results({{.ResultTypes}}).AsEventually()),
+{{else}}		{{.MethodName}}: {{$.PkgImptest}}.NewDependencyMethod(ctrl, "{{.MethodName}}").Results({{.ResultTypes}}).AsEventually(),
 {{end}}{{end}}	}
 	mock := &{{.ImplName}}{{.TypeParamsUse}}{ctrl: ctrl}
 	return mock, imp
@@ -49,7 +71,9 @@ func {{.MockName}}{{.TypeParamsDecl}}(t {{.PkgImptest}}.TestReporter) ({{if .IsS
 package {{.PkgName}}
 
 import (
-	{{.PkgImptest}} "github.com/toejough/imptest"{{if .NeedsQualifier}}
+	{{.PkgImptest}} "github.com/toejough/imptest"{{if .NeedsReflect}}
+	{{.PkgReflect}} "reflect"{{end}}{{if .NeedsTime}}
+	{{.PkgTime}} "time"{{end}}{{if .NeedsQualifier}}
 	{{.Qualifier}} "{{.PkgPath}}"{{end}}{{range .AdditionalImports}}
 	{{.Alias}} "{{.Path}}"{{end}}
 )
@@ -68,9 +92,7 @@ func (impl *{{.ImplName}}{{.TypeParamsUse}}) {{.MethodName}}({{.Params}}){{.Resu
 	}
 	impl.ctrl.CallChan <- call
 	resp := <-call.ResponseChan
-	if resp.Type == "panic" {
-		panic(resp.PanicValue)
-	}
+	resp.Resolve()
 	{{if .HasResults}}{{range .ResultVars}}
 	var {{.Name}} {{.Type}}
 	if len(resp.ReturnValues) > {{.Index}} {
@@ -93,6 +115,7 @@ func (impl *{{.ImplName}}{{.TypeParamsUse}}) {{.MethodName}}({{.Params}}){{.Resu
 	tmplDepInterfaceMethod = `{{if .IsStructType}}// {{.MockTypeName}}Interface is a generated interface matching the methods of {{.InterfaceName}}.
 type {{.MockTypeName}}Interface{{.TypeParamsDecl}} interface {
 {{range .Methods}}	{{.MethodName}}({{.Params}}){{.Results}}
+{{end}}{{range .UnmockedMethods}}	{{.MethodName}}({{.Params}}){{.Results}}
 {{end}}}
 
 {{end}}`
@@ -116,9 +139,27 @@ func (m *{{.MethodTypeName}}{{.TypeParamsUse}}) ArgsEqual({{.TypedParams}}) *{{.
 	return &{{.CallTypeName}}{{.TypeParamsUse}}{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *{{.MethodTypeName}}{{.TypeParamsUse}}) ArgsShould(matchers ...any) *{{.CallTypeName}}{{.TypeParamsUse}} {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *{{.MethodTypeName}}{{.TypeParamsUse}}) ArgsShould({{.MatcherParams}}) *{{.CallTypeName}}{{.TypeParamsUse}} {
+	{{if .HasVariadic}}_matchers := []any{ {{if .NonVariadicArgs}}{{.NonVariadicArgs}}{{end}} }
+	_matchers = append(_matchers, {{.VariadicArg}}...)
+	_call := m.DependencyMethod.ArgsShould(_matchers...){{else}}_call := m.DependencyMethod.ArgsShould({{.ArgNames}}){{end}}
+	return &{{.CallTypeName}}{{.TypeParamsUse}}{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *{{.MethodTypeName}}{{.TypeParamsUse}}) ArgsWhere(predicate func({{.ArgsTypeName}}{{.TypeParamsUse}}) error) *{{.CallTypeName}}{{.TypeParamsUse}} {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args {{.ArgsTypeName}}{{.TypeParamsUse}}
+{{range .ParamFields}}{{if .VariadicElem}}		for _, value := range raw[{{.Index}}:] {
+			elem, _ := value.({{.VariadicElem}})
+			args.{{.Name}} = append(args.{{.Name}}, elem)
+		}
+{{else}}		args.{{.Name}}, _ = raw[{{.Index}}].({{.Type}})
+{{end}}{{end}}		return predicate(args)
+	})
 	return &{{.CallTypeName}}{{.TypeParamsUse}}{DependencyCall: call}
 }
 
@@ -138,12 +179,18 @@ type {{.ImpTypeName}}Eventually{{.TypeParamsDecl}} struct {
 {{else}}	{{.MethodName}} *{{$.PkgImptest}}.DependencyMethod
 {{end}}{{end}}}
 
+`
+	tmplDepUnmockedMethod = `// {{.MethodName}} implements {{.InterfaceType}}.{{.MethodName}}, but is not mocked: it panics if called.
+func (impl *{{.ImplName}}{{.TypeParamsUse}}) {{.MethodName}}({{.Params}}){{.Results}} {
+	panic("{{.InterfaceType}}.{{.MethodName}} is not mocked: it was left out by --methods or --exclude-methods")
+}
+
 `
 	tmplFuncDepConstructor = `// {{.MockName}} creates a mock {{.FuncName}} function and returns (mock, expectation handle).
 func {{.MockName}}{{.TypeParamsDecl}}(t {{.PkgImptest}}.TestReporter) ({{.FuncSig}}, {{if .Method.HasParams}}*{{.Method.MethodTypeName}}{{.TypeParamsUse}}{{else}}*{{.PkgImptest}}.DependencyMethod{{end}}) {
 	ctrl := {{.PkgImptest}}.GetOrCreateImp(t)
-{{if .Method.HasParams}}	imp := new{{.Method.MethodTypeName}}{{.TypeParamsUse}}({{.PkgImptest}}.NewDependencyMethod(ctrl, "{{.FuncName}}"))
-{{else}}	imp := {{.PkgImptest}}.NewDependencyMethod(ctrl, "{{.FuncName}}")
+{{if .Method.HasParams}}	imp := new{{.Method.MethodTypeName}}{{.TypeParamsUse}}({{.PkgImptest}}.NewDependencyMethod(ctrl, "{{.FuncName}}").Results({{.Method.ResultTypes}}))
+{{else}}	imp := {{.PkgImptest}}.NewDependencyMethod(ctrl, "{{.FuncName}}").Results({{.Method.ResultTypes}})
 {{end}}	mock := func({{.Method.Params}}){{.Method.Results}} {
 		{{if .Method.HasVariadic}}callArgs := []any{ {{.Method.NonVariadicArgs}} }
 		for _, v := range {{.Method.VariadicArg}} {
@@ -156,9 +203,7 @@ func {{.MockName}}{{.TypeParamsDecl}}(t {{.PkgImptest}}.TestReporter) ({{.FuncSi
 		}
 		ctrl.CallChan <- call
 		resp := <-call.ResponseChan
-		if resp.Type == "panic" {
-			panic(resp.PanicValue)
-		}
+		resp.Resolve()
 		{{if .Method.HasResults}}{{range .Method.ResultVars}}
 		var {{.Name}} {{.Type}}
 		if len(resp.ReturnValues) > {{.Index}} {
@@ -197,9 +242,27 @@ func (m *{{.Method.MethodTypeName}}{{.TypeParamsUse}}) ArgsEqual({{.Method.Typed
 	return &{{.Method.CallTypeName}}{{.TypeParamsUse}}{DependencyCall: call}
 }
 
-// ArgsShould waits for a call with arguments matching the given matchers.
-func (m *{{.Method.MethodTypeName}}{{.TypeParamsUse}}) ArgsShould(matchers ...any) *{{.Method.CallTypeName}}{{.TypeParamsUse}} {
-	call := m.DependencyMethod.ArgsShould(matchers...)
+// ArgsShould waits for a call with arguments matching the given matchers, one per parameter.
+// Each matcher may also be a plain value, which is compared with reflect.DeepEqual.
+func (m *{{.Method.MethodTypeName}}{{.TypeParamsUse}}) ArgsShould({{.Method.MatcherParams}}) *{{.Method.CallTypeName}}{{.TypeParamsUse}} {
+	{{if .Method.HasVariadic}}_matchers := []any{ {{if .Method.NonVariadicArgs}}{{.Method.NonVariadicArgs}}{{end}} }
+	_matchers = append(_matchers, {{.Method.VariadicArg}}...)
+	_call := m.DependencyMethod.ArgsShould(_matchers...){{else}}_call := m.DependencyMethod.ArgsShould({{.Method.ArgNames}}){{end}}
+	return &{{.Method.CallTypeName}}{{.TypeParamsUse}}{DependencyCall: _call}
+}
+
+// ArgsWhere waits for a call whose typed arguments satisfy predicate.
+// The predicate returns nil for a match, or an error describing the mismatch.
+func (m *{{.Method.MethodTypeName}}{{.TypeParamsUse}}) ArgsWhere(predicate func({{.Method.ArgsTypeName}}{{.TypeParamsUse}}) error) *{{.Method.CallTypeName}}{{.TypeParamsUse}} {
+	call := m.DependencyMethod.ArgsWhere(func(raw []any) error {
+		var args {{.Method.ArgsTypeName}}{{.TypeParamsUse}}
+{{range .Method.ParamFields}}{{if .VariadicElem}}		for _, value := range raw[{{.Index}}:] {
+			elem, _ := value.({{.VariadicElem}})
+			args.{{.Name}} = append(args.{{.Name}}, elem)
+		}
+{{else}}		args.{{.Name}}, _ = raw[{{.Index}}].({{.Type}})
+{{end}}{{end}}		return predicate(args)
+	})
 	return &{{.Method.CallTypeName}}{{.TypeParamsUse}}{DependencyCall: call}
 }
 
@@ -245,8 +308,8 @@ func (h *{{.CallHandleType}}) Completes() {
 	h.T.Helper()
 	h.WaitForResponse()
 
-	if h.Panicked != nil {
-		h.T.Fatalf("expected method to complete, but it panicked with: %v", h.Panicked)
+	if h.Panicked != nil || h.Goexited {
+		h.T.Fatalf("expected method to complete, but %s", h.Outcome())
 	}
 }
 
@@ -264,7 +327,7 @@ func (h *{{.CallHandleType}}) PanicEquals(expected any) {
 		return
 	}
 
-	h.T.Fatalf("expected method to panic, but it returned")
+	h.T.Fatalf("expected method to panic, but %s", h.Outcome())
 }
 
 // PanicShould verifies the method panics with a value matching the given matcher.
@@ -273,14 +336,31 @@ func (h *{{.CallHandleType}}) PanicShould(matcher any) {
 	h.WaitForResponse()
 
 	if h.Panicked != nil {
-		ok, msg := {{.PkgImptest}}.MatchValue(h.Panicked, matcher)
+		ok, msg := {{.PkgImptest}}.MatchPanic(h.Panicked, h.PanicStack, matcher)
 		if !ok {
 			h.T.Fatalf("panic value: %s", msg)
 		}
 		return
 	}
 
-	h.T.Fatalf("expected method to panic, but it returned")
+	h.T.Fatalf("expected method to panic, but %s", h.Outcome())
+}
+
+// GoexitedShould verifies the method exited via runtime.Goexit (e.g. t.FailNow) without
+// returning or panicking. The matcher is applied to the goroutine's stack trace as a string.
+func (h *{{.CallHandleType}}) GoexitedShould(matcher any) {
+	h.T.Helper()
+	h.WaitForResponse()
+
+	if h.Goexited {
+		ok, msg := {{.PkgImptest}}.MatchValue(string(h.GoexitStack), matcher)
+		if !ok {
+			h.T.Fatalf("goexit stack: %s", msg)
+		}
+		return
+	}
+
+	h.T.Fatalf("expected method to exit via runtime.Goexit, but %s", h.Outcome())
 }
 
 `
@@ -290,13 +370,13 @@ func (h *{{.CallHandleType}}) ReturnsEqual({{.ExpectedParams}}) {
 	h.WaitForResponse()
 
 	if h.Returned != nil {
-		{{range .ResultChecks}}if !_reflect.DeepEqual(h.Returned.{{.Field}}, {{.Expected}}) {
-			h.T.Fatalf("expected return value {{.Index}} to be %v, got %v", {{.Expected}}, h.Returned.{{.Field}})
+		{{range .ResultChecks}}if ok, msg := {{$.PkgImptest}}.EqualValue(h.T, h.Returned.{{.Field}}, {{.Expected}}); !ok {
+			h.T.Fatalf("return value {{.Index}}: %s", msg)
 		}
 		{{end}}return
 	}
 
-	h.T.Fatalf("expected method to return, but it panicked with: %v", h.Panicked)
+	h.T.Fatalf("expected method to return, but %s", h.Outcome())
 }
 
 // ReturnsShould verifies the return values match the given matchers.
@@ -314,7 +394,7 @@ func (h *{{.CallHandleType}}) ReturnsShould({{.MatcherParams}}) {
 		{{end}}return
 	}
 
-	h.T.Fatalf("expected method to return, but it panicked with: %v", h.Panicked)
+	h.T.Fatalf("expected method to return, but %s", h.Outcome())
 }
 
 `
@@ -329,12 +409,16 @@ func (w *{{.WrapperType}}) Start({{.Params}}) *{{.CallHandleType}} {
 		CallableController: {{.PkgImptest}}.NewCallableController[{{.ReturnsType}}](w.t),
 	}
 	go func() {
+		_completed := false
 		defer func() {
 			if r := recover(); r != nil {
-				handle.PanicChan <- r
+				handle.RecordPanic(r)
+			} else if !_completed {
+				handle.RecordGoexit()
 			}
 		}()
 		returns := w.fn({{.ParamNames}})
+		_completed = true
 		handle.ReturnChan <- returns
 	}()
 	return handle
@@ -378,7 +462,7 @@ func (e *{{.CallHandleType}}Eventually{{.TypeParamsUse}}) ensureStarted() *{{.Pk
 		e.h.pendingCompletion = e.h.controller.RegisterPendingCompletion()
 		go func() {
 			e.h.WaitForResponse()
-			e.h.pendingCompletion.SetCompleted(e.h.Returned, e.h.Panicked)
+			e.h.pendingCompletion.SetCompleted(e.h.Completion())
 		}()
 	}
 	return e.h.pendingCompletion
@@ -403,13 +487,18 @@ func {{.WrapName}}{{.TypeParamsDecl}}(t {{.PkgImptest}}.TestReporter, fn {{.Func
 	}
 	handle.Eventually = &{{.CallHandleType}}Eventually{{.TypeParamsUse}}{h: handle}
 	go func() {
+		_completed := false
 		defer func() {
 			if r := recover(); r != nil {
-				handle.PanicChan <- r
+				handle.RecordPanic(r)
+			} else if !_completed {
+				handle.RecordGoexit()
 			}
 		}()
 		{{if .HasResults}}{{.ResultVars}} := fn({{.ParamNames}})
+		_completed = true
 		handle.ReturnChan <- {{.ReturnsType}}Return{{.TypeParamsUse}}{ {{.ReturnAssignments}} }{{else}}fn({{.ParamNames}})
+		_completed = true
 		handle.ReturnChan <- {{.ReturnsType}}Return{{.TypeParamsUse}}{}{{end}}
 	}()
 	return handle
@@ -421,8 +510,8 @@ func (h *{{.CallHandleType}}{{.TypeParamsUse}}) Completes() {
 	h.T.Helper()
 	h.WaitForResponse()
 
-	if h.Panicked != nil {
-		h.T.Fatalf("expected function to complete, but it panicked with: %v", h.Panicked)
+	if h.Panicked != nil || h.Goexited {
+		h.T.Fatalf("expected function to complete, but %s", h.Outcome())
 	}
 }
 
@@ -440,7 +529,7 @@ func (h *{{.CallHandleType}}{{.TypeParamsUse}}) PanicEquals(expected any) {
 		return
 	}
 
-	h.T.Fatalf("expected function to panic, but it returned")
+	h.T.Fatalf("expected function to panic, but %s", h.Outcome())
 }
 
 // PanicShould verifies the function panics with a value matching the given matcher.
@@ -449,14 +538,31 @@ func (h *{{.CallHandleType}}{{.TypeParamsUse}}) PanicShould(matcher any) {
 	h.WaitForResponse()
 
 	if h.Panicked != nil {
-		ok, msg := {{.PkgImptest}}.MatchValue(h.Panicked, matcher)
+		ok, msg := {{.PkgImptest}}.MatchPanic(h.Panicked, h.PanicStack, matcher)
 		if !ok {
 			h.T.Fatalf("panic value: %s", msg)
 		}
 		return
 	}
 
-	h.T.Fatalf("expected function to panic, but it returned")
+	h.T.Fatalf("expected function to panic, but %s", h.Outcome())
+}
+
+// GoexitedShould verifies the function exited via runtime.Goexit (e.g. t.FailNow) without
+// returning or panicking. The matcher is applied to the goroutine's stack trace as a string.
+func (h *{{.CallHandleType}}{{.TypeParamsUse}}) GoexitedShould(matcher any) {
+	h.T.Helper()
+	h.WaitForResponse()
+
+	if h.Goexited {
+		ok, msg := {{.PkgImptest}}.MatchValue(string(h.GoexitStack), matcher)
+		if !ok {
+			h.T.Fatalf("goexit stack: %s", msg)
+		}
+		return
+	}
+
+	h.T.Fatalf("expected function to exit via runtime.Goexit, but %s", h.Outcome())
 }
 
 `
@@ -466,13 +572,13 @@ func (h *{{.CallHandleType}}{{.TypeParamsUse}}) ReturnsEqual({{.ExpectedParams}}
 	h.WaitForResponse()
 
 	if h.Returned != nil {
-		{{range .ResultChecks}}if !_reflect.DeepEqual(h.Returned.{{.Field}}, {{.Expected}}) {
-			h.T.Fatalf("expected return value {{.Index}} to be %v, got %v", {{.Expected}}, h.Returned.{{.Field}})
+		{{range .ResultChecks}}if ok, msg := {{$.PkgImptest}}.EqualValue(h.T, h.Returned.{{.Field}}, {{.Expected}}); !ok {
+			h.T.Fatalf("return value {{.Index}}: %s", msg)
 		}
 		{{end}}return
 	}
 
-	h.T.Fatalf("expected function to return, but it panicked with: %v", h.Panicked)
+	h.T.Fatalf("expected function to return, but %s", h.Outcome())
 }
 
 // ReturnsShould verifies the return values match the given matchers.
@@ -490,7 +596,7 @@ func (h *{{.CallHandleType}}{{.TypeParamsUse}}) ReturnsShould({{.MatcherParams}}
 		{{end}}return
 	}
 
-	h.T.Fatalf("expected function to return, but it panicked with: %v", h.Panicked)
+	h.T.Fatalf("expected function to return, but %s", h.Outcome())
 }
 
 `
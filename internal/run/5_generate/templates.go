@@ -19,8 +19,9 @@ type baseTemplateData struct {
 	// Framework packages are always imported with underscore prefix to avoid conflicts.
 	// User's package (Qualifier/PkgPath) is imported without alias.
 	NeedsFmt     bool // Whether fmt import is needed for Sprintf
-	NeedsReflect bool // Whether reflect import is needed for DeepEqual
+	NeedsReflect bool // Whether reflect import is needed for DeepEqual or result types
 	NeedsImptest bool // Whether imptest import is needed for matchers
+	NeedsTime    bool // Whether time import is needed for durations
 
 	// Additional imports needed for external types used in method signatures
 	AdditionalImports []importInfo
@@ -60,6 +61,7 @@ type depMethodTemplateData struct {
 	ArgNames        string // Comma-separated argument names
 	HasResults      bool
 	ResultVars      []resultVar
+	ResultTypes     string          // Comma-separated reflect.Type expressions for the results
 	ReturnList      string          // Comma-separated return variable names
 	ReturnStatement string          // Return statement (e.g., "return r1, r2")
 	Callbacks       []callbackParam // Callback function parameters
@@ -72,25 +74,28 @@ type depMethodTemplateData struct {
 	CallTypeName   string       // Call wrapper type name (e.g., "CalculatorAddCall")
 	MethodTypeName string       // Method wrapper type name (e.g., "CalculatorAddMethod")
 	TypedParams    string       // Typed parameter list for Expect (e.g., "a int, b int")
+	MatcherParams  string       // Matcher parameter list for ArgsShould (e.g., "a any, b ...any")
 
 	// Type-safe return value support
 	TypedReturnParams string // Typed return parameter list for Return (e.g., "result0 int, result1 error")
 	ReturnParamNames  string // Comma-separated return parameter names (e.g., "result0, result1")
+	ClosesChannels    bool   // Whether to generate CloseReturnedChannels: the mock returns channels
 }
 
 type depTemplateData struct {
 	baseTemplateData //nolint:unused // Embedded struct accessed by templates
 
-	MockName      string                  // Constructor function name (e.g., "MockOps")
-	MockTypeName  string                  // Struct type name (e.g., "OpsMock")
-	ImpTypeName   string                  // Expectation handle type name (e.g., "OpsImp")
-	BaseName      string                  // Base interface name without "Mock" prefix
-	InterfaceName string                  // Local interface name (e.g., "Ops")
-	InterfaceType string                  // Qualified interface type (e.g., "basic.Ops")
-	ImplName      string                  // Implementation struct name (e.g., "mockOpsImpl")
-	MethodNames   []string                // List of interface method names
-	Methods       []depMethodTemplateData // Full method data for generating wrappers
-	IsStructType  bool                    // True if mocking a struct type (needs synthetic interface)
+	MockName        string                  // Constructor function name (e.g., "MockOps")
+	MockTypeName    string                  // Struct type name (e.g., "OpsMock")
+	ImpTypeName     string                  // Expectation handle type name (e.g., "OpsImp")
+	BaseName        string                  // Base interface name without "Mock" prefix
+	InterfaceName   string                  // Local interface name (e.g., "Ops")
+	InterfaceType   string                  // Qualified interface type (e.g., "basic.Ops")
+	ImplName        string                  // Implementation struct name (e.g., "mockOpsImpl")
+	MethodNames     []string                // List of interface method names
+	Methods         []depMethodTemplateData // Full method data for generating wrappers
+	IsStructType    bool                    // True if mocking a struct type (needs synthetic interface)
+	UnmockedMethods []depMethodTemplateData // Methods left out by --methods or --exclude-methods; they only panic
 }
 
 type importInfo struct {
@@ -136,9 +141,12 @@ type methodWrapperData struct {
 }
 
 type paramField struct {
-	Name  string // Field name (e.g., "A", "B", "Key")
-	Type  string // Field type (e.g., "int", "string")
-	Index int    // Zero-based index in args array
+	Name         string // Field name (e.g., "A", "B", "Key")
+	Type         string // Field type (e.g., "int", "string")
+	Index        int    // Zero-based index in args array
+	OutKind      string // Out-parameter helper to generate: "slice", "pointer", or ""
+	ElemType     string // Value type the out-parameter helper accepts (e.g., "User" for *User)
+	VariadicElem string // Element type if the parameter is variadic (e.g., "int" for ...int), else ""
 }
 
 type resultCheck struct {
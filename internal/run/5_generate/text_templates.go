@@ -3,6 +3,9 @@ package generate
 import (
 	"bytes"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 	"text/template"
 )
 
@@ -17,6 +20,7 @@ type TemplateRegistry struct {
 	depArgsStructTmpl      *template.Template
 	depCallWrapperTmpl     *template.Template
 	depMethodWrapperTmpl   *template.Template
+	depUnmockedMethodTmpl  *template.Template
 	// Target wrapper templates
 	targetHeaderTmpl           *template.Template
 	targetConstructorTmpl      *template.Template
@@ -49,283 +53,218 @@ type TemplateRegistry struct {
 // NewTemplateRegistry creates and initializes a new template registry with all templates parsed.
 // Templates are hardcoded constants, so parsing cannot fail at runtime.
 func NewTemplateRegistry() *TemplateRegistry {
+	registry, err := NewTemplateRegistryWithOverrides(nil)
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse built-in templates: %v", err))
+	}
+
+	return registry
+}
+
+// NewTemplateRegistryWithOverrides creates a template registry in which each entry of overrides replaces the
+// built-in template of that name (e.g. "depImplMethod"). Overrides execute with the same data as the templates
+// they replace. Unknown names and overrides that fail to parse are errors.
+func NewTemplateRegistryWithOverrides(overrides map[string]string) (*TemplateRegistry, error) {
 	registry := &TemplateRegistry{}
+	pending := maps.Clone(overrides)
 
-	registry.parseDependencyTemplates()
-	registry.parseTargetTemplates()
-	registry.parseInterfaceTargetTemplates()
-	registry.parseFunctionDependencyTemplates()
+	for _, parse := range []func(map[string]string) error{
+		registry.parseDependencyTemplates,
+		registry.parseTargetTemplates,
+		registry.parseInterfaceTargetTemplates,
+		registry.parseFunctionDependencyTemplates,
+	} {
+		err := parse(pending)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	return registry
+	if len(pending) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTemplate, strings.Join(slices.Sorted(maps.Keys(pending)), ", "))
+	}
+
+	return registry, nil
 }
 
 // WriteDepArgsStruct writes the dependency args struct.
-func (r *TemplateRegistry) WriteDepArgsStruct(buf *bytes.Buffer, data any) {
-	err := r.depArgsStructTmpl.Execute(buf, data)
-	if err != nil {
-		panic(fmt.Sprintf("failed to execute depArgsStruct template: %v", err))
-	}
+func (r *TemplateRegistry) WriteDepArgsStruct(buf *bytes.Buffer, data any) error {
+	return execute(r.depArgsStructTmpl, buf, data)
 }
 
 // WriteDepCallWrapper writes the dependency call wrapper.
-func (r *TemplateRegistry) WriteDepCallWrapper(buf *bytes.Buffer, data any) {
-	err := r.depCallWrapperTmpl.Execute(buf, data)
-	if err != nil {
-		panic(fmt.Sprintf("failed to execute depCallWrapper template: %v", err))
-	}
+func (r *TemplateRegistry) WriteDepCallWrapper(buf *bytes.Buffer, data any) error {
+	return execute(r.depCallWrapperTmpl, buf, data)
 }
 
 // WriteDepConstructor writes the dependency mock constructor.
-func (r *TemplateRegistry) WriteDepConstructor(buf *bytes.Buffer, data any) {
-	err := r.depConstructorTmpl.Execute(buf, data)
-	if err != nil {
-		panic(fmt.Sprintf("failed to execute depConstructor template: %v", err))
-	}
+func (r *TemplateRegistry) WriteDepConstructor(buf *bytes.Buffer, data any) error {
+	return execute(r.depConstructorTmpl, buf, data)
 }
 
 // WriteDepHeader writes the dependency mock header.
-func (r *TemplateRegistry) WriteDepHeader(buf *bytes.Buffer, data any) {
-	err := r.depHeaderTmpl.Execute(buf, data)
-	if err != nil {
-		panic(fmt.Sprintf("failed to execute depHeader template: %v", err))
-	}
+func (r *TemplateRegistry) WriteDepHeader(buf *bytes.Buffer, data any) error {
+	return execute(r.depHeaderTmpl, buf, data)
 }
 
 // WriteDepImplMethod writes a dependency implementation method.
-func (r *TemplateRegistry) WriteDepImplMethod(buf *bytes.Buffer, data any) {
-	err := r.depImplMethodTmpl.Execute(buf, data)
-	if err != nil {
-		panic(fmt.Sprintf("failed to execute depImplMethod template: %v", err))
-	}
+func (r *TemplateRegistry) WriteDepImplMethod(buf *bytes.Buffer, data any) error {
+	return execute(r.depImplMethodTmpl, buf, data)
 }
 
 // WriteDepImplStruct writes the dependency implementation struct.
-func (r *TemplateRegistry) WriteDepImplStruct(buf *bytes.Buffer, data any) {
-	err := r.depImplStructTmpl.Execute(buf, data)
-	if err != nil {
-		panic(fmt.Sprintf("failed to execute depImplStruct template: %v", err))
-	}
+func (r *TemplateRegistry) WriteDepImplStruct(buf *bytes.Buffer, data any) error {
+	return execute(r.depImplStructTmpl, buf, data)
 }
 
 // WriteDepInterfaceMethod writes the dependency Interface() method.
-func (r *TemplateRegistry) WriteDepInterfaceMethod(buf *bytes.Buffer, data any) {
-	err := r.depInterfaceMethodTmpl.Execute(buf, data)
-	if err != nil {
-		panic(fmt.Sprintf("failed to execute depInterfaceMethod template: %v", err))
-	}
+func (r *TemplateRegistry) WriteDepInterfaceMethod(buf *bytes.Buffer, data any) error {
+	return execute(r.depInterfaceMethodTmpl, buf, data)
 }
 
 // WriteDepMethodWrapper writes the dependency method wrapper.
-func (r *TemplateRegistry) WriteDepMethodWrapper(buf *bytes.Buffer, data any) {
-	err := r.depMethodWrapperTmpl.Execute(buf, data)
-	if err != nil {
-		panic(fmt.Sprintf("failed to execute depMethodWrapper template: %v", err))
-	}
+func (r *TemplateRegistry) WriteDepMethodWrapper(buf *bytes.Buffer, data any) error {
+	return execute(r.depMethodWrapperTmpl, buf, data)
 }
 
 // WriteDepMockStruct writes the dependency mock struct.
-func (r *TemplateRegistry) WriteDepMockStruct(buf *bytes.Buffer, data any) {
-	err := r.depMockStructTmpl.Execute(buf, data)
-	if err != nil {
-		panic(fmt.Sprintf("failed to execute depMockStruct template: %v", err))
-	}
+func (r *TemplateRegistry) WriteDepMockStruct(buf *bytes.Buffer, data any) error {
+	return execute(r.depMockStructTmpl, buf, data)
+}
+
+// WriteDepUnmockedMethod writes a dependency implementation method that panics, for a method left unmocked.
+func (r *TemplateRegistry) WriteDepUnmockedMethod(buf *bytes.Buffer, data any) error {
+	return execute(r.depUnmockedMethodTmpl, buf, data)
 }
 
 // WriteFuncDepConstructor writes the function dependency mock constructor.
-func (r *TemplateRegistry) WriteFuncDepConstructor(buf *bytes.Buffer, data any) {
-	err := r.funcDepConstructorTmpl.Execute(buf, data)
-	if err != nil {
-		panic(fmt.Sprintf("failed to execute funcDepConstructor template: %v", err))
-	}
+func (r *TemplateRegistry) WriteFuncDepConstructor(buf *bytes.Buffer, data any) error {
+	return execute(r.funcDepConstructorTmpl, buf, data)
 }
 
 // WriteFuncDepFuncMethod writes the function dependency Func() method.
 
 // WriteFuncDepMethodWrapper writes the function dependency method wrapper.
-func (r *TemplateRegistry) WriteFuncDepMethodWrapper(buf *bytes.Buffer, data any) {
-	err := r.funcDepMethodWrapperTmpl.Execute(buf, data)
-	if err != nil {
-		panic(fmt.Sprintf("failed to execute funcDepMethodWrapper template: %v", err))
-	}
+func (r *TemplateRegistry) WriteFuncDepMethodWrapper(buf *bytes.Buffer, data any) error {
+	return execute(r.funcDepMethodWrapperTmpl, buf, data)
 }
 
 // WriteFuncDepMockStruct writes the function dependency mock struct.
 // Template is empty (two-return style doesn't need Handle struct) but call is kept for structural consistency.
-func (r *TemplateRegistry) WriteFuncDepMockStruct(buf *bytes.Buffer, data any) {
-	_ = r.funcDepMockStructTmpl.Execute(buf, data)
+func (r *TemplateRegistry) WriteFuncDepMockStruct(buf *bytes.Buffer, data any) error {
+	return execute(r.funcDepMockStructTmpl, buf, data)
 }
 
 // WriteInterfaceTargetConstructor writes the interface target constructor.
-func (r *TemplateRegistry) WriteInterfaceTargetConstructor(buf *bytes.Buffer, data any) {
-	err := r.interfaceTargetConstructorTmpl.Execute(buf, data)
-	if err != nil {
-		panic(fmt.Sprintf("failed to execute interfaceTargetConstructor template: %v", err))
-	}
+func (r *TemplateRegistry) WriteInterfaceTargetConstructor(buf *bytes.Buffer, data any) error {
+	return execute(r.interfaceTargetConstructorTmpl, buf, data)
 }
 
 // WriteInterfaceTargetHeader writes the interface target header.
-func (r *TemplateRegistry) WriteInterfaceTargetHeader(buf *bytes.Buffer, data any) {
-	err := r.interfaceTargetHeaderTmpl.Execute(buf, data)
-	if err != nil {
-		panic(fmt.Sprintf("failed to execute interfaceTargetHeader template: %v", err))
-	}
+func (r *TemplateRegistry) WriteInterfaceTargetHeader(buf *bytes.Buffer, data any) error {
+	return execute(r.interfaceTargetHeaderTmpl, buf, data)
 }
 
 // WriteInterfaceTargetMethodCallHandleStruct writes the interface target method call handle struct.
-func (r *TemplateRegistry) WriteInterfaceTargetMethodCallHandleStruct(buf *bytes.Buffer, data any) {
-	err := r.interfaceTargetMethodCallHandleStructTmpl.Execute(buf, data)
-	if err != nil {
-		panic(
-			fmt.Sprintf(
-				"failed to execute interfaceTargetMethodCallHandleStruct template: %v",
-				err,
-			),
-		)
-	}
+func (r *TemplateRegistry) WriteInterfaceTargetMethodCallHandleStruct(buf *bytes.Buffer, data any) error {
+	return execute(r.interfaceTargetMethodCallHandleStructTmpl, buf, data)
 }
 
 // WriteInterfaceTargetMethodExpectCompletes writes the interface target method ExpectCompletes method.
-func (r *TemplateRegistry) WriteInterfaceTargetMethodExpectCompletes(buf *bytes.Buffer, data any) {
-	err := r.interfaceTargetMethodExpectCompletesTmpl.Execute(buf, data)
-	if err != nil {
-		panic(
-			fmt.Sprintf("failed to execute interfaceTargetMethodExpectCompletes template: %v", err),
-		)
-	}
+func (r *TemplateRegistry) WriteInterfaceTargetMethodExpectCompletes(buf *bytes.Buffer, data any) error {
+	return execute(r.interfaceTargetMethodExpectCompletesTmpl, buf, data)
 }
 
 // WriteInterfaceTargetMethodExpectPanic writes the interface target method ExpectPanic methods.
-func (r *TemplateRegistry) WriteInterfaceTargetMethodExpectPanic(buf *bytes.Buffer, data any) {
-	err := r.interfaceTargetMethodExpectPanicTmpl.Execute(buf, data)
-	if err != nil {
-		panic(fmt.Sprintf("failed to execute interfaceTargetMethodExpectPanic template: %v", err))
-	}
+func (r *TemplateRegistry) WriteInterfaceTargetMethodExpectPanic(buf *bytes.Buffer, data any) error {
+	return execute(r.interfaceTargetMethodExpectPanicTmpl, buf, data)
 }
 
 // WriteInterfaceTargetMethodExpectReturns writes the interface target method ExpectReturns methods.
-func (r *TemplateRegistry) WriteInterfaceTargetMethodExpectReturns(buf *bytes.Buffer, data any) {
-	err := r.interfaceTargetMethodExpectReturnsTmpl.Execute(buf, data)
-	if err != nil {
-		panic(fmt.Sprintf("failed to execute interfaceTargetMethodExpectReturns template: %v", err))
-	}
+func (r *TemplateRegistry) WriteInterfaceTargetMethodExpectReturns(buf *bytes.Buffer, data any) error {
+	return execute(r.interfaceTargetMethodExpectReturnsTmpl, buf, data)
 }
 
 // WriteInterfaceTargetMethodReturns writes the interface target method returns struct and methods.
-func (r *TemplateRegistry) WriteInterfaceTargetMethodReturns(buf *bytes.Buffer, data any) {
-	err := r.interfaceTargetMethodReturnsTmpl.Execute(buf, data)
-	if err != nil {
-		panic(fmt.Sprintf("failed to execute interfaceTargetMethodReturns template: %v", err))
-	}
+func (r *TemplateRegistry) WriteInterfaceTargetMethodReturns(buf *bytes.Buffer, data any) error {
+	return execute(r.interfaceTargetMethodReturnsTmpl, buf, data)
 }
 
 // WriteInterfaceTargetMethodStart writes the interface target method Start method.
-func (r *TemplateRegistry) WriteInterfaceTargetMethodStart(buf *bytes.Buffer, data any) {
-	err := r.interfaceTargetMethodStartTmpl.Execute(buf, data)
-	if err != nil {
-		panic(fmt.Sprintf("failed to execute interfaceTargetMethodStart template: %v", err))
-	}
+func (r *TemplateRegistry) WriteInterfaceTargetMethodStart(buf *bytes.Buffer, data any) error {
+	return execute(r.interfaceTargetMethodStartTmpl, buf, data)
 }
 
 // WriteInterfaceTargetMethodWrapperFunc writes the interface target method wrapper function.
-func (r *TemplateRegistry) WriteInterfaceTargetMethodWrapperFunc(buf *bytes.Buffer, data any) {
-	err := r.interfaceTargetMethodWrapperFuncTmpl.Execute(buf, data)
-	if err != nil {
-		panic(fmt.Sprintf("failed to execute interfaceTargetMethodWrapperFunc template: %v", err))
-	}
+func (r *TemplateRegistry) WriteInterfaceTargetMethodWrapperFunc(buf *bytes.Buffer, data any) error {
+	return execute(r.interfaceTargetMethodWrapperFuncTmpl, buf, data)
 }
 
 // WriteInterfaceTargetMethodWrapperStruct writes the interface target method wrapper struct.
-func (r *TemplateRegistry) WriteInterfaceTargetMethodWrapperStruct(buf *bytes.Buffer, data any) {
-	err := r.interfaceTargetMethodWrapperStructTmpl.Execute(buf, data)
-	if err != nil {
-		panic(fmt.Sprintf("failed to execute interfaceTargetMethodWrapperStruct template: %v", err))
-	}
+func (r *TemplateRegistry) WriteInterfaceTargetMethodWrapperStruct(buf *bytes.Buffer, data any) error {
+	return execute(r.interfaceTargetMethodWrapperStructTmpl, buf, data)
 }
 
 // WriteInterfaceTargetWrapperStruct writes the interface target wrapper struct.
-func (r *TemplateRegistry) WriteInterfaceTargetWrapperStruct(buf *bytes.Buffer, data any) {
-	err := r.interfaceTargetWrapperStructTmpl.Execute(buf, data)
-	if err != nil {
-		panic(fmt.Sprintf("failed to execute interfaceTargetWrapperStruct template: %v", err))
-	}
+func (r *TemplateRegistry) WriteInterfaceTargetWrapperStruct(buf *bytes.Buffer, data any) error {
+	return execute(r.interfaceTargetWrapperStructTmpl, buf, data)
 }
 
 // WriteTargetCallHandleStruct writes the target call handle struct.
-func (r *TemplateRegistry) WriteTargetCallHandleStruct(buf *bytes.Buffer, data any) {
-	err := r.targetCallHandleStructTmpl.Execute(buf, data)
-	if err != nil {
-		panic(fmt.Sprintf("failed to execute targetCallHandleStruct template: %v", err))
-	}
+func (r *TemplateRegistry) WriteTargetCallHandleStruct(buf *bytes.Buffer, data any) error {
+	return execute(r.targetCallHandleStructTmpl, buf, data)
 }
 
 // WriteTargetConstructor writes the target wrapper constructor.
-func (r *TemplateRegistry) WriteTargetConstructor(buf *bytes.Buffer, data any) {
-	err := r.targetConstructorTmpl.Execute(buf, data)
-	if err != nil {
-		panic(fmt.Sprintf("failed to execute targetConstructor template: %v", err))
-	}
+func (r *TemplateRegistry) WriteTargetConstructor(buf *bytes.Buffer, data any) error {
+	return execute(r.targetConstructorTmpl, buf, data)
 }
 
 // WriteTargetExpectCompletes writes the target ExpectCompletes method.
-func (r *TemplateRegistry) WriteTargetExpectCompletes(buf *bytes.Buffer, data any) {
-	err := r.targetExpectCompletesTmpl.Execute(buf, data)
-	if err != nil {
-		panic(fmt.Sprintf("failed to execute targetExpectCompletes template: %v", err))
-	}
+func (r *TemplateRegistry) WriteTargetExpectCompletes(buf *bytes.Buffer, data any) error {
+	return execute(r.targetExpectCompletesTmpl, buf, data)
 }
 
 // WriteTargetExpectPanic writes the target ExpectPanic methods.
-func (r *TemplateRegistry) WriteTargetExpectPanic(buf *bytes.Buffer, data any) {
-	err := r.targetExpectPanicTmpl.Execute(buf, data)
-	if err != nil {
-		panic(fmt.Sprintf("failed to execute targetExpectPanic template: %v", err))
-	}
+func (r *TemplateRegistry) WriteTargetExpectPanic(buf *bytes.Buffer, data any) error {
+	return execute(r.targetExpectPanicTmpl, buf, data)
 }
 
 // WriteTargetExpectReturns writes the target ExpectReturns methods.
-func (r *TemplateRegistry) WriteTargetExpectReturns(buf *bytes.Buffer, data any) {
-	err := r.targetExpectReturnsTmpl.Execute(buf, data)
-	if err != nil {
-		panic(fmt.Sprintf("failed to execute targetExpectReturns template: %v", err))
-	}
+func (r *TemplateRegistry) WriteTargetExpectReturns(buf *bytes.Buffer, data any) error {
+	return execute(r.targetExpectReturnsTmpl, buf, data)
 }
 
 // WriteTargetHeader writes the target wrapper header.
-func (r *TemplateRegistry) WriteTargetHeader(buf *bytes.Buffer, data any) {
-	err := r.targetHeaderTmpl.Execute(buf, data)
-	if err != nil {
-		panic(fmt.Sprintf("failed to execute targetHeader template: %v", err))
-	}
+func (r *TemplateRegistry) WriteTargetHeader(buf *bytes.Buffer, data any) error {
+	return execute(r.targetHeaderTmpl, buf, data)
 }
 
 // WriteTargetReturnsStruct writes the target returns struct.
-func (r *TemplateRegistry) WriteTargetReturnsStruct(buf *bytes.Buffer, data any) {
-	err := r.targetReturnsStructTmpl.Execute(buf, data)
-	if err != nil {
-		panic(fmt.Sprintf("failed to execute targetReturnsStruct template: %v", err))
-	}
+func (r *TemplateRegistry) WriteTargetReturnsStruct(buf *bytes.Buffer, data any) error {
+	return execute(r.targetReturnsStructTmpl, buf, data)
 }
 
 // WriteTargetStartMethod writes the target Start method.
-// Note: This template is currently empty, so Execute cannot fail.
-func (r *TemplateRegistry) WriteTargetStartMethod(buf *bytes.Buffer, data any) {
-	_ = r.targetStartMethodTmpl.Execute(buf, data)
+// Note: The built-in template is empty, but a --templates override may fill it in.
+func (r *TemplateRegistry) WriteTargetStartMethod(buf *bytes.Buffer, data any) error {
+	return execute(r.targetStartMethodTmpl, buf, data)
 }
 
 // WriteTargetWaitMethod writes the target Wait method.
-// Note: This template is currently empty, so Execute cannot fail.
-func (r *TemplateRegistry) WriteTargetWaitMethod(buf *bytes.Buffer, data any) {
-	_ = r.targetWaitMethodTmpl.Execute(buf, data)
+// Note: The built-in template is empty, but a --templates override may fill it in.
+func (r *TemplateRegistry) WriteTargetWaitMethod(buf *bytes.Buffer, data any) error {
+	return execute(r.targetWaitMethodTmpl, buf, data)
 }
 
 // WriteTargetWrapperStruct writes the target wrapper struct.
-// Note: This template is currently empty, so Execute cannot fail.
-func (r *TemplateRegistry) WriteTargetWrapperStruct(buf *bytes.Buffer, data any) {
-	_ = r.targetWrapperStructTmpl.Execute(buf, data)
+// Note: The built-in template is empty, but a --templates override may fill it in.
+func (r *TemplateRegistry) WriteTargetWrapperStruct(buf *bytes.Buffer, data any) error {
+	return execute(r.targetWrapperStructTmpl, buf, data)
 }
 
 // parseDependencyTemplates parses all dependency mock templates.
-func (r *TemplateRegistry) parseDependencyTemplates() {
+func (r *TemplateRegistry) parseDependencyTemplates(overrides map[string]string) error {
 	templates := []struct {
 		target  **template.Template
 		name    string
@@ -340,13 +279,14 @@ func (r *TemplateRegistry) parseDependencyTemplates() {
 		{&r.depArgsStructTmpl, "depArgsStruct", tmplDepArgsStruct},
 		{&r.depCallWrapperTmpl, "depCallWrapper", tmplDepCallWrapper},
 		{&r.depMethodWrapperTmpl, "depMethodWrapper", tmplDepMethodWrapper},
+		{&r.depUnmockedMethodTmpl, "depUnmockedMethod", tmplDepUnmockedMethod},
 	}
 
-	parseTemplateList(templates)
+	return parseTemplateList(templates, overrides)
 }
 
 // parseFunctionDependencyTemplates parses all function dependency mock templates.
-func (r *TemplateRegistry) parseFunctionDependencyTemplates() {
+func (r *TemplateRegistry) parseFunctionDependencyTemplates(overrides map[string]string) error {
 	templates := []struct {
 		target  **template.Template
 		name    string
@@ -357,11 +297,11 @@ func (r *TemplateRegistry) parseFunctionDependencyTemplates() {
 		{&r.funcDepMethodWrapperTmpl, "funcDepMethodWrapper", tmplFuncDepMethodWrapper},
 	}
 
-	parseTemplateList(templates)
+	return parseTemplateList(templates, overrides)
 }
 
 // parseInterfaceTargetTemplates parses all interface target wrapper templates.
-func (r *TemplateRegistry) parseInterfaceTargetTemplates() {
+func (r *TemplateRegistry) parseInterfaceTargetTemplates(overrides map[string]string) error {
 	templates := []struct {
 		target  **template.Template
 		name    string
@@ -420,11 +360,11 @@ func (r *TemplateRegistry) parseInterfaceTargetTemplates() {
 		},
 	}
 
-	parseTemplateList(templates)
+	return parseTemplateList(templates, overrides)
 }
 
 // parseTargetTemplates parses all target wrapper templates.
-func (r *TemplateRegistry) parseTargetTemplates() {
+func (r *TemplateRegistry) parseTargetTemplates(overrides map[string]string) error {
 	templates := []struct {
 		target  **template.Template
 		name    string
@@ -442,25 +382,44 @@ func (r *TemplateRegistry) parseTargetTemplates() {
 		{&r.targetReturnsStructTmpl, "targetReturnsStruct", tmplTargetReturnsStruct},
 	}
 
-	parseTemplateList(templates)
+	return parseTemplateList(templates, overrides)
 }
 
-// parseTemplate is a helper function that parses a template using template.Must().
-// Templates are hardcoded constants, so parsing cannot fail at runtime.
-// Panics if the template is invalid (programming error, caught at startup).
-func parseTemplate(name, content string) *template.Template {
-	return template.Must(template.New(name).Parse(content))
+// execute runs tmpl with data into buf, naming the template in any error.
+func execute(tmpl *template.Template, buf *bytes.Buffer, data any) error {
+	err := tmpl.Execute(buf, data)
+	if err != nil {
+		return fmt.Errorf("failed to execute %s template: %w", tmpl.Name(), err)
+	}
+
+	return nil
 }
 
-// parseTemplateList parses a list of templates and assigns them to their targets.
-// Uses template.Must() internally, so panics on invalid templates.
+// parseTemplateList parses a list of templates and assigns them to their targets. A template named in overrides
+// is parsed from the override content instead, and its entry is removed so that leftovers can be reported as unknown.
 func parseTemplateList(templates []struct {
 	target  **template.Template
 	name    string
 	content string
-},
-) {
+}, overrides map[string]string,
+) error {
 	for _, def := range templates {
-		*def.target = parseTemplate(def.name, def.content)
+		content := def.content
+
+		override, ok := overrides[def.name]
+		if ok {
+			content = override
+
+			delete(overrides, def.name)
+		}
+
+		tmpl, err := template.New(def.name).Parse(content)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s template: %w", def.name, err)
+		}
+
+		*def.target = tmpl
 	}
+
+	return nil
 }
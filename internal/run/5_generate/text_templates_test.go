@@ -3,6 +3,7 @@ package generate
 
 import (
 	"bytes"
+	"errors"
 	"reflect"
 	"strings"
 	"testing"
@@ -14,47 +15,83 @@ import (
 
 // Test with no params
 
-//nolint:tparallel,paralleltest // Tests panic behavior which needs sequential execution per subtest
-func TestTemplateRegistry_WritePanicPaths(t *testing.T) {
+func TestNewTemplateRegistryWithOverrides(t *testing.T) {
+	t.Parallel()
+
+	t.Run("override replaces the named template", func(t *testing.T) {
+		t.Parallel()
+
+		registry, err := NewTemplateRegistryWithOverrides(map[string]string{
+			"targetWaitMethod": "// wait for {{.ImpName}}\n",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		buf := &bytes.Buffer{}
+
+		err = registry.WriteTargetWaitMethod(buf, struct{ ImpName string }{ImpName: "WrapAdd"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got := buf.String(); got != "// wait for WrapAdd\n" {
+			t.Errorf("expected override output, got %q", got)
+		}
+	})
+
+	t.Run("unknown template name", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewTemplateRegistryWithOverrides(map[string]string{"depImplMethodd": ""})
+		if !errors.Is(err, ErrUnknownTemplate) {
+			t.Fatalf("expected ErrUnknownTemplate, got %v", err)
+		}
+
+		if !strings.Contains(err.Error(), "depImplMethodd") {
+			t.Errorf("expected error to name the override, got %v", err)
+		}
+	})
+
+	t.Run("override that fails to parse", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewTemplateRegistryWithOverrides(map[string]string{"depHeader": "{{.PkgName"})
+		if err == nil || !strings.Contains(err.Error(), "failed to parse depHeader template") {
+			t.Fatalf("expected parse error naming depHeader, got %v", err)
+		}
+	})
+}
+
+func TestTemplateRegistry_WriteErrorPaths(t *testing.T) {
 	t.Parallel()
 
 	registry := NewTemplateRegistry()
 
 	for _, testCase := range allTemplateWriteTests() {
 		t.Run(testCase.name, func(t *testing.T) {
-			buf := &bytes.Buffer{}
-
-			defer func() {
-				recovered := recover()
-				if recovered == nil {
-					t.Errorf("%s: expected panic for invalid template data", testCase.name)
-					return
-				}
-
-				msg, isString := recovered.(string)
-				if !isString {
-					t.Errorf("%s: expected string panic, got %T", testCase.name, recovered)
-					return
-				}
-
-				if !strings.Contains(msg, testCase.panicMsg) {
-					t.Errorf("%s: unexpected panic message: %s", testCase.name, msg)
-				}
-			}()
-
-			testCase.writeFunc(registry, buf)
+			t.Parallel()
+
+			err := testCase.writeFunc(registry, &bytes.Buffer{})
+			if err == nil {
+				t.Fatalf("%s: expected error for invalid template data", testCase.name)
+			}
+
+			if !strings.Contains(err.Error(), testCase.errMsg) {
+				t.Errorf("%s: unexpected error message: %v", testCase.name, err)
+			}
 		})
 	}
 }
 
 type templateWriteTest struct {
 	name      string
-	writeFunc func(registry *TemplateRegistry, buf *bytes.Buffer)
-	panicMsg  string
+	writeFunc func(registry *TemplateRegistry, buf *bytes.Buffer) error
+	errMsg    string
 }
 
 // allTemplateWriteTests returns test cases for all template Write functions.
-// The following are intentionally omitted - templates have empty content and won't panic:
+// The following are intentionally omitted - templates have empty content and won't fail:
 // - WriteTargetWaitMethod
 // - WriteFuncDepMockStruct (two-return style doesn't need Handle struct)
 // - WriteTargetStartMethod (flattened API - Start logic moved into constructor)
@@ -63,7 +100,7 @@ func allTemplateWriteTests() []templateWriteTest {
 	methodNames := []string{
 		"WriteDepArgsStruct", "WriteDepCallWrapper", "WriteDepConstructor", "WriteDepHeader",
 		"WriteDepImplMethod", "WriteDepImplStruct", "WriteDepInterfaceMethod", "WriteDepMethodWrapper",
-		"WriteDepMockStruct", "WriteFuncDepConstructor", "WriteFuncDepMethodWrapper",
+		"WriteDepMockStruct", "WriteDepUnmockedMethod", "WriteFuncDepConstructor", "WriteFuncDepMethodWrapper",
 		"WriteInterfaceTargetConstructor", "WriteInterfaceTargetHeader",
 		"WriteInterfaceTargetMethodCallHandleStruct", "WriteInterfaceTargetMethodExpectCompletes",
 		"WriteInterfaceTargetMethodExpectPanic", "WriteInterfaceTargetMethodExpectReturns",
@@ -77,21 +114,25 @@ func allTemplateWriteTests() []templateWriteTest {
 	tests := make([]templateWriteTest, 0, len(methodNames))
 
 	for _, methodName := range methodNames {
-		// Derive panicMsg by removing "Write" prefix and lowercasing first char
-		panicMsg := strings.ToLower(methodName[5:6]) + methodName[6:]
+		// Derive errMsg by removing "Write" prefix and lowercasing first char
+		errMsg := strings.ToLower(methodName[5:6]) + methodName[6:]
 		tests = append(tests, templateWriteTest{
 			name:      methodName,
 			writeFunc: makeWriteFunc(methodName),
-			panicMsg:  panicMsg,
+			errMsg:    errMsg,
 		})
 	}
 
 	return tests
 }
 
-func makeWriteFunc(methodName string) func(*TemplateRegistry, *bytes.Buffer) {
-	return func(r *TemplateRegistry, b *bytes.Buffer) {
+func makeWriteFunc(methodName string) func(*TemplateRegistry, *bytes.Buffer) error {
+	return func(r *TemplateRegistry, b *bytes.Buffer) error {
 		method := reflect.ValueOf(r).MethodByName(methodName)
-		method.Call([]reflect.Value{reflect.ValueOf(b), reflect.ValueOf(struct{}{})})
+		results := method.Call([]reflect.Value{reflect.ValueOf(b), reflect.ValueOf(struct{}{})})
+
+		err, _ := results[0].Interface().(error)
+
+		return err
 	}
 }
@@ -132,7 +132,6 @@ func (gen *targetGenerator) buildTargetTemplateData() targetTemplateData {
 		PkgTime:           pkgTime,
 		PkgReflect:        pkgReflect,
 		NeedsFmt:          gen.needsFmt,
-		NeedsReflect:      gen.needsReflect,
 		NeedsImptest:      gen.needsImptest,
 		AdditionalImports: gen.collectAdditionalImports(),
 	}
@@ -248,12 +247,18 @@ func (gen *targetGenerator) formatFieldListTypes(fields *dst.FieldList) string {
 func (gen *targetGenerator) generate() (string, error) {
 	// Pre-scan to determine what imports are needed
 	gen.checkIfQualifierNeeded(gen.funcDecl.Type)
-
-	// Get the global template registry (initialized at package load time)
-	templates := NewTemplateRegistry()
+	gen.checkIfTypeParamsNeedQualifier()
 
 	// Generate using templates
-	gen.generateWithTemplates(templates)
+	templates, err := gen.templateRegistry()
+	if err != nil {
+		return "", err
+	}
+
+	err = gen.generateWithTemplates(templates)
+	if err != nil {
+		return "", err
+	}
 
 	formatted, err := format.Source(gen.bytes())
 	if err != nil {
@@ -264,26 +269,29 @@ func (gen *targetGenerator) generate() (string, error) {
 }
 
 // generateWithTemplates generates code using templates instead of direct code generation.
-func (gen *targetGenerator) generateWithTemplates(templates *TemplateRegistry) {
+func (gen *targetGenerator) generateWithTemplates(templates *TemplateRegistry) error {
 	data := gen.buildTargetTemplateData()
 
-	// Generate each section using templates
-	templates.WriteTargetHeader(&gen.buf, data)
-	templates.WriteTargetReturnsStruct(&gen.buf, data)
-	templates.WriteTargetConstructor(&gen.buf, data)
-	templates.WriteTargetWrapperStruct(&gen.buf, data)
-	templates.WriteTargetCallHandleStruct(&gen.buf, data)
-	templates.WriteTargetStartMethod(&gen.buf, data)
-	templates.WriteTargetWaitMethod(&gen.buf, data)
-
 	// Generate expect methods based on whether function has results
+	writeExpect := templates.WriteTargetExpectCompletes
 	if gen.hasResults {
-		templates.WriteTargetExpectReturns(&gen.buf, data)
-	} else {
-		templates.WriteTargetExpectCompletes(&gen.buf, data)
+		writeExpect = templates.WriteTargetExpectReturns
 	}
 
-	templates.WriteTargetExpectPanic(&gen.buf, data)
+	// Generate each section using templates
+	return writeTemplates(
+		&gen.buf,
+		data,
+		templates.WriteTargetHeader,
+		templates.WriteTargetReturnsStruct,
+		templates.WriteTargetConstructor,
+		templates.WriteTargetWrapperStruct,
+		templates.WriteTargetCallHandleStruct,
+		templates.WriteTargetStartMethod,
+		templates.WriteTargetWaitMethod,
+		writeExpect,
+		templates.WriteTargetExpectPanic,
+	)
 }
 
 // hasMultipleResults checks if the function has multiple return values.
@@ -409,7 +417,7 @@ func newTargetGenerator(
 		// For non-function-type cases (regular functions), use the original logic
 		// Note: resolvePackageInfo converts all ErrNotPackageReference errors to empty returns,
 		// and GetPackageInfo only returns nil or ErrNotPackageReference, so this cannot fail.
-		if pkgImportPath != "." || strings.HasSuffix(info.PkgName, "_test") {
+		if pkgImportPath != "." || importsOwnPackage(info) {
 			pkgPath, qualifier, _ = resolvePackageInfo(info, pkgLoader)
 		}
 	}
@@ -432,14 +440,14 @@ func newTargetGenerator(
 		astFiles:       astFiles,
 	}
 
+	gen.templates = info.Templates
+
 	// Extract parameter names and result types
 	gen.paramNames = extractParamNames(funcDecl.Type)
 
 	gen.hasResults = funcDecl.Type.Results != nil && len(funcDecl.Type.Results.List) > 0
 	if gen.hasResults {
 		gen.resultTypes = gen.extractResultTypes(funcDecl.Type.Results)
-		// Target wrappers need reflect for DeepEqual in ExpectReturnsEqual (only when there are results)
-		gen.needsReflect = true
 	}
 
 	return gen
@@ -54,17 +54,6 @@ type interfaceTargetGenerator struct {
 func (gen *interfaceTargetGenerator) buildInterfaceTargetTemplateData(
 	isStructType bool,
 ) interfaceTargetTemplateData {
-	// Determine if we need reflect (for ExpectReturnsEqual with DeepEqual)
-	needsReflect := false
-	_ = forEachInterfaceMethod(
-		gen.identifiedInterface.Iface, gen.astFiles, gen.fset, gen.pkgImportPath, gen.pkgLoader,
-		func(_ string, ftype *dst.FuncType) {
-			if ftype.Results != nil && len(ftype.Results.List) > 0 {
-				needsReflect = true
-			}
-		},
-	)
-
 	// Build base template data
 	base := baseTemplateData{
 		PkgName:           gen.pkgName,
@@ -80,8 +69,7 @@ func (gen *interfaceTargetGenerator) buildInterfaceTargetTemplateData(
 		PkgTime:           pkgTime,
 		PkgReflect:        pkgReflect,
 		NeedsFmt:          false, // Interface wrappers don't need fmt
-		NeedsReflect:      needsReflect,
-		NeedsImptest:      true, // Always needed for CallableController
+		NeedsImptest:      true,  // Always needed for CallableController
 		AdditionalImports: gen.collectAdditionalImports(),
 	}
 
@@ -224,6 +212,8 @@ func (gen *interfaceTargetGenerator) checkIfQualifierNeeded() {
 			gen.baseGenerator.checkIfQualifierNeeded(ftype)
 		},
 	)
+
+	gen.checkIfTypeParamsNeedQualifier()
 }
 
 // collectAdditionalImports collects imports needed for interface method signatures.
@@ -264,11 +254,13 @@ func (gen *interfaceTargetGenerator) formatQualifiedInterfaceType() string {
 		interfaceType = qualifierToUse + "." + gen.interfaceName
 	}
 
-	// Add type parameters to interface type if present
+	// Add type parameters, or the type arguments of an instantiation, to interface type if present
 	if gen.formatTypeParamsUse() != "" {
 		interfaceType += gen.formatTypeParamsUse()
 	}
 
+	interfaceType += gen.formatTypeArgs()
+
 	return interfaceType
 }
 
@@ -282,11 +274,16 @@ func (gen *interfaceTargetGenerator) generate(isStructType bool) (string, error)
 		gen.needsQualifier = true
 	}
 
-	// Get the global template registry (initialized at package load time)
-	templates := NewTemplateRegistry()
-
 	// Generate using templates - pass isStructType from parameter
-	gen.generateWithTemplates(templates, isStructType)
+	templates, err := gen.templateRegistry()
+	if err != nil {
+		return "", err
+	}
+
+	err = gen.generateWithTemplates(templates, isStructType)
+	if err != nil {
+		return "", err
+	}
 
 	formatted, err := format.Source(gen.bytes())
 	if err != nil {
@@ -300,25 +297,41 @@ func (gen *interfaceTargetGenerator) generate(isStructType bool) (string, error)
 func (gen *interfaceTargetGenerator) generateWithTemplates(
 	templates *TemplateRegistry,
 	isStructType bool,
-) {
+) error {
 	data := gen.buildInterfaceTargetTemplateData(isStructType)
 
 	// Generate each section using templates
-	templates.WriteInterfaceTargetHeader(&gen.buf, data)
-	templates.WriteInterfaceTargetWrapperStruct(&gen.buf, data)
-	templates.WriteInterfaceTargetConstructor(&gen.buf, data)
+	err := writeTemplates(
+		&gen.buf,
+		data,
+		templates.WriteInterfaceTargetHeader,
+		templates.WriteInterfaceTargetWrapperStruct,
+		templates.WriteInterfaceTargetConstructor,
+	)
+	if err != nil {
+		return err
+	}
 
 	// Generate method wrappers for each interface method
 	for _, methodData := range data.Methods {
-		templates.WriteInterfaceTargetMethodWrapperFunc(&gen.buf, methodData)
-		templates.WriteInterfaceTargetMethodWrapperStruct(&gen.buf, methodData)
-		templates.WriteInterfaceTargetMethodCallHandleStruct(&gen.buf, methodData)
-		templates.WriteInterfaceTargetMethodStart(&gen.buf, methodData)
-		templates.WriteInterfaceTargetMethodReturns(&gen.buf, methodData)
-		templates.WriteInterfaceTargetMethodExpectReturns(&gen.buf, methodData)
-		templates.WriteInterfaceTargetMethodExpectCompletes(&gen.buf, methodData)
-		templates.WriteInterfaceTargetMethodExpectPanic(&gen.buf, methodData)
+		err = writeTemplates(
+			&gen.buf,
+			methodData,
+			templates.WriteInterfaceTargetMethodWrapperFunc,
+			templates.WriteInterfaceTargetMethodWrapperStruct,
+			templates.WriteInterfaceTargetMethodCallHandleStruct,
+			templates.WriteInterfaceTargetMethodStart,
+			templates.WriteInterfaceTargetMethodReturns,
+			templates.WriteInterfaceTargetMethodExpectReturns,
+			templates.WriteInterfaceTargetMethodExpectCompletes,
+			templates.WriteInterfaceTargetMethodExpectPanic,
+		)
+		if err != nil {
+			return err
+		}
 	}
+
+	return nil
 }
 
 // newInterfaceTargetGenerator creates a new interface or struct target wrapper generator.
@@ -362,6 +375,8 @@ func newInterfaceTargetGenerator(
 	}
 
 	gen.methodNames = methodNames
+	gen.typeArgs = ifaceWithDetails.TypeArgs
+	gen.templates = info.Templates
 
 	return gen, nil
 }
@@ -20,8 +20,11 @@ func StructDependencyCode(
 	pkgLoader detect.PackageLoader,
 	structWithDetails detect.StructWithDetails,
 ) (string, error) {
-	// Collect all methods for this struct type
-	methods := detect.CollectStructMethods(astFiles, fset, structWithDetails.TypeName)
+	// Collect all methods for this struct type, unless the type checker already resolved them
+	methods := structWithDetails.Methods
+	if methods == nil {
+		methods = detect.CollectStructMethods(astFiles, fset, structWithDetails.TypeName)
+	}
 
 	// Convert methods map to sorted slice of method names (for deterministic output)
 	methodNames := make([]string, 0, len(methods))
@@ -52,6 +55,7 @@ func StructDependencyCode(
 	ifaceDetails := detect.IfaceWithDetails{
 		Iface:         interfaceType,
 		TypeParams:    structWithDetails.TypeParams,
+		TypeArgs:      structWithDetails.TypeArgs,
 		SourceImports: structWithDetails.SourceImports,
 		IsStructType:  true, // Mark as struct-derived to generate synthetic interface in output
 	}
@@ -71,8 +75,11 @@ func StructTargetCode(
 	pkgLoader detect.PackageLoader,
 	structWithDetails detect.StructWithDetails,
 ) (string, error) {
-	// Collect all methods for this struct type
-	methods := detect.CollectStructMethods(astFiles, fset, structWithDetails.TypeName)
+	// Collect all methods for this struct type, unless the type checker already resolved them
+	methods := structWithDetails.Methods
+	if methods == nil {
+		methods = detect.CollectStructMethods(astFiles, fset, structWithDetails.TypeName)
+	}
 
 	// Convert methods map to sorted slice of method names (for deterministic output)
 	methodNames := make([]string, 0, len(methods))
@@ -103,6 +110,7 @@ func StructTargetCode(
 	ifaceDetails := detect.IfaceWithDetails{
 		Iface:         interfaceType,
 		TypeParams:    structWithDetails.TypeParams,
+		TypeArgs:      structWithDetails.TypeArgs,
 		SourceImports: structWithDetails.SourceImports,
 	}
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/toejough/go-reorder"
@@ -13,16 +14,20 @@ type Writer interface {
 	WriteFile(name string, data []byte, perm os.FileMode) error
 }
 
-// WriteGeneratedCode writes the generated code to generated_<impName>.go.
+// WriteGeneratedCode writes the generated code to generated_<impName>.go, in outputDir if given.
 func WriteGeneratedCode(
-	code, impName, pkgName string, getEnv func(string) string, fileWriter Writer, out io.Writer,
+	code, impName, pkgName, outputDir string, getEnv func(string) string, fileWriter Writer, out io.Writer,
 ) error {
 	const generatedFilePermissions = 0o600
 
 	filename := "generated_" + impName
 	// If we're in a test package OR the source file is a test file, append _test to the filename
 	// This handles both blackbox testing (package xxx_test) and whitebox testing (package xxx in xxx_test.go)
+	// In another directory, the source file says nothing about the generated one: only its package does
 	goFile := getEnv("GOFILE")
+	if outputDir != "" {
+		goFile = ""
+	}
 
 	isTestFile := strings.HasSuffix(pkgName, "_test") || strings.HasSuffix(goFile, "_test.go")
 	if isTestFile && !strings.HasSuffix(impName, "_test") {
@@ -31,6 +36,8 @@ func WriteGeneratedCode(
 		filename += ".go"
 	}
 
+	filename = filepath.Join(outputDir, filename)
+
 	// Reorder declarations according to project conventions
 	reordered, err := reorder.Source(code)
 	if err != nil {
@@ -16,6 +16,7 @@ func TestWriteGeneratedCode(t *testing.T) {
 		code         string
 		impName      string
 		pkgName      string
+		outputDir    string
 		goFile       string
 		writerErr    error
 		wantFilename string
@@ -69,6 +70,15 @@ func TestWriteGeneratedCode(t *testing.T) {
 			goFile:       "source.go",
 			wantFilename: "generated_MyType_test.go",
 		},
+		{
+			name:         "output dir ignores the source file",
+			code:         "package foomock\n",
+			impName:      "MyType",
+			pkgName:      "foomock",
+			outputDir:    "mocks/foomock",
+			goFile:       "source_test.go",
+			wantFilename: "mocks/foomock/generated_MyType.go",
+		},
 		{
 			name:      "write error returns error",
 			code:      "package foo\n",
@@ -96,7 +106,7 @@ func TestWriteGeneratedCode(t *testing.T) {
 				return ""
 			}
 
-			err := WriteGeneratedCode(tt.code, tt.impName, tt.pkgName, getEnv, writer, out)
+			err := WriteGeneratedCode(tt.code, tt.impName, tt.pkgName, tt.outputDir, getEnv, writer, out)
 
 			if tt.wantErr {
 				if err == nil {
@@ -0,0 +1,226 @@
+package run
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	"github.com/BurntSushi/toml"
+
+	detect "github.com/toejough/imptest/internal/run/3_detect"
+)
+
+// unexported constants.
+const (
+	configFileName = "impgen.toml"
+)
+
+// unexported variables.
+var (
+	errConfigNoSymbols      = errors.New("no symbols listed")
+	errConfigPackageUnknown = errors.New("cannot determine the package name; set package in the entry")
+	errNoConfig             = errors.New("no arguments given and no " + configFileName + " at the module root")
+	errNoModuleRoot         = errors.New("no go.mod found in this directory or any parent")
+	errUnknownConfigKey     = errors.New("unknown keys")
+)
+
+// config is the contents of impgen.toml. Defaults apply to every entry; an entry's own settings take precedence.
+//
+//	[defaults]
+//	dependency = true
+//
+//	[[generate]]
+//	dir = "internal/storage"
+//	symbols = ["storage.Repo", "storage.Clock:target"]
+type config struct {
+	Defaults cliArgs       `toml:"defaults"`
+	Generate []configEntry `toml:"generate"`
+}
+
+// configEntry is one [[generate]] table: the same settings as the command line, plus where to generate.
+type configEntry struct {
+	cliArgs
+
+	// Dir is the directory to generate into, relative to the module root. It plays the role of the directory
+	// containing a go:generate directive.
+	Dir string `toml:"dir"`
+	// Package is the package the generated code belongs to. Defaults to the external test package of Dir, or with
+	// output-dir, to the name of that directory.
+	Package string `toml:"package"`
+	// keys are the keys the entry sets, so that an explicit false or empty value still overrides the defaults.
+	keys map[string]bool
+}
+
+// findModuleRoot walks up from dir to the nearest directory containing a go.mod file.
+func findModuleRoot(dir string, fs FileReader) (string, error) {
+	for {
+		_, err := fs.ReadFile(filepath.Join(dir, "go.mod"))
+		if err == nil {
+			return dir, nil
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", errNoModuleRoot
+		}
+
+		dir = parent
+	}
+}
+
+// mergeDefaults fills every field of args whose key is not among keys, the keys the entry sets, from defaults. An
+// entry that picks a mode does not inherit the default mode, so "target = true" in an entry overrides
+// "dependency = true" in the defaults.
+func mergeDefaults(args, defaults cliArgs, keys map[string]bool) cliArgs {
+	if keys["target"] || keys["dependency"] {
+		defaults.Target, defaults.Dependency = false, false
+	}
+
+	merged := reflect.ValueOf(&args).Elem()
+	fallback := reflect.ValueOf(defaults)
+
+	for i := range merged.NumField() {
+		if !keys[merged.Type().Field(i).Tag.Get("toml")] {
+			merged.Field(i).Set(fallback.Field(i))
+		}
+	}
+
+	return args
+}
+
+// readConfig parses the config file at path, rejecting keys that do not match any setting.
+func readConfig(path string, fs FileReader) (config, error) {
+	data, err := fs.ReadFile(path)
+	if err != nil {
+		return config{}, fmt.Errorf("%w: %w", errNoConfig, err)
+	}
+
+	var cfg config
+
+	meta, err := toml.Decode(string(data), &cfg)
+	if err != nil {
+		return config{}, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	if undecoded := meta.Undecoded(); len(undecoded) > 0 {
+		return config{}, fmt.Errorf("%w in %s: %v", errUnknownConfigKey, path, undecoded)
+	}
+
+	// Decoding again into maps tells which keys each entry sets, whichever TOML syntax declares the entries
+	var raw struct {
+		Generate []map[string]any `toml:"generate"`
+	}
+
+	_, err = toml.Decode(string(data), &raw)
+	if err != nil {
+		return config{}, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	for index, entry := range raw.Generate {
+		cfg.Generate[index].keys = make(map[string]bool, len(entry))
+		for key := range entry {
+			cfg.Generate[index].keys[key] = true
+		}
+	}
+
+	return cfg, nil
+}
+
+// runConfig generates every entry of the impgen.toml file at the module root. Each entry is generated from its own
+// directory, as a go:generate directive there would be; the working directory is restored afterwards.
+func runConfig(
+	getEnv func(string) string,
+	fileSystem FileSystem,
+	pkgLoader detect.PackageLoader,
+	out io.Writer,
+) error {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	root, err := findModuleRoot(cwd, fileSystem)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := readConfig(filepath.Join(root, configFileName), fileSystem)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = os.Chdir(cwd) }()
+
+	var errs []error
+
+	for index, entry := range cfg.Generate {
+		args := mergeDefaults(entry.cliArgs, cfg.Defaults, entry.keys)
+
+		_, err = runConfigEntry(filepath.Join(root, entry.Dir), entry, args, getEnv, fileSystem, pkgLoader, out)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s entry %d (dir %q): %w", configFileName, index+1, entry.Dir, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// runConfigEntry generates a single config entry, with its defaults already merged into args, from within dir. It
+// returns the names of the files the entry generates into.
+func runConfigEntry(
+	dir string,
+	entry configEntry,
+	args cliArgs,
+	getEnv func(string) string,
+	fileSystem FileSystem,
+	pkgLoader detect.PackageLoader,
+	out io.Writer,
+) ([]string, error) {
+	if len(args.Symbols) == 0 {
+		return nil, errConfigNoSymbols
+	}
+
+	err := os.Chdir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enter directory: %w", err)
+	}
+
+	loader := newPackageCache(pkgLoader)
+
+	// With output-dir, package names the package generated into, and symbols resolve from the package in dir itself
+	pkgName, testSuffix := entry.Package, "_test"
+	if args.OutputDir != "" {
+		args.Package, pkgName, testSuffix = entry.Package, "", ""
+	}
+
+	if pkgName == "" {
+		astFiles, _, err := loadPackage(".", loader)
+		if err != nil {
+			return nil, err
+		}
+
+		_, declName := nonTestFiles(astFiles)
+		if declName == "" {
+			return nil, errConfigPackageUnknown
+		}
+
+		pkgName = declName + testSuffix
+	}
+
+	// There is no directive file to infer imports from; symbols resolve from the entry's package instead
+	entryEnv := func(key string) string {
+		switch key {
+		case goPackageEnvVarName:
+			return pkgName
+		case "GOFILE":
+			return ""
+		}
+
+		return getEnv(key)
+	}
+
+	return generateAll(args, pkgName, entryEnv, fileSystem, loader, out)
+}
@@ -0,0 +1,215 @@
+//nolint:testpackage // Tests internal functions
+package run
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestMergeDefaults(t *testing.T) {
+	t.Parallel()
+
+	defaults := cliArgs{Dependency: true, NamePattern: "Fake{name}", Exclude: "^Legacy", Types: true}
+
+	tests := []struct {
+		name  string
+		entry cliArgs
+		keys  []string
+		want  cliArgs
+	}{
+		{
+			name:  "unset fields inherit defaults",
+			entry: cliArgs{Symbols: []string{"pkg.Repo"}},
+			keys:  []string{"symbols"},
+			want: cliArgs{
+				Symbols:     []string{"pkg.Repo"},
+				Dependency:  true,
+				NamePattern: "Fake{name}",
+				Exclude:     "^Legacy",
+				Types:       true,
+			},
+		},
+		{
+			name:  "entry settings take precedence",
+			entry: cliArgs{Symbols: []string{"pkg.Repo"}, NamePattern: "Stub{name}"},
+			keys:  []string{"symbols", "name-pattern"},
+			want: cliArgs{
+				Symbols:     []string{"pkg.Repo"},
+				Dependency:  true,
+				NamePattern: "Stub{name}",
+				Exclude:     "^Legacy",
+				Types:       true,
+			},
+		},
+		{
+			name:  "explicit zero values override defaults",
+			entry: cliArgs{Symbols: []string{"pkg.Repo"}},
+			keys:  []string{"symbols", "exclude", "types"},
+			want: cliArgs{
+				Symbols:     []string{"pkg.Repo"},
+				Dependency:  true,
+				NamePattern: "Fake{name}",
+			},
+		},
+		{
+			name:  "entry mode replaces default mode",
+			entry: cliArgs{Symbols: []string{"pkg.Run"}, Target: true},
+			keys:  []string{"symbols", "target"},
+			want: cliArgs{
+				Symbols:     []string{"pkg.Run"},
+				Target:      true,
+				NamePattern: "Fake{name}",
+				Exclude:     "^Legacy",
+				Types:       true,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			keys := make(map[string]bool, len(testCase.keys))
+			for _, key := range testCase.keys {
+				keys[key] = true
+			}
+
+			got := mergeDefaults(testCase.entry, defaults, keys)
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("mergeDefaults() = %+v, want %+v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestReadConfig(t *testing.T) {
+	t.Parallel()
+
+	fileSystem := &mockCachingFileSystem{files: map[string][]byte{
+		"impgen.toml": []byte(`
+[defaults]
+dependency = true
+name-pattern = "Fake{name}"
+
+[[generate]]
+dir = "internal/storage"
+symbols = ["storage.Repo", "storage.Clock:target"]
+
+[[generate]]
+dir = "internal/ports"
+package = "ports"
+all = true
+symbols = ["."]
+exclude = "^Audit"
+types = false
+`),
+		"typo.toml": []byte(`
+[[generate]]
+symbol = ["storage.Repo"]
+`),
+	}}
+
+	cfg, err := readConfig("impgen.toml", fileSystem)
+	if err != nil {
+		t.Fatalf("readConfig() error = %v", err)
+	}
+
+	if !cfg.Defaults.Dependency || cfg.Defaults.NamePattern != "Fake{name}" {
+		t.Errorf("Defaults = %+v", cfg.Defaults)
+	}
+
+	if len(cfg.Generate) != 2 {
+		t.Fatalf("got %d entries, want 2", len(cfg.Generate))
+	}
+
+	storage, ports := cfg.Generate[0], cfg.Generate[1]
+	if storage.Dir != "internal/storage" ||
+		!slices.Equal(storage.Symbols, []string{"storage.Repo", "storage.Clock:target"}) {
+		t.Errorf("first entry = %+v", storage)
+	}
+
+	if ports.Package != "ports" || !ports.All || ports.Exclude != "^Audit" {
+		t.Errorf("second entry = %+v", ports)
+	}
+
+	if !ports.keys["types"] || ports.keys["name-pattern"] {
+		t.Errorf("second entry keys = %v, want types set and name-pattern unset", ports.keys)
+	}
+
+	_, err = readConfig("typo.toml", fileSystem)
+	if !errors.Is(err, errUnknownConfigKey) {
+		t.Errorf("readConfig() error = %v, want %v", err, errUnknownConfigKey)
+	}
+
+	_, err = readConfig("missing.toml", fileSystem)
+	if !errors.Is(err, errNoConfig) {
+		t.Errorf("readConfig() error = %v, want %v", err, errNoConfig)
+	}
+}
+
+//nolint:paralleltest // t.Chdir is incompatible with t.Parallel
+func TestRun_Config(t *testing.T) {
+	root := t.TempDir()
+
+	err := os.Mkdir(filepath.Join(root, "storage"), 0o750)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Chdir(root)
+
+	loader, fileSystem := createTestInterfaceAST("Repo")
+	fileSystem.files[filepath.Join(root, "go.mod")] = []byte("module example.com/app\n")
+	fileSystem.files[filepath.Join(root, configFileName)] = []byte(`
+[defaults]
+dependency = true
+
+[[generate]]
+dir = "storage"
+symbols = ["Repo"]
+
+[[generate]]
+dir = "storage"
+name-pattern = "Fake{name}"
+symbols = ["Repo"]
+
+[[generate]]
+dir = "storage"
+output-dir = "../mocks/repomock"
+package = "repomock"
+symbols = ["Repo"]
+`)
+
+	err = Run([]string{"impgen"}, func(string) string { return "" }, fileSystem, loader, io.Discard)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	// The package name comes from the entry's directory, so the output lands in its external test package
+	for _, name := range []string{"generated_MockRepo_test.go", "generated_FakeRepo_test.go"} {
+		if _, ok := fileSystem.files[name]; !ok {
+			t.Errorf("Expected %s to be written", name)
+		}
+	}
+
+	// With output-dir, the entry's package names the shared package generated into
+	shared := string(fileSystem.files[filepath.Join("..", "mocks", "repomock", "generated_MockRepo.go")])
+	if !strings.Contains(shared, "\npackage repomock\n") {
+		t.Errorf("Expected generated_MockRepo.go in package repomock, got:\n%s", shared)
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cwd != root {
+		t.Errorf("working directory = %s, want it restored to %s", cwd, root)
+	}
+}
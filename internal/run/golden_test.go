@@ -18,6 +18,7 @@ import (
 
 	"github.com/toejough/imptest/internal/run"
 	cache "github.com/toejough/imptest/internal/run/1_cache"
+	load "github.com/toejough/imptest/internal/run/2_load"
 )
 
 // TestUATConsistency ensures that the generated files in the UAT directory
@@ -146,6 +147,21 @@ func (pl *testPackageLoader) Load(
 	return files, fset, nil, nil
 }
 
+// LoadTypes type-checks a package by import path, resolved from WorkDir like Load.
+func (pl *testPackageLoader) LoadTypes(importPath string) (*types.Package, error) {
+	workDir := pl.WorkDir
+	if workDir == "" {
+		workDir, _ = os.Getwd()
+	}
+
+	pkg, err := load.PackageTypes(workDir, pl.resolveLocalPackageFromDir(importPath, workDir))
+	if err != nil {
+		return nil, fmt.Errorf("failed to type-check package %q from %q: %w", importPath, workDir, err)
+	}
+
+	return pkg, nil
+}
+
 // loadPackageFromDir loads a package from a specific working directory.
 // This is similar to run.LoadPackageDST but works from an explicit directory.
 //
@@ -7,9 +7,16 @@ import (
 	"errors"
 	"fmt"
 	"go/token"
+	"go/types"
 	"io"
+	"maps"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
 	"strings"
 	"time"
+	"unicode"
 
 	"github.com/dave/dst"
 	"github.com/toejough/targ"
@@ -41,10 +48,11 @@ type FileSystem interface {
 
 // Run executes the impgen tool logic. It takes command-line arguments, an environment variable getter, an output.Writer
 // interface for file operations, a PackageLoader for package operations, and an io.Writer for output messages. It
-// returns an error if any step fails. On success, it generates a Go source file implementing the specified interface,
-// in the calling test package.
+// returns an error if any step fails. On success, it generates one Go source file per requested symbol, in the calling
+// test package. Each package is loaded at most once per call, no matter how many symbols are requested from it.
 //
-//nolint:cyclop,funlen // Main orchestration function with timing instrumentation
+// With no arguments, Run generates every entry in the impgen.toml file at the module root instead. "impgen verify
+// [dir/...]" writes nothing, and fails if any generated file is stale, missing, or no longer generated.
 func Run(
 	args []string,
 	getEnv func(string) string,
@@ -52,149 +60,152 @@ func Run(
 	pkgLoader detect.PackageLoader,
 	out io.Writer,
 ) error {
-	timing := getEnv("IMPGEN_TIMING") != ""
-
-	var start time.Time
-
-	if timing {
-		start = time.Now()
-	}
-
-	info, err := getGeneratorCallInfo(args, getEnv)
-	if err != nil {
-		return err
-	}
-
-	pkgImportPath, err := getInterfacePackagePath(
-		info.InterfaceName,
-		pkgLoader,
-		info.ImportPathFlag,
-		getEnv,
-	)
-	if err != nil {
-		return err
+	if len(args) <= 1 {
+		return runConfig(getEnv, fileSystem, pkgLoader, out)
 	}
 
-	// If it's a local package, we should use the full name for symbol lookup
-	// (e.g. "MyType.MyMethod" instead of just "MyMethod")
-	if pkgImportPath == "." {
-		info.LocalInterfaceName = info.InterfaceName
-		// Recalculate impName with the corrected localInterfaceName if not user-provided
-		if !info.NameProvided {
-			info.ImpName = determineGeneratedTypeName(info.Mode, info.LocalInterfaceName)
-		}
+	if args[1] == verifyCommand {
+		return runVerify(args[2:], getEnv, fileSystem, pkgLoader, out)
 	}
 
-	if timing {
-		_, _ = fmt.Fprintf(out, "[%s] Args/resolve: %v\n", info.ImpName, time.Since(start))
-		start = time.Now()
-	}
-
-	astFiles, fset, err := loadPackage(pkgImportPath, pkgLoader)
-	if err != nil {
-		return err
-	}
-
-	if timing {
-		_, _ = fmt.Fprintf(out, "[%s] Load package: %v\n", info.ImpName, time.Since(start))
-		start = time.Now()
-	}
-
-	// Find the symbol to generate code for
-	result, err := findSymbol(info, astFiles, fset, pkgImportPath, pkgLoader)
-	if err != nil {
-		return err
-	}
-
-	if timing {
-		_, _ = fmt.Fprintf(out, "[%s] Find symbol: %v\n", info.ImpName, time.Since(start))
-		start = time.Now()
-	}
-
-	// Compute hash and check cache (unless disabled)
-	typeHash := computeTypeHash(result.symbol, info, result.fset)
-	outputFile := getOutputFilename(info.ImpName, info.PkgName, getEnv)
-	noCache := getEnv("IMPGEN_NO_CACHE") != ""
-
-	if !noCache && checkCachedHash(outputFile, typeHash, fileSystem) {
-		if timing {
-			_, _ = fmt.Fprintf(out, "[%s] Cache hit: %v\n", info.ImpName, time.Since(start))
-		}
-
-		_, _ = fmt.Fprintf(out, "%s unchanged (cached).\n", outputFile)
-
-		return nil
-	}
-
-	if timing {
-		_, _ = fmt.Fprintf(out, "[%s] Cache miss: %v\n", info.ImpName, time.Since(start))
-		start = time.Now()
+	pkgName := getEnv(goPackageEnvVarName)
+	if pkgName == "" {
+		return errGOPACKAGENotSet
 	}
 
-	code, err := generateCode(info, result, pkgLoader)
+	parsed, err := parseArgs(args)
 	if err != nil {
 		return err
 	}
 
-	// Add hash to generated code for future cache checks
-	code = addHashToCode(code, typeHash)
-
-	if timing {
-		_, _ = fmt.Fprintf(out, "[%s] Generate code: %v\n", info.ImpName, time.Since(start))
-		start = time.Now()
-	}
-
-	err = output.WriteGeneratedCode(code, info.ImpName, info.PkgName, getEnv, fileSystem, out)
-	if err != nil {
-		return fmt.Errorf("failed to write generated code: %w", err)
-	}
-
-	if timing {
-		_, _ = fmt.Fprintf(out, "[%s] Write output: %v\n", info.ImpName, time.Since(start))
-	}
+	_, err = generateAll(parsed, pkgName, getEnv, fileSystem, pkgLoader, out)
 
-	return nil
+	return err
 }
 
 // unexported constants.
 const (
+	generatedHeader     = "// Code generated by impgen. DO NOT EDIT."
 	goPackageEnvVarName = "GOPACKAGE"
 	hashHeaderLines     = 10
 	hashPrefix          = "// impgen:hash:"
+	namePlaceholder     = "{name}"
 )
 
 // unexported variables.
 var (
+	errAllRequired      = errors.New("--match, --exclude, and --func-types require --all")
 	errAmbiguousPackage = errors.New(
 		"package is ambiguous: both stdlib and local package exist",
 	)
-	errGOPACKAGENotSet        = errors.New(goPackageEnvVarName + " environment variable not set")
-	errMutuallyExclusiveFlags = errors.New("--target and --dependency flags are mutually exclusive")
+	errGeneratedMissing        = errors.New("generated file is missing")
+	errGeneratedStale          = errors.New("generated file is stale")
+	errGOPACKAGENotSet         = errors.New(goPackageEnvVarName + " environment variable not set")
+	errInvalidTypeArgs         = errors.New("invalid type arguments: expected Symbol[T1,T2]")
+	errImportPathWithAll       = errors.New("--import-path cannot be used with --all: pass the import path as the package")
+	errMethodsFilter           = errors.New("--methods and --exclude-methods only apply to interface and struct mocks")
+	errMethodsWithAll          = errors.New("--methods and --exclude-methods cannot be used with --all")
+	errMutuallyExclusiveFlags  = errors.New("--target and --dependency flags are mutually exclusive")
+	errNamePatternPlaceholder  = errors.New("--name-pattern must contain " + namePlaceholder)
+	errNameWithMultipleSymbols = errors.New("--name can only be used with a single symbol")
+	errNoSymbolsFound          = errors.New("no matching exported symbols found in package")
+	errNoTemplates             = errors.New("no .tmpl files found in --templates directory")
+	errPackageWithoutOutputDir = errors.New("--package requires --output-dir")
+	errTypesUnavailable        = errors.New("package loader cannot type-check packages")
+	errUnknownSymbolMode       = errors.New("unknown symbol mode: use :target or :dependency")
 )
 
+// cliArgs holds the command-line arguments. It is also the schema for entries in impgen.toml, so every flag can be
+// set from the config file under the same name.
 type cliArgs struct {
-	Interface  string `targ:"positional,required,desc=interface or function name to wrap/mock"`
-	Name       string `targ:"flag,desc=name for the generated code (overrides default naming)"`
-	Target     bool   `targ:"flag,desc=generate target wrapper (WrapXxx) instead of dependency mock"`
-	Dependency bool   `targ:"flag,desc=generate dependency mock (MockXxx) - this is the default behavior"`
-	ImportPath string `targ:"flag,name=import-path,desc=explicit import path when ambiguous"`
+	Symbols        []string `toml:"symbols"      targ:"positional,required,desc=interfaces or functions to wrap/mock, each optionally suffixed with :target or :dependency (packages with --all)"`
+	Name           string   `toml:"name"         targ:"flag,desc=name for the generated code (overrides default naming)"`
+	NamePattern    string   `toml:"name-pattern" targ:"flag,name=name-pattern,desc=pattern for generated names; {name} is replaced by the symbol name (e.g. Fake{name})"`
+	Target         bool     `toml:"target"       targ:"flag,desc=generate target wrapper (WrapXxx) instead of dependency mock"`
+	Dependency     bool     `toml:"dependency"   targ:"flag,desc=generate dependency mock (MockXxx) - this is the default behavior"`
+	ImportPath     string   `toml:"import-path"  targ:"flag,name=import-path,desc=explicit import path when ambiguous"`
+	All            bool     `toml:"all"          targ:"flag,desc=treat each argument as a package and generate code for all of its exported interfaces"`
+	FuncTypes      bool     `toml:"func-types"   targ:"flag,name=func-types,desc=with --all, also include exported function types"`
+	Match          string   `toml:"match"        targ:"flag,desc=with --all, only include symbols whose name matches this regular expression"`
+	Check          bool     `toml:"check"        targ:"flag,desc=report stale or missing generated files instead of writing them"`
+	Exclude        string   `toml:"exclude"      targ:"flag,desc=with --all, skip symbols whose name matches this regular expression"`
+	Types          bool     `toml:"types"        targ:"flag,desc=always resolve symbols with the type checker (slower, but precise for aliases and embedded types from other packages)"`
+	OutputDir      string   `toml:"output-dir"   targ:"flag,name=output-dir,desc=generate exported non-test code into this directory instead of next to the directive (e.g. internal/mocks/storemock)"`
+	Templates      string   `toml:"templates"    targ:"flag,desc=directory of <name>.tmpl files that override the built-in templates of the same name (e.g. depImplMethod.tmpl)"`
+	Methods        string   `toml:"methods"      targ:"flag,desc=mock only these comma-separated methods (e.g. Get,Put); the others panic if called"`
+	ExcludeMethods string   `toml:"exclude-methods" targ:"flag,name=exclude-methods,desc=leave methods whose name matches this regular expression unmocked; they panic if called"`
+	// Package is set by an entry's own package key in impgen.toml, so it has no key of its own there
+	Package string `toml:"-" targ:"flag,desc=with --output-dir, the package name of the generated code (defaults to the directory name)"`
 }
 
 // Run is required by targ but not used - parsing only.
 func (c *cliArgs) Run() { _ = c }
 
+type loadedPackage struct {
+	files     []*dst.File
+	fset      *token.FileSet
+	typesInfo *types.Info
+	err       error
+}
+
+type loadedTypes struct {
+	pkg *types.Package
+	err error
+}
+
+// packageCache wraps a PackageLoader so that each import path is loaded (and type-checked) at most once.
+type packageCache struct {
+	loader   detect.PackageLoader
+	packages map[string]loadedPackage
+	typed    map[string]loadedTypes
+}
+
+// Load returns the cached result for importPath, loading it on first use.
+func (c *packageCache) Load(importPath string) ([]*dst.File, *token.FileSet, *types.Info, error) {
+	pkg, ok := c.packages[importPath]
+	if !ok {
+		pkg.files, pkg.fset, pkg.typesInfo, pkg.err = c.loader.Load(importPath)
+		c.packages[importPath] = pkg
+	}
+
+	return pkg.files, pkg.fset, pkg.typesInfo, pkg.err
+}
+
+// LoadTypes returns the cached type-checked package for importPath, type-checking it on first use. It fails if the
+// wrapped loader cannot type-check packages.
+func (c *packageCache) LoadTypes(importPath string) (*types.Package, error) {
+	pkg, ok := c.typed[importPath]
+	if !ok {
+		pkg.err = errTypesUnavailable
+
+		if typesLoader, ok := c.loader.(detect.TypesLoader); ok {
+			pkg.pkg, pkg.err = typesLoader.LoadTypes(importPath)
+		}
+
+		c.typed[importPath] = pkg
+	}
+
+	return pkg.pkg, pkg.err
+}
+
+// symbolRequest is a single symbol to generate, with the import path to resolve it from (if known).
+type symbolRequest struct {
+	name       string
+	importPath string
+}
+
 type symbolResult struct {
 	symbol   detect.SymbolDetails
 	astFiles []*dst.File
 	fset     *token.FileSet
 	pkgPath  string
+	typed    bool // resolved by the type checker rather than from syntax
 }
 
 // addHashToCode inserts the hash comment after the "DO NOT EDIT" line.
 func addHashToCode(code, hash string) string {
-	const doNotEdit = "// Code generated by impgen. DO NOT EDIT."
-	if idx := strings.Index(code, doNotEdit); idx != -1 {
-		insertPos := idx + len(doNotEdit)
+	if idx := strings.Index(code, generatedHeader); idx != -1 {
+		insertPos := idx + len(generatedHeader)
 
 		return code[:insertPos] + "\n" + hashPrefix + hash + code[insertPos:]
 	}
@@ -223,6 +234,36 @@ func checkCachedHash(filename, expectedHash string, fs FileReader) bool {
 	return false // No hash found
 }
 
+// compilePattern compiles the regular expression given to flag, returning nil if none was given.
+func compilePattern(flag, pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil //nolint:nilnil // no pattern means no filtering
+	}
+
+	compiled, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s pattern %q: %w", flag, pattern, err)
+	}
+
+	return compiled, nil
+}
+
+// checkGeneratedFile reports an error if the generated file is missing or was generated from a different type hash.
+func checkGeneratedFile(filename, expectedHash string, fs FileReader, out io.Writer) error {
+	_, err := fs.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errGeneratedMissing, filename)
+	}
+
+	if !checkCachedHash(filename, expectedHash, fs) {
+		return fmt.Errorf("%w: %s", errGeneratedStale, filename)
+	}
+
+	_, _ = fmt.Fprintf(out, "%s up to date.\n", filename)
+
+	return nil
+}
+
 // computeTypeHash computes a hash of the symbol details and generator info.
 // This hash changes when the type definition or generator settings change.
 func computeTypeHash(
@@ -239,6 +280,27 @@ func computeTypeHash(
 	fmt.Fprintf(&builder, "kind:%d\n", symbol.Kind)
 	fmt.Fprintf(&builder, "pkgpath:%s\n", symbol.PkgPath)
 
+	if info.TypeArgs != "" {
+		fmt.Fprintf(&builder, "typeargs:%s\n", info.TypeArgs)
+	}
+
+	if info.DirectivePkgName != "" {
+		fmt.Fprintf(&builder, "directive:%s\n", info.DirectivePkgName)
+	}
+
+	if len(info.Methods) > 0 {
+		fmt.Fprintf(&builder, "methods:%s\n", strings.Join(info.Methods, ","))
+	}
+
+	if info.ExcludeMethods != nil {
+		fmt.Fprintf(&builder, "excludemethods:%s\n", info.ExcludeMethods)
+	}
+
+	// Template overrides change the generated code without changing the symbol
+	for _, name := range slices.Sorted(maps.Keys(info.Templates)) {
+		fmt.Fprintf(&builder, "template:%s\n%s\n", name, info.Templates[name])
+	}
+
 	// Include type-specific details
 	switch symbol.Kind {
 	case detect.SymbolInterface:
@@ -325,6 +387,100 @@ func findSymbol(
 	}, nil
 }
 
+// findTypedSymbol resolves the symbol with the type checker instead of syntax, instantiating it with its type
+// arguments if it has any. The package's syntax is still returned, for the generators to resolve the symbol's own
+// package qualifier from.
+func findTypedSymbol(
+	info generate.GeneratorInfo,
+	astFiles []*dst.File,
+	fset *token.FileSet,
+	pkgImportPath string,
+	pkgLoader detect.PackageLoader,
+) (symbolResult, error) {
+	typesLoader, ok := pkgLoader.(detect.TypesLoader)
+	if !ok {
+		return symbolResult{}, errTypesUnavailable
+	}
+
+	pkg, err := typesLoader.LoadTypes(pkgImportPath)
+	if err != nil {
+		return symbolResult{}, fmt.Errorf("failed to type-check package %s: %w", pkgImportPath, err)
+	}
+
+	var typeArgs []types.Type
+
+	if info.TypeArgs != "" {
+		typeArgs, err = detect.ResolveTypeArgs(info.TypeArgs, pkg, func(name string) (*types.Package, error) {
+			return lookupTypesPackage(name, pkg, typesLoader, pkgLoader)
+		})
+		if err != nil {
+			return symbolResult{}, fmt.Errorf("failed to resolve type arguments of %s: %w", info.InterfaceName, err)
+		}
+	}
+
+	symbol, err := detect.FindTypedSymbol(pkg, info.LocalInterfaceName, typeArgs, pkgImportPath)
+	if err != nil {
+		return symbolResult{}, fmt.Errorf("failed to find symbol %s: %w", info.LocalInterfaceName, err)
+	}
+
+	// A synthesized function or function type has no file of its own: the generators find the imports its signature
+	// needs here
+	if symbol.Kind == detect.SymbolFunction || symbol.Kind == detect.SymbolFunctionType {
+		astFiles = []*dst.File{{Name: dst.NewIdent(pkg.Name()), Imports: symbol.FuncImports}}
+	}
+
+	return symbolResult{
+		symbol:   symbol,
+		astFiles: astFiles,
+		fset:     fset,
+		pkgPath:  pkgImportPath,
+		typed:    true,
+	}, nil
+}
+
+// generateAll generates code for every symbol requested by parsed, into the package pkgName. It returns the names of
+// the files the symbols generate into, whether or not they were written.
+func generateAll(
+	parsed cliArgs,
+	pkgName string,
+	getEnv func(string) string,
+	fileSystem FileSystem,
+	pkgLoader detect.PackageLoader,
+	out io.Writer,
+) ([]string, error) {
+	loader := newPackageCache(pkgLoader)
+
+	infos, err := getGeneratorCallInfos(parsed, pkgName, loader)
+	if err != nil {
+		return nil, err
+	}
+
+	templates, err := loadTemplates(parsed.Templates, fileSystem)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		outputFiles []string
+		errs        []error
+	)
+
+	for _, info := range infos {
+		info.Templates = templates
+
+		outputFile, err := generateSymbol(info, parsed, getEnv, fileSystem, loader, out)
+		if outputFile != "" {
+			outputFiles = append(outputFiles, outputFile)
+		}
+
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return outputFiles, errors.Join(errs...)
+}
+
 func generateCode(
 	info generate.GeneratorInfo,
 	result symbolResult,
@@ -341,55 +497,207 @@ func generateCode(
 	)
 }
 
-// Functions - Private
-
-// getGeneratorCallInfo returns basic information about the current call to the generator.
-func getGeneratorCallInfo(
-	args []string,
+// generateSymbol resolves, generates, and writes the code for a single requested symbol, returning the name of the
+// file it generates into. In check mode, nothing is written; instead it reports an error if that file is stale or
+// missing.
+//
+//nolint:cyclop,funlen // Main orchestration function with timing instrumentation
+func generateSymbol(
+	info generate.GeneratorInfo,
+	parsed cliArgs,
 	getEnv func(string) string,
-) (generate.GeneratorInfo, error) {
-	pkgName := getEnv(goPackageEnvVarName)
-	if pkgName == "" {
-		return generate.GeneratorInfo{}, errGOPACKAGENotSet
+	fileSystem FileSystem,
+	pkgLoader detect.PackageLoader,
+	out io.Writer,
+) (string, error) {
+	timing := getEnv("IMPGEN_TIMING") != ""
+
+	var start time.Time
+
+	if timing {
+		start = time.Now()
 	}
 
-	parsed, err := parseArgs(args)
+	pkgImportPath, err := getInterfacePackagePath(
+		info.InterfaceName,
+		pkgLoader,
+		info.ImportPathFlag,
+		getEnv,
+	)
 	if err != nil {
-		return generate.GeneratorInfo{}, err
+		return "", err
 	}
 
-	interfaceName := parsed.Interface
-	localInterfaceName := getLocalInterfaceName(interfaceName)
-	impName := parsed.Name
-	nameProvided := parsed.Name != ""
+	// If it's a local package, we should use the full name for symbol lookup
+	// (e.g. "MyType.MyMethod" instead of just "MyMethod")
+	if pkgImportPath == "." {
+		info.LocalInterfaceName = info.InterfaceName
+		// Recalculate impName with the corrected localInterfaceName if not user-provided
+		if !info.NameProvided {
+			info.ImpName = patternedTypeName(
+				info.Mode, instanceName(info.LocalInterfaceName, info.TypeArgs), info.NamePattern,
+			)
+		}
+	}
 
-	// Validate mutually exclusive flags
-	if parsed.Target && parsed.Dependency {
-		return generate.GeneratorInfo{}, errMutuallyExclusiveFlags
+	if timing {
+		_, _ = fmt.Fprintf(out, "[%s] Args/resolve: %v\n", info.ImpName, time.Since(start))
+		start = time.Now()
+	}
+
+	astFiles, fset, err := loadPackage(pkgImportPath, pkgLoader)
+	if err != nil {
+		return "", err
+	}
+
+	if timing {
+		_, _ = fmt.Fprintf(out, "[%s] Load package: %v\n", info.ImpName, time.Since(start))
+		start = time.Now()
+	}
+
+	// Find the symbol to generate code for
+	result, err := resolveSymbol(info, astFiles, fset, pkgImportPath, pkgLoader, parsed.Types)
+	if err != nil {
+		return "", err
 	}
 
-	// Determine naming mode based on flags
-	mode := generate.NamingModeDefault
+	if timing {
+		_, _ = fmt.Fprintf(out, "[%s] Find symbol: %v\n", info.ImpName, time.Since(start))
+		start = time.Now()
+	}
+
+	// Compute hash and check cache (unless disabled)
+	typeHash := computeTypeHash(result.symbol, info, result.fset)
+	outputFile := getOutputFilename(info.ImpName, info.PkgName, info.OutputDir, getEnv)
+	noCache := getEnv("IMPGEN_NO_CACHE") != ""
+
+	if parsed.Check {
+		return outputFile, checkGeneratedFile(outputFile, typeHash, fileSystem, out)
+	}
+
+	if !noCache && checkCachedHash(outputFile, typeHash, fileSystem) {
+		if timing {
+			_, _ = fmt.Fprintf(out, "[%s] Cache hit: %v\n", info.ImpName, time.Since(start))
+		}
+
+		_, _ = fmt.Fprintf(out, "%s unchanged (cached).\n", outputFile)
+
+		return outputFile, nil
+	}
+
+	if timing {
+		_, _ = fmt.Fprintf(out, "[%s] Cache miss: %v\n", info.ImpName, time.Since(start))
+		start = time.Now()
+	}
+
+	code, err := generateCode(info, result, pkgLoader)
+	if err != nil {
+		return "", err
+	}
+
+	// Add hash to generated code for future cache checks
+	code = addHashToCode(code, typeHash)
+
+	if timing {
+		_, _ = fmt.Fprintf(out, "[%s] Generate code: %v\n", info.ImpName, time.Since(start))
+		start = time.Now()
+	}
+
+	err = output.WriteGeneratedCode(code, info.ImpName, info.PkgName, info.OutputDir, getEnv, fileSystem, out)
+	if err != nil {
+		return outputFile, fmt.Errorf("failed to write generated code: %w", err)
+	}
+
+	if timing {
+		_, _ = fmt.Fprintf(out, "[%s] Write output: %v\n", info.ImpName, time.Since(start))
+	}
+
+	return outputFile, nil
+}
+
+// Functions - Private
+
+// getGeneratorCallInfos returns basic information about each symbol requested by the current call to the
+// generator. The --target and --dependency flags set the default mode; a symbol may override it with a ":target" or
+// ":dependency" suffix. With --all, each argument names a package whose exported symbols are requested.
+func getGeneratorCallInfos(
+	parsed cliArgs,
+	pkgName string,
+	pkgLoader detect.PackageLoader,
+) ([]generate.GeneratorInfo, error) {
+	err := validateArgs(parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	// Determine default naming mode based on flags
+	defaultMode := generate.NamingModeDefault
 	if parsed.Target {
-		mode = generate.NamingModeTarget
+		defaultMode = generate.NamingModeTarget
 	} else if parsed.Dependency {
-		mode = generate.NamingModeDependency
+		defaultMode = generate.NamingModeDependency
 	}
 
-	// set impname if not provided
-	if impName == "" {
-		impName = determineGeneratedTypeName(mode, localInterfaceName)
+	requests, err := getSymbolRequests(parsed, pkgLoader)
+	if err != nil {
+		return nil, err
 	}
 
-	return generate.GeneratorInfo{
-		PkgName:            pkgName,
-		InterfaceName:      interfaceName,
-		LocalInterfaceName: localInterfaceName,
-		ImpName:            impName,
-		Mode:               mode,
-		ImportPathFlag:     parsed.ImportPath,
-		NameProvided:       nameProvided,
-	}, nil
+	excludeMethods, err := compilePattern("--exclude-methods", parsed.ExcludeMethods)
+	if err != nil {
+		return nil, err
+	}
+
+	var methods []string
+	if parsed.Methods != "" {
+		methods = strings.Split(parsed.Methods, ",")
+	}
+
+	// With --output-dir, the code is generated into a package of its own, which imports the directive's package
+	outputPkgName, directivePkgName := pkgName, ""
+	if parsed.OutputDir != "" {
+		outputPkgName, directivePkgName = outputPackageName(parsed), pkgName
+	}
+
+	infos := make([]generate.GeneratorInfo, 0, len(requests))
+
+	for _, request := range requests {
+		symbolName, mode, err := parseSymbolArg(request.name, defaultMode)
+		if err != nil {
+			return nil, err
+		}
+
+		interfaceName, typeArgs, err := splitTypeArgs(symbolName)
+		if err != nil {
+			return nil, err
+		}
+
+		localInterfaceName := getLocalInterfaceName(interfaceName)
+
+		// set impname if not provided
+		impName := parsed.Name
+		if impName == "" {
+			impName = patternedTypeName(mode, instanceName(localInterfaceName, typeArgs), parsed.NamePattern)
+		}
+
+		infos = append(infos, generate.GeneratorInfo{
+			PkgName:            outputPkgName,
+			DirectivePkgName:   directivePkgName,
+			OutputDir:          parsed.OutputDir,
+			InterfaceName:      interfaceName,
+			LocalInterfaceName: localInterfaceName,
+			TypeArgs:           typeArgs,
+			ImpName:            impName,
+			Mode:               mode,
+			ImportPathFlag:     request.importPath,
+			NameProvided:       parsed.Name != "",
+			NamePattern:        parsed.NamePattern,
+			Methods:            methods,
+			ExcludeMethods:     excludeMethods,
+		})
+	}
+
+	return infos, nil
 }
 
 // getInterfacePackagePath resolves the import path for the package containing the target interface.
@@ -477,10 +785,14 @@ func getLocalInterfaceName(interfaceName string) string {
 	return interfaceName
 }
 
-// getOutputFilename returns the filename that would be generated.
-func getOutputFilename(impName, pkgName string, getEnv func(string) string) string {
+// getOutputFilename returns the filename that would be generated, relative to the directive's directory.
+func getOutputFilename(impName, pkgName, outputDir string, getEnv func(string) string) string {
 	filename := "generated_" + impName
+
 	goFile := getEnv("GOFILE")
+	if outputDir != "" {
+		goFile = ""
+	}
 
 	isTestFile := strings.HasSuffix(pkgName, "_test") || strings.HasSuffix(goFile, "_test.go")
 	if isTestFile && !strings.HasSuffix(impName, "_test") {
@@ -489,7 +801,111 @@ func getOutputFilename(impName, pkgName string, getEnv func(string) string) stri
 		filename += ".go"
 	}
 
-	return filename
+	return filepath.Join(outputDir, filename)
+}
+
+// getPackageSymbols lists the exported symbols of the package at pkgArg that pass the --match and --exclude
+// filters.
+func getPackageSymbols(
+	pkgArg string,
+	parsed cliArgs,
+	pkgLoader detect.PackageLoader,
+) ([]symbolRequest, error) {
+	match, err := compilePattern("--match", parsed.Match)
+	if err != nil {
+		return nil, err
+	}
+
+	exclude, err := compilePattern("--exclude", parsed.Exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	astFiles, _, err := loadPackage(pkgArg, pkgLoader)
+	if err != nil {
+		return nil, err
+	}
+
+	// The local package includes test files; only the non-test package's symbols can be referenced
+	pkgFiles, declName := nonTestFiles(astFiles)
+
+	// Symbols of the local package are requested unqualified, as they would be in a hand-written directive; the
+	// generator resolves them from a _test package to the package under test.
+	qualifier, importPath := declName+".", pkgArg
+	if pkgArg == "." {
+		qualifier, importPath = "", ""
+	}
+
+	var requests []symbolRequest
+
+	for _, name := range detect.ExportedSymbols(pkgFiles, parsed.FuncTypes, pkgLoader) {
+		if match != nil && !match.MatchString(name) {
+			continue
+		}
+
+		if exclude != nil && exclude.MatchString(name) {
+			continue
+		}
+
+		requests = append(requests, symbolRequest{name: qualifier + name, importPath: importPath})
+	}
+
+	if len(requests) == 0 {
+		return nil, fmt.Errorf("%w: %s", errNoSymbolsFound, pkgArg)
+	}
+
+	return requests, nil
+}
+
+// getSymbolRequests expands the positional arguments into the symbols to generate.
+func getSymbolRequests(parsed cliArgs, pkgLoader detect.PackageLoader) ([]symbolRequest, error) {
+	if !parsed.All {
+		requests := make([]symbolRequest, 0, len(parsed.Symbols))
+		for _, arg := range parsed.Symbols {
+			requests = append(requests, symbolRequest{name: arg, importPath: parsed.ImportPath})
+		}
+
+		return requests, nil
+	}
+
+	var requests []symbolRequest
+
+	for _, pkgArg := range parsed.Symbols {
+		pkgRequests, err := getPackageSymbols(pkgArg, parsed, pkgLoader)
+		if err != nil {
+			return nil, err
+		}
+
+		requests = append(requests, pkgRequests...)
+	}
+
+	return requests, nil
+}
+
+// instanceName returns the name to derive a generated type name from for a symbol instantiated with typeArgs: the
+// symbol name followed by the capitalized names of its type arguments, without their package qualifiers. For
+// example, "Repo" with "model.User" gives "RepoUser", and "Map" with "int,string" gives "MapIntString".
+func instanceName(localInterfaceName, typeArgs string) string {
+	var builder strings.Builder
+
+	builder.WriteString(localInterfaceName)
+
+	words := strings.FieldsFunc(typeArgs, func(r rune) bool {
+		return r != '.' && r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	for _, word := range words {
+		// Keep only the type name of a qualified identifier
+		name := word[strings.LastIndex(word, ".")+1:]
+		if name == "" {
+			continue
+		}
+
+		runes := []rune(name)
+		builder.WriteString(string(unicode.ToUpper(runes[0])) + string(runes[1:]))
+	}
+
+	return builder.String()
 }
 
 // loadPackage loads the AST for the package at the given path.
@@ -505,6 +921,129 @@ func loadPackage(
 	return astFiles, fset, nil
 }
 
+// loadTemplates reads the template overrides in dir: each <name>.tmpl file replaces the built-in template of that
+// name. They are parsed here, so that a bad override is reported once rather than for every symbol. An empty dir means
+// no overrides.
+func loadTemplates(dir string, fs FileReader) (map[string]string, error) {
+	if dir == "" {
+		return nil, nil
+	}
+
+	files, err := fs.Glob(filepath.Join(dir, "*.tmpl"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list templates in %s: %w", dir, err)
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("%w: %s", errNoTemplates, dir)
+	}
+
+	templates := make(map[string]string, len(files))
+
+	for _, file := range files {
+		content, err := fs.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read template %s: %w", file, err)
+		}
+
+		templates[strings.TrimSuffix(filepath.Base(file), ".tmpl")] = string(content)
+	}
+
+	_, err = generate.NewTemplateRegistryWithOverrides(templates)
+	if err != nil {
+		return nil, fmt.Errorf("invalid templates in %s: %w", dir, err)
+	}
+
+	return templates, nil
+}
+
+// lookupTypesPackage returns the type-checked package that name refers to in a type argument. Type arguments are
+// written from the package being generated into, so name is resolved through its imports. The instantiated package's
+// own dependencies are reused when they include it; anything else is type-checked separately.
+func lookupTypesPackage(
+	name string, pkg *types.Package, typesLoader detect.TypesLoader, pkgLoader detect.PackageLoader,
+) (*types.Package, error) {
+	localFiles, _, _, err := pkgLoader.Load(".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load local package: %w", err)
+	}
+
+	importPath, err := detect.FindImportPath(localFiles, name, pkgLoader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve package %s: %w", name, err)
+	}
+
+	seen := make(map[*types.Package]bool)
+	pending := []*types.Package{pkg}
+
+	for len(pending) > 0 {
+		current := pending[0]
+		pending = pending[1:]
+
+		if seen[current] {
+			continue
+		}
+
+		seen[current] = true
+
+		if current.Path() == importPath {
+			return current, nil
+		}
+
+		pending = append(pending, current.Imports()...)
+	}
+
+	imported, err := typesLoader.LoadTypes(importPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to type-check package %s: %w", importPath, err)
+	}
+
+	return imported, nil
+}
+
+// newPackageCache returns an empty packageCache backed by loader.
+func newPackageCache(loader detect.PackageLoader) *packageCache {
+	return &packageCache{
+		loader:   loader,
+		packages: make(map[string]loadedPackage),
+		typed:    make(map[string]loadedTypes),
+	}
+}
+
+// nonTestFiles returns the files of the non-test package among astFiles, along with that package's name.
+func nonTestFiles(astFiles []*dst.File) ([]*dst.File, string) {
+	var (
+		pkgFiles []*dst.File
+		declName string
+	)
+
+	for _, file := range astFiles {
+		if strings.HasSuffix(file.Name.Name, "_test") {
+			continue
+		}
+
+		if declName == "" {
+			declName = file.Name.Name
+		}
+
+		if file.Name.Name == declName {
+			pkgFiles = append(pkgFiles, file)
+		}
+	}
+
+	return pkgFiles, declName
+}
+
+// outputPackageName returns the package name of code generated into --output-dir: --package, or else the name of the
+// directory.
+func outputPackageName(parsed cliArgs) string {
+	if parsed.Package != "" {
+		return parsed.Package
+	}
+
+	return filepath.Base(parsed.OutputDir)
+}
+
 // parseArgs parses command-line arguments into cliArgs.
 func parseArgs(args []string) (cliArgs, error) {
 	var parsed cliArgs
@@ -517,6 +1056,63 @@ func parseArgs(args []string) (cliArgs, error) {
 	return parsed, nil
 }
 
+// parseSymbolArg splits a symbol argument into its name and naming mode. A ":target" or ":dependency" suffix
+// overrides the default mode.
+func parseSymbolArg(arg string, defaultMode generate.NamingMode) (string, generate.NamingMode, error) {
+	name, suffix, found := strings.Cut(arg, ":")
+	if !found {
+		return arg, defaultMode, nil
+	}
+
+	switch suffix {
+	case "target":
+		return name, generate.NamingModeTarget, nil
+	case "dependency":
+		return name, generate.NamingModeDependency, nil
+	}
+
+	return "", generate.NamingModeDefault, fmt.Errorf("%w: %q in %q", errUnknownSymbolMode, suffix, arg)
+}
+
+// patternedTypeName returns the generated type name, using pattern in place of the mode's default naming if given.
+func patternedTypeName(mode generate.NamingMode, localInterfaceName, pattern string) string {
+	if pattern == "" {
+		return determineGeneratedTypeName(mode, localInterfaceName)
+	}
+
+	return strings.ReplaceAll(pattern, namePlaceholder, strings.ReplaceAll(localInterfaceName, ".", ""))
+}
+
+// resolveSymbol finds the symbol to generate code for. Syntax alone is fast and enough for most symbols; the type
+// checker is used instead when forceTypes is set, when the symbol is instantiated with type arguments, when syntax
+// cannot find the symbol (e.g. an alias), or when detect.NeedsTypeCheck finds a method set syntax cannot resolve.
+// If the type checker is unavailable or fails, the syntax result stands.
+func resolveSymbol(
+	info generate.GeneratorInfo,
+	astFiles []*dst.File,
+	fset *token.FileSet,
+	pkgImportPath string,
+	pkgLoader detect.PackageLoader,
+	forceTypes bool,
+) (symbolResult, error) {
+	// Instantiating a generic symbol needs the type checker to substitute its type arguments
+	if forceTypes || info.TypeArgs != "" {
+		return findTypedSymbol(info, astFiles, fset, pkgImportPath, pkgLoader)
+	}
+
+	result, err := findSymbol(info, astFiles, fset, pkgImportPath, pkgLoader)
+	if err == nil && !detect.NeedsTypeCheck(result.astFiles, result.symbol, pkgLoader) {
+		return result, nil
+	}
+
+	typedResult, typedErr := findTypedSymbol(info, astFiles, fset, pkgImportPath, pkgLoader)
+	if typedErr != nil {
+		return result, err
+	}
+
+	return typedResult, nil
+}
+
 // routeFunctionGenerator routes to function generators based on mode.
 //
 //nolint:wrapcheck // internal subpackage, errors already have context
@@ -609,6 +1205,13 @@ func routeToGenerator(
 	pkgLoader detect.PackageLoader,
 	symbol detect.SymbolDetails,
 ) (string, error) {
+	// Only mocks of interfaces and structs have methods to leave unmocked
+	hasMethodFilter := len(info.Methods) > 0 || info.ExcludeMethods != nil
+	if hasMethodFilter && (info.Mode == generate.NamingModeTarget ||
+		symbol.Kind == detect.SymbolFunction || symbol.Kind == detect.SymbolFunctionType) {
+		return "", errMethodsFilter
+	}
+
 	switch symbol.Kind {
 	case detect.SymbolFunction:
 		return routeFunctionGenerator(
@@ -719,4 +1322,68 @@ func serializeStruct(
 ) {
 	fmt.Fprintf(builder, "name:%s\n", structType.TypeName)
 	serializeFieldList(builder, "typeparams", structType.TypeParams, fset)
+
+	// Methods are only set when resolved by the type checker
+	methodNames := make([]string, 0, len(structType.Methods))
+	for name := range structType.Methods {
+		methodNames = append(methodNames, name)
+	}
+
+	sort.Strings(methodNames)
+
+	for _, name := range methodNames {
+		fmt.Fprintf(builder, "method:%s\n", name)
+		fmt.Fprintf(builder, "type:%s\n", astutil.ExprToString(fset, structType.Methods[name]))
+	}
+}
+
+// splitTypeArgs splits a symbol like "Repo[model.User]" into its name and the type arguments between its brackets.
+// A symbol without brackets has no type arguments.
+func splitTypeArgs(symbol string) (string, string, error) {
+	name, typeArgs, found := strings.Cut(symbol, "[")
+	if !found {
+		return symbol, "", nil
+	}
+
+	typeArgs, ok := strings.CutSuffix(typeArgs, "]")
+	if !ok || name == "" || strings.TrimSpace(typeArgs) == "" {
+		return "", "", fmt.Errorf("%w: %q", errInvalidTypeArgs, symbol)
+	}
+
+	return name, typeArgs, nil
+}
+
+// validateArgs checks for flag combinations that cannot be satisfied.
+func validateArgs(parsed cliArgs) error {
+	if parsed.Target && parsed.Dependency {
+		return errMutuallyExclusiveFlags
+	}
+
+	// A custom name can only apply to a single generated type
+	if parsed.Name != "" && (parsed.All || len(parsed.Symbols) > 1) {
+		return errNameWithMultipleSymbols
+	}
+
+	if !parsed.All && (parsed.Match != "" || parsed.Exclude != "" || parsed.FuncTypes) {
+		return errAllRequired
+	}
+
+	if parsed.All && parsed.ImportPath != "" {
+		return errImportPathWithAll
+	}
+
+	if parsed.NamePattern != "" && !strings.Contains(parsed.NamePattern, namePlaceholder) {
+		return fmt.Errorf("%w: %q", errNamePatternPlaceholder, parsed.NamePattern)
+	}
+
+	if parsed.Package != "" && parsed.OutputDir == "" {
+		return errPackageWithoutOutputDir
+	}
+
+	// Method names differ from one interface to the next, so they cannot apply to a whole package
+	if parsed.All && (parsed.Methods != "" || parsed.ExcludeMethods != "") {
+		return errMethodsWithAll
+	}
+
+	return nil
 }
@@ -3,14 +3,19 @@ package run
 
 import (
 	"errors"
+	"go/ast"
+	"go/parser"
 	"go/token"
 	"go/types"
 	"io"
 	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 
 	"github.com/dave/dst"
+	"github.com/dave/dst/decorator"
 
 	detect "github.com/toejough/imptest/internal/run/3_detect"
 	generate "github.com/toejough/imptest/internal/run/5_generate"
@@ -100,11 +105,12 @@ func TestGetOutputFilename(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		name    string
-		impName string
-		pkgName string
-		goFile  string
-		want    string
+		name      string
+		impName   string
+		pkgName   string
+		outputDir string
+		goFile    string
+		want      string
 	}{
 		{
 			name:    "test package adds _test suffix",
@@ -134,6 +140,14 @@ func TestGetOutputFilename(t *testing.T) {
 			goFile:  "custom.go",
 			want:    "generated_Custom.go",
 		},
+		{
+			name:      "output dir ignores the test file",
+			impName:   "MockStore",
+			pkgName:   "storemock",
+			outputDir: "../mocks/storemock",
+			goFile:    "store_test.go",
+			want:      "../mocks/storemock/generated_MockStore.go",
+		},
 	}
 
 	for _, testCase := range tests {
@@ -148,7 +162,7 @@ func TestGetOutputFilename(t *testing.T) {
 				return ""
 			}
 
-			got := getOutputFilename(testCase.impName, testCase.pkgName, getEnv)
+			got := getOutputFilename(testCase.impName, testCase.pkgName, testCase.outputDir, getEnv)
 			if got != testCase.want {
 				t.Errorf("getOutputFilename() = %q, want %q", got, testCase.want)
 			}
@@ -156,6 +170,34 @@ func TestGetOutputFilename(t *testing.T) {
 	}
 }
 
+func TestInstanceName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		typeArgs string
+		want     string
+	}{
+		{name: "Repo", typeArgs: "", want: "Repo"},
+		{name: "Repo", typeArgs: "model.User", want: "RepoUser"},
+		{name: "Map", typeArgs: "int,string", want: "MapIntString"},
+		{name: "Cache", typeArgs: "string, *model.User", want: "CacheStringUser"},
+		{name: "Repo", typeArgs: "[]byte", want: "RepoByte"},
+		{name: "Repo", typeArgs: "store.Page[model.User]", want: "RepoPageUser"},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name+"["+testCase.typeArgs+"]", func(t *testing.T) {
+			t.Parallel()
+
+			got := instanceName(testCase.name, testCase.typeArgs)
+			if got != testCase.want {
+				t.Errorf("instanceName(%q, %q) = %q, want %q", testCase.name, testCase.typeArgs, got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestLoadPackage_Error(t *testing.T) {
 	t.Parallel()
 
@@ -236,6 +278,68 @@ func TestRouteStructGenerator_DefaultModeError(t *testing.T) {
 	}
 }
 
+func TestRun_AllSymbols(t *testing.T) {
+	t.Parallel()
+
+	getEnv := func(key string) string {
+		switch key {
+		case "GOPACKAGE":
+			return "testpkg_test"
+		case goFileEnvVar:
+			return "test_file_test.go"
+		}
+
+		return ""
+	}
+
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{
+			name: "every interface",
+			args: []string{"impgen", ".", "--all", "--dependency"},
+			want: []string{"generated_MockAuditLog_test.go", "generated_MockUserRepo_test.go"},
+		},
+		{
+			name: "match",
+			args: []string{"impgen", ".", "--all", "--dependency", "--match", "Repo$"},
+			want: []string{"generated_MockUserRepo_test.go"},
+		},
+		{
+			name: "exclude",
+			args: []string{"impgen", ".", "--all", "--dependency", "--exclude", "^Audit"},
+			want: []string{"generated_MockUserRepo_test.go"},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			loader, fileSystem := createTestInterfaceAST("UserRepo")
+			audit, _ := createTestInterfaceAST("AuditLog")
+			loader.files[0].Decls = append(loader.files[0].Decls, audit.files[0].Decls...)
+
+			err := Run(testCase.args, getEnv, fileSystem, loader, io.Discard)
+			if err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+
+			if len(fileSystem.files) != len(testCase.want) {
+				t.Errorf("wrote %d files, want %d", len(fileSystem.files), len(testCase.want))
+			}
+
+			for _, name := range testCase.want {
+				if _, ok := fileSystem.files[name]; !ok {
+					t.Errorf("Expected %s to be written", name)
+				}
+			}
+		})
+	}
+}
+
 func TestRun_CacheHit(t *testing.T) {
 	t.Parallel()
 
@@ -292,6 +396,54 @@ func TestRun_CacheHit(t *testing.T) {
 	}
 }
 
+func TestRun_Check(t *testing.T) {
+	t.Parallel()
+
+	loader, fileSystem := createTestInterfaceAST("CheckIface")
+
+	getEnv := func(key string) string {
+		if key == "GOPACKAGE" {
+			return "testpkg_test"
+		}
+
+		return ""
+	}
+
+	args := []string{"impgen", "CheckIface", "--dependency"}
+	checkArgs := append(slices.Clone(args), "--check")
+
+	// Check mode reports a missing file and writes nothing
+	err := Run(checkArgs, getEnv, fileSystem, loader, io.Discard)
+	if !errors.Is(err, errGeneratedMissing) {
+		t.Fatalf("Run(--check) error = %v, want %v", err, errGeneratedMissing)
+	}
+
+	if len(fileSystem.files) != 0 {
+		t.Fatalf("check mode wrote files: %v", fileSystem.files)
+	}
+
+	err = Run(args, getEnv, fileSystem, loader, io.Discard)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	err = Run(checkArgs, getEnv, fileSystem, loader, io.Discard)
+	if err != nil {
+		t.Fatalf("Run(--check) after generating error = %v", err)
+	}
+
+	// A different type hash means the interface changed since generation
+	const filename = "generated_MockCheckIface_test.go"
+
+	fileSystem.files[filename] = []byte(strings.Replace(
+		string(fileSystem.files[filename]), hashPrefix, hashPrefix+"0", 1))
+
+	err = Run(checkArgs, getEnv, fileSystem, loader, io.Discard)
+	if !errors.Is(err, errGeneratedStale) {
+		t.Errorf("Run(--check) error = %v, want %v", err, errGeneratedStale)
+	}
+}
+
 func TestRun_GOPACKAGENotSet(t *testing.T) {
 	t.Parallel()
 
@@ -312,6 +464,433 @@ func TestRun_GOPACKAGENotSet(t *testing.T) {
 	}
 }
 
+func TestRun_InvalidTypeArgs(t *testing.T) {
+	t.Parallel()
+
+	loader, fileSystem := createTestInterfaceAST("GenericIface")
+
+	getEnv := func(key string) string {
+		if key == "GOPACKAGE" {
+			return "testpkg_test"
+		}
+
+		return ""
+	}
+
+	for _, symbol := range []string{"GenericIface[string", "GenericIface[]", "[string]"} {
+		err := Run([]string{"impgen", symbol, "--dependency"}, getEnv, fileSystem, loader, io.Discard)
+		if !errors.Is(err, errInvalidTypeArgs) {
+			t.Errorf("Run(%q) error = %v, want %v", symbol, err, errInvalidTypeArgs)
+		}
+	}
+}
+
+func TestRun_Methods(t *testing.T) {
+	t.Parallel()
+
+	loader, fileSystem := createTestInterfaceAST("FilterIface")
+
+	getEnv := func(key string) string {
+		switch key {
+		case "GOPACKAGE":
+			return "testpkg_test"
+		case goFileEnvVar:
+			return "test_file_test.go"
+		}
+
+		return ""
+	}
+
+	err := Run(
+		[]string{"impgen", "FilterIface", "--dependency", "--exclude-methods", "^Meth"},
+		getEnv,
+		fileSystem,
+		loader,
+		io.Discard,
+	)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	code := string(fileSystem.files["generated_MockFilterIface_test.go"])
+	if !strings.Contains(code, "FilterIface.Method is not mocked") {
+		t.Errorf("expected an unmocked Method that panics, got:\n%s", code)
+	}
+
+	if strings.Contains(code, "NewDependencyMethod(ctrl, \"Method\")") {
+		t.Errorf("expected no expectations for the unmocked Method, got:\n%s", code)
+	}
+}
+
+func TestRun_MethodsErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr error
+	}{
+		{
+			name:    "with --all",
+			args:    []string{"impgen", "./testpkg", "--all", "--dependency", "--methods", "Method"},
+			wantErr: errMethodsWithAll,
+		},
+		{
+			name:    "target wrapper",
+			args:    []string{"impgen", "FilterIface", "--target", "--exclude-methods", "Method"},
+			wantErr: errMethodsFilter,
+		},
+		{
+			name:    "unknown method",
+			args:    []string{"impgen", "FilterIface", "--dependency", "--methods", "Method,Missing"},
+			wantErr: generate.ErrUnknownMethod,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			loader, fileSystem := createTestInterfaceAST("FilterIface")
+
+			getEnv := func(key string) string {
+				if key == "GOPACKAGE" {
+					return "testpkg_test"
+				}
+
+				return ""
+			}
+
+			err := Run(testCase.args, getEnv, fileSystem, loader, io.Discard)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Errorf("Run() error = %v, want %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestRun_MultipleSymbols(t *testing.T) {
+	t.Parallel()
+
+	loader, fileSystem := createTestInterfaceAST("FirstIface")
+	second, _ := createTestInterfaceAST("SecondIface")
+	loader.files[0].Decls = append(loader.files[0].Decls, second.files[0].Decls...)
+
+	getEnv := func(key string) string {
+		switch key {
+		case "GOPACKAGE":
+			return "testpkg_test"
+		case goFileEnvVar:
+			return "test_file_test.go"
+		}
+
+		return ""
+	}
+
+	// --dependency is the default; the suffix overrides it for one symbol
+	err := Run(
+		[]string{"impgen", "FirstIface", "SecondIface:target", "--dependency"},
+		getEnv,
+		fileSystem,
+		loader,
+		io.Discard,
+	)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	for _, name := range []string{
+		"generated_MockFirstIface_test.go",
+		"generated_StartSecondIface_test.go",
+	} {
+		if _, ok := fileSystem.files[name]; !ok {
+			t.Errorf("Expected %s to be written", name)
+		}
+	}
+
+	// Both symbols live in the same package, which should be loaded once
+	if loader.loads != 1 {
+		t.Errorf("package loaded %d times, want 1", loader.loads)
+	}
+}
+
+func TestRun_MultipleSymbolsErrors(t *testing.T) {
+	t.Parallel()
+
+	getEnv := func(key string) string {
+		if key == "GOPACKAGE" {
+			return "testpkg_test"
+		}
+
+		return ""
+	}
+
+	tests := []struct {
+		name string
+		args []string
+		want error
+	}{
+		{
+			name: "name with multiple symbols",
+			args: []string{"impgen", "First", "Second", "--dependency", "--name", "Custom"},
+			want: errNameWithMultipleSymbols,
+		},
+		{
+			name: "unknown mode suffix",
+			args: []string{"impgen", "First:mock"},
+			want: errUnknownSymbolMode,
+		},
+		{
+			name: "name with all",
+			args: []string{"impgen", ".", "--all", "--dependency", "--name", "Custom"},
+			want: errNameWithMultipleSymbols,
+		},
+		{
+			name: "match without all",
+			args: []string{"impgen", "First", "--dependency", "--match", "Repo$"},
+			want: errAllRequired,
+		},
+		{
+			name: "import path with all",
+			args: []string{"impgen", ".", "--all", "--dependency", "--import-path", "example.com/pkg"},
+			want: errImportPathWithAll,
+		},
+		{
+			name: "name pattern without placeholder",
+			args: []string{"impgen", "First", "--dependency", "--name-pattern", "Fake"},
+			want: errNamePatternPlaceholder,
+		},
+		{
+			name: "nothing matched",
+			args: []string{"impgen", ".", "--all", "--dependency", "--match", "^Nothing$"},
+			want: errNoSymbolsFound,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			loader, fileSystem := createTestInterfaceAST("UserRepo")
+
+			err := Run(testCase.args, getEnv, fileSystem, loader, io.Discard)
+			if !errors.Is(err, testCase.want) {
+				t.Errorf("Run() error = %v, want %v", err, testCase.want)
+			}
+		})
+	}
+}
+
+func TestRun_PackageWithoutOutputDir(t *testing.T) {
+	t.Parallel()
+
+	loader, fileSystem := createTestInterfaceAST("PkgIface")
+
+	getEnv := func(key string) string {
+		if key == "GOPACKAGE" {
+			return "testpkg_test"
+		}
+
+		return ""
+	}
+
+	args := []string{"impgen", "PkgIface", "--dependency", "--package", "mocks"}
+
+	err := Run(args, getEnv, fileSystem, loader, io.Discard)
+	if !errors.Is(err, errPackageWithoutOutputDir) {
+		t.Errorf("Run() error = %v, want %v", err, errPackageWithoutOutputDir)
+	}
+}
+
+func TestRun_Templates(t *testing.T) {
+	t.Parallel()
+
+	loader, fileSystem := createTestInterfaceAST("TmplIface")
+
+	getEnv := func(key string) string {
+		switch key {
+		case "GOPACKAGE":
+			return "testpkg_test"
+		case goFileEnvVar:
+			return "test_file_test.go"
+		}
+
+		return ""
+	}
+
+	err := Run([]string{"impgen", "TmplIface", "--dependency"}, getEnv, fileSystem, loader, io.Discard)
+	if err != nil {
+		t.Fatalf("Run() without templates error = %v", err)
+	}
+
+	fileSystem.files["tmpl/depMethodWrapper.tmpl"] = []byte("// traced {{.MethodName}}\n")
+
+	var out strings.Builder
+
+	err = Run(
+		[]string{"impgen", "TmplIface", "--dependency", "--templates", "tmpl"},
+		getEnv,
+		fileSystem,
+		loader,
+		&out,
+	)
+	if err != nil {
+		t.Fatalf("Run() with templates error = %v", err)
+	}
+
+	// The override changes the output, so it must not be served from the cache
+	if strings.Contains(out.String(), "unchanged (cached)") {
+		t.Error("template override should invalidate the cached file")
+	}
+
+	code := string(fileSystem.files["generated_MockTmplIface_test.go"])
+	if !strings.Contains(code, "// traced Method") {
+		t.Errorf("expected the depMethodWrapper override in the generated code, got:\n%s", code)
+	}
+}
+
+func TestRun_TemplatesErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantErr error
+	}{
+		{
+			name:    "no templates in directory",
+			files:   map[string]string{"tmpl/README.md": "not a template"},
+			wantErr: errNoTemplates,
+		},
+		{
+			name:    "unknown template name",
+			files:   map[string]string{"tmpl/depImplMethodd.tmpl": ""},
+			wantErr: generate.ErrUnknownTemplate,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			loader, fileSystem := createTestInterfaceAST("TmplIface")
+			for name, content := range testCase.files {
+				fileSystem.files[name] = []byte(content)
+			}
+
+			getEnv := func(key string) string {
+				if key == "GOPACKAGE" {
+					return "testpkg_test"
+				}
+
+				return ""
+			}
+
+			args := []string{"impgen", "TmplIface", "--dependency", "--templates", "tmpl"}
+
+			err := Run(args, getEnv, fileSystem, loader, io.Discard)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Errorf("Run() error = %v, want %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestRun_Types(t *testing.T) {
+	t.Parallel()
+
+	loader := typedSourceLoader(`package testpkg
+
+type Validator func(data string) error
+
+type Mapper[T any] func(in T) T
+
+type Calculator struct{}
+
+func (Calculator) Add(a, b int) int { return a + b }
+`)
+
+	getEnv := func(key string) string {
+		if key == "GOPACKAGE" {
+			return "testpkg_test"
+		}
+
+		return ""
+	}
+
+	// The typed path handles what the syntax path does, and instantiated generic function types
+	tests := []struct {
+		args     []string
+		wantFile string
+		wantCode string
+	}{
+		{
+			args:     []string{"impgen", "testpkg.Validator", "--dependency", "--types"},
+			wantFile: "generated_MockValidator_test.go",
+			wantCode: "func MockValidator(",
+		},
+		{
+			args:     []string{"impgen", "testpkg.Mapper[string]", "--dependency"},
+			wantFile: "generated_MockMapperString_test.go",
+			wantCode: "func(in string) string",
+		},
+		{
+			args:     []string{"impgen", "testpkg.Calculator.Add", "--target", "--types"},
+			wantFile: "generated_StartCalculatorAdd_test.go",
+			wantCode: "fn func(int, int) int",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.args[1], func(t *testing.T) {
+			t.Parallel()
+
+			fileSystem := &mockCachingFileSystem{files: make(map[string][]byte)}
+
+			err := Run(testCase.args, getEnv, fileSystem, loader, io.Discard)
+			if err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+
+			code := string(fileSystem.files[testCase.wantFile])
+			if !strings.Contains(code, testCase.wantCode) {
+				t.Errorf("expected %s to contain %q, got:\n%s", testCase.wantFile, testCase.wantCode, code)
+			}
+		})
+	}
+}
+
+func TestRun_TypesUnavailable(t *testing.T) {
+	t.Parallel()
+
+	loader, fileSystem := createTestInterfaceAST("TypedIface")
+
+	getEnv := func(key string) string {
+		if key == "GOPACKAGE" {
+			return "testpkg_test"
+		}
+
+		return ""
+	}
+
+	// Without --types, a loader that cannot type-check falls back to syntax
+	err := Run([]string{"impgen", "TypedIface", "--dependency"}, getEnv, fileSystem, loader, io.Discard)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	err = Run([]string{"impgen", "TypedIface", "--dependency", "--types"}, getEnv, fileSystem, loader, io.Discard)
+	if !errors.Is(err, errTypesUnavailable) {
+		t.Errorf("Run(--types) error = %v, want %v", err, errTypesUnavailable)
+	}
+
+	// Type arguments can only be substituted by the type checker
+	err = Run([]string{"impgen", "TypedIface[string]", "--dependency"}, getEnv, fileSystem, loader, io.Discard)
+	if !errors.Is(err, errTypesUnavailable) {
+		t.Errorf("Run(TypedIface[string]) error = %v, want %v", err, errTypesUnavailable)
+	}
+}
+
 func TestRun_WithTiming(t *testing.T) {
 	t.Parallel()
 
@@ -366,7 +945,12 @@ func (m *mockCachingFileSystem) Glob(pattern string) ([]string, error) {
 	var matches []string
 
 	for name := range m.files {
-		if strings.Contains(name, strings.TrimPrefix(strings.TrimSuffix(pattern, "*"), "*")) {
+		matched, err := filepath.Match(pattern, name)
+		if err != nil {
+			return nil, err
+		}
+
+		if matched {
 			matches = append(matches, name)
 		}
 	}
@@ -393,12 +977,38 @@ type mockPkgLoader struct {
 	fset  *token.FileSet
 	info  *types.Info
 	err   error
+	loads int
 }
 
 func (m *mockPkgLoader) Load(_ string) ([]*dst.File, *token.FileSet, *types.Info, error) {
+	m.loads++
+
 	return m.files, m.fset, m.info, m.err
 }
 
+// typedSourceLoader is a package loader that parses and type-checks a single package source.
+type typedSourceLoader string
+
+func (l typedSourceLoader) Load(_ string) ([]*dst.File, *token.FileSet, *types.Info, error) {
+	file, err := decorator.Parse(string(l))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return []*dst.File{file}, token.NewFileSet(), nil, nil
+}
+
+func (l typedSourceLoader) LoadTypes(importPath string) (*types.Package, error) {
+	fset := token.NewFileSet()
+
+	file, err := parser.ParseFile(fset, "source.go", string(l), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return (&types.Config{}).Check(importPath, fset, []*ast.File{file}, nil)
+}
+
 // createTestInterfaceAST creates a minimal interface AST for testing.
 func createTestInterfaceAST(ifaceName string) (*mockPkgLoader, *mockCachingFileSystem) {
 	ifaceDecl := &dst.GenDecl{
@@ -0,0 +1,391 @@
+package run
+
+import (
+	"errors"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	detect "github.com/toejough/imptest/internal/run/3_detect"
+)
+
+// unexported constants.
+const (
+	generateDirectivePrefix = "//go:generate"
+	verifyCommand           = "verify"
+)
+
+// unexported variables.
+var (
+	errGeneratedOrphaned = errors.New("generated file has no go:generate directive or " + configFileName + " entry")
+)
+
+// directive is an impgen go:generate directive found in a source file.
+type directive struct {
+	file    string
+	line    int
+	pkgName string
+	args    []string
+}
+
+// findDirectives returns the impgen go:generate directives in the Go files of the current directory. Like go
+// generate, it only considers lines that start with "//go:generate".
+func findDirectives(fileSystem FileReader) ([]directive, error) {
+	entries, err := os.ReadDir(".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory: %w", err)
+	}
+
+	var directives []directive
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") {
+			continue
+		}
+
+		data, err := fileSystem.ReadFile(entry.Name())
+		if err != nil {
+			return nil, err //nolint:wrapcheck // FileSystem errors already name the file
+		}
+
+		if !strings.Contains(string(data), generateDirectivePrefix) {
+			continue
+		}
+
+		file, err := parser.ParseFile(token.NewFileSet(), entry.Name(), data, parser.PackageClauseOnly)
+		if err != nil {
+			continue // go generate would not run a file without a package clause either
+		}
+
+		for index, line := range strings.Split(string(data), "\n") {
+			args, ok := parseDirective(line)
+			if ok {
+				directives = append(directives, directive{
+					file:    entry.Name(),
+					line:    index + 1,
+					pkgName: file.Name.Name,
+					args:    args,
+				})
+			}
+		}
+	}
+
+	return directives, nil
+}
+
+// findOrphans returns the impgen-generated files in the current directory that are not in expected.
+func findOrphans(expected map[string]bool, fileSystem FileReader) ([]string, error) {
+	entries, err := os.ReadDir(".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory: %w", err)
+	}
+
+	var orphans []string
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || expected[name] ||
+			!strings.HasPrefix(name, "generated_") || !strings.HasSuffix(name, ".go") {
+			continue
+		}
+
+		data, err := fileSystem.ReadFile(name)
+		if err != nil {
+			return nil, err //nolint:wrapcheck // FileSystem errors already name the file
+		}
+
+		if strings.Contains(string(data), generatedHeader) {
+			orphans = append(orphans, name)
+		}
+	}
+
+	return orphans, nil
+}
+
+// parseDirective returns the impgen arguments of a go:generate line, if it runs impgen.
+func parseDirective(line string) ([]string, bool) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 || fields[0] != generateDirectivePrefix {
+		return nil, false
+	}
+
+	for index, field := range fields[1:] {
+		if strings.HasSuffix(field, "impgen") {
+			return fields[index+2:], true
+		}
+	}
+
+	return nil, false
+}
+
+// recordExpected records names, relative to dir, as generated. Names in a directory that is not being verified are
+// ignored.
+func recordExpected(expected map[string]map[string]bool, dir string, names []string) {
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		if files := expected[filepath.Dir(path)]; files != nil {
+			files[filepath.Base(path)] = true
+		}
+	}
+}
+
+// runVerify checks every impgen go:generate directive and impgen.toml entry in the directories matched by patterns
+// (default "./..."), without writing anything. It returns an error listing every generated file that is stale or
+// missing, and every impgen-generated file that nothing generates anymore.
+func runVerify(
+	patterns []string,
+	getEnv func(string) string,
+	fileSystem FileSystem,
+	pkgLoader detect.PackageLoader,
+	out io.Writer,
+) error {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	dirs, err := verifyDirs(cwd, patterns)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = os.Chdir(cwd) }()
+
+	expected := make(map[string]map[string]bool, len(dirs))
+	for _, dir := range dirs {
+		expected[dir] = make(map[string]bool)
+	}
+
+	problems := verifyConfig(cwd, expected, getEnv, fileSystem, pkgLoader, out)
+
+	for _, dir := range dirs {
+		problems = append(problems, verifyDir(cwd, dir, expected, getEnv, fileSystem, pkgLoader, out)...)
+	}
+
+	// Directives may generate into other directories (--output-dir), so orphans are only known once all have run
+	for _, dir := range dirs {
+		problems = append(problems, verifyOrphans(cwd, dir, expected[dir], fileSystem)...)
+	}
+
+	if len(problems) > 0 {
+		return errors.Join(problems...)
+	}
+
+	_, _ = fmt.Fprintln(out, "All generated files are up to date.")
+
+	return nil
+}
+
+// splitErrors returns the errors joined into err by errors.Join, or err itself.
+func splitErrors(err error) []error {
+	if err == nil {
+		return nil
+	}
+
+	joined, ok := err.(interface{ Unwrap() []error }) //nolint:errorlint // inspecting errors.Join's result directly
+	if ok {
+		return joined.Unwrap()
+	}
+
+	return []error{err}
+}
+
+// verifyConfig checks the impgen.toml entries that generate into one of the verified directories, recording the files
+// they generate in expected.
+func verifyConfig(
+	cwd string,
+	expected map[string]map[string]bool,
+	getEnv func(string) string,
+	fileSystem FileSystem,
+	pkgLoader detect.PackageLoader,
+	out io.Writer,
+) []error {
+	root, err := findModuleRoot(cwd, fileSystem)
+	if err != nil {
+		return nil // Without a module there is no config to check
+	}
+
+	cfg, err := readConfig(filepath.Join(root, configFileName), fileSystem)
+	if errors.Is(err, errNoConfig) {
+		return nil
+	}
+
+	if err != nil {
+		return []error{err}
+	}
+
+	var problems []error
+
+	for index, entry := range cfg.Generate {
+		dir := filepath.Join(root, entry.Dir)
+		if expected[dir] == nil {
+			continue
+		}
+
+		args := mergeDefaults(entry.cliArgs, cfg.Defaults, entry.keys)
+		args.Check = true
+
+		outputFiles, err := runConfigEntry(dir, entry, args, getEnv, fileSystem, pkgLoader, out)
+		recordExpected(expected, dir, outputFiles)
+
+		for _, problem := range splitErrors(err) {
+			problems = append(problems, fmt.Errorf("%s entry %d: %w", configFileName, index+1, problem))
+		}
+	}
+
+	return problems
+}
+
+// verifyDir checks the impgen directives in dir, recording the files they generate in expected.
+func verifyDir(
+	cwd, dir string,
+	expected map[string]map[string]bool,
+	getEnv func(string) string,
+	fileSystem FileSystem,
+	pkgLoader detect.PackageLoader,
+	out io.Writer,
+) []error {
+	relDir, err := filepath.Rel(cwd, dir)
+	if err != nil {
+		relDir = dir
+	}
+
+	err = os.Chdir(dir)
+	if err != nil {
+		return []error{fmt.Errorf("%s: failed to enter directory: %w", relDir, err)}
+	}
+
+	directives, err := findDirectives(fileSystem)
+	if err != nil {
+		return []error{fmt.Errorf("%s: %w", relDir, err)}
+	}
+
+	loader := newPackageCache(pkgLoader)
+
+	var problems []error
+
+	for _, found := range directives {
+		// A bare "impgen" runs impgen.toml, which verifyConfig already checked
+		if len(found.args) == 0 || found.args[0] == verifyCommand {
+			continue
+		}
+
+		location := fmt.Sprintf("%s:%d", filepath.Join(relDir, found.file), found.line)
+
+		directiveEnv := func(key string) string {
+			switch key {
+			case goPackageEnvVarName:
+				return found.pkgName
+			case "GOFILE":
+				return found.file
+			}
+
+			return getEnv(key)
+		}
+
+		parsed, err := parseArgs(append([]string{"impgen"}, found.args...))
+		if err != nil {
+			problems = append(problems, fmt.Errorf("%s: %w", location, err))
+
+			continue
+		}
+
+		parsed.Check = true
+
+		outputFiles, err := generateAll(parsed, found.pkgName, directiveEnv, fileSystem, loader, out)
+		recordExpected(expected, dir, outputFiles)
+
+		for _, problem := range splitErrors(err) {
+			problems = append(problems, fmt.Errorf("%s: %w", location, problem))
+		}
+	}
+
+	return problems
+}
+
+// verifyDirs resolves patterns to absolute directories. A pattern ending in "/..." matches the directory and all of
+// its subdirectories, skipping the ones the go tool ignores (testdata, vendor, and names starting with "." or "_").
+func verifyDirs(cwd string, patterns []string) ([]string, error) {
+	if len(patterns) == 0 {
+		patterns = []string{"./..."}
+	}
+
+	var dirs []string
+
+	for _, pattern := range patterns {
+		base, recursive := strings.CutSuffix(pattern, "...")
+
+		base = strings.TrimSuffix(base, "/")
+		if base == "" {
+			base = "."
+		}
+
+		if !filepath.IsAbs(base) {
+			base = filepath.Join(cwd, base)
+		}
+
+		if !recursive {
+			dirs = append(dirs, base)
+
+			continue
+		}
+
+		err := filepath.WalkDir(base, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if !entry.IsDir() {
+				return nil
+			}
+
+			name := entry.Name()
+			if path != base && (name == "testdata" || name == "vendor" ||
+				strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+				return filepath.SkipDir
+			}
+
+			dirs = append(dirs, path)
+
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to walk %s: %w", pattern, err)
+		}
+	}
+
+	slices.Sort(dirs)
+
+	return slices.Compact(dirs), nil
+}
+
+// verifyOrphans reports impgen-generated files in dir that neither a directive nor a config entry generates.
+func verifyOrphans(cwd, dir string, expected map[string]bool, fileSystem FileReader) []error {
+	relDir, err := filepath.Rel(cwd, dir)
+	if err != nil {
+		relDir = dir
+	}
+
+	err = os.Chdir(dir)
+	if err != nil {
+		return []error{fmt.Errorf("%s: failed to enter directory: %w", relDir, err)}
+	}
+
+	orphans, err := findOrphans(expected, fileSystem)
+	if err != nil {
+		return []error{fmt.Errorf("%s: %w", relDir, err)}
+	}
+
+	problems := make([]error, 0, len(orphans))
+	for _, name := range orphans {
+		problems = append(problems, fmt.Errorf("%w: %s", errGeneratedOrphaned, filepath.Join(relDir, name)))
+	}
+
+	return problems
+}
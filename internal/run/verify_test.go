@@ -0,0 +1,208 @@
+//nolint:testpackage // Tests internal functions
+package run
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseDirective(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		line   string
+		want   []string
+		wantOK bool
+	}{
+		{
+			name:   "impgen directive",
+			line:   "//go:generate impgen pkg.Repo --dependency",
+			want:   []string{"pkg.Repo", "--dependency"},
+			wantOK: true,
+		},
+		{
+			name:   "impgen via go run",
+			line:   "//go:generate go run ./impgen pkg.Run --target",
+			want:   []string{"pkg.Run", "--target"},
+			wantOK: true,
+		},
+		{
+			name:   "bare impgen",
+			line:   "//go:generate impgen",
+			want:   []string{},
+			wantOK: true,
+		},
+		{
+			name: "other generator",
+			line: "//go:generate stringer -type=Kind",
+		},
+		{
+			name: "indented comment is not a directive",
+			line: "\t// //go:generate impgen pkg.Repo",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := parseDirective(testCase.line)
+			if ok != testCase.wantOK || !slices.Equal(got, testCase.want) {
+				t.Errorf("parseDirective(%q) = %v, %v, want %v, %v",
+					testCase.line, got, ok, testCase.want, testCase.wantOK)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest // t.Chdir is incompatible with t.Parallel
+func TestRun_Verify(t *testing.T) {
+	root := t.TempDir()
+	pkgDir := filepath.Join(root, "pkg")
+	generated := filepath.Join(pkgDir, "generated_MockTestIface_test.go")
+
+	writeFile(t, filepath.Join(root, "go.mod"), "module example.com/app\n")
+	writeFile(t, filepath.Join(pkgDir, "x_test.go"),
+		"package testpkg_test\n\n//go:generate impgen TestIface --dependency\n")
+
+	loader, _ := createTestInterfaceAST("TestIface")
+	fileSystem := diskFileSystem{}
+	verify := func() error {
+		t.Helper()
+		t.Chdir(root)
+
+		return Run([]string{"impgen", "verify", "./..."}, os.Getenv, fileSystem, loader, io.Discard)
+	}
+
+	err := verify()
+	if !errors.Is(err, errGeneratedMissing) {
+		t.Fatalf("before generating: Run() error = %v, want %v", err, errGeneratedMissing)
+	}
+
+	// Generate the file the way go generate would
+	t.Chdir(pkgDir)
+
+	getEnv := func(key string) string {
+		if key == goPackageEnvVarName {
+			return "testpkg_test"
+		}
+
+		return ""
+	}
+
+	err = Run([]string{"impgen", "TestIface", "--dependency"}, getEnv, fileSystem, loader, io.Discard)
+	if err != nil {
+		t.Fatalf("generating: Run() error = %v", err)
+	}
+
+	err = verify()
+	if err != nil {
+		t.Fatalf("after generating: Run() error = %v", err)
+	}
+
+	data, err := os.ReadFile(generated) //nolint:gosec // G304: test temp dir
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	writeFile(t, generated, strings.Replace(string(data), hashPrefix, hashPrefix+"0", 1))
+
+	err = verify()
+	if !errors.Is(err, errGeneratedStale) {
+		t.Errorf("after changing the hash: Run() error = %v, want %v", err, errGeneratedStale)
+	}
+
+	writeFile(t, generated, string(data))
+	writeFile(t, filepath.Join(pkgDir, "generated_MockRemoved_test.go"), generatedHeader+"\npackage testpkg_test\n")
+
+	err = verify()
+	if !errors.Is(err, errGeneratedOrphaned) {
+		t.Errorf("with an orphaned file: Run() error = %v, want %v", err, errGeneratedOrphaned)
+	}
+}
+
+//nolint:paralleltest // t.Chdir is incompatible with t.Parallel
+func TestRun_VerifyOutputDir(t *testing.T) {
+	root := t.TempDir()
+	pkgDir := filepath.Join(root, "pkg")
+	mockDir := filepath.Join(root, "mocks", "testmock")
+
+	writeFile(t, filepath.Join(root, "go.mod"), "module example.com/app\n")
+	writeFile(t, filepath.Join(pkgDir, "x_test.go"),
+		"package testpkg_test\n\n//go:generate impgen TestIface --dependency --output-dir ../mocks/testmock\n")
+
+	err := os.MkdirAll(mockDir, 0o750)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loader, _ := createTestInterfaceAST("TestIface")
+	fileSystem := diskFileSystem{}
+
+	t.Chdir(pkgDir)
+
+	getEnv := func(key string) string {
+		if key == goPackageEnvVarName {
+			return "testpkg_test"
+		}
+
+		return ""
+	}
+
+	err = Run([]string{"impgen", "TestIface", "--dependency", "--output-dir", "../mocks/testmock"},
+		getEnv, fileSystem, loader, io.Discard)
+	if err != nil {
+		t.Fatalf("generating: Run() error = %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(mockDir, "generated_MockTestIface.go")) //nolint:gosec // G304: test temp dir
+	if err != nil {
+		t.Fatalf("generated file not in the output dir: %v", err)
+	}
+
+	if !strings.Contains(string(data), "\npackage testmock\n") {
+		t.Errorf("generated file is not in package testmock:\n%s", data)
+	}
+
+	// The output dir is verified before the directive's dir, but its file is generated, not orphaned
+	t.Chdir(root)
+
+	err = Run([]string{"impgen", "verify", "./..."}, os.Getenv, fileSystem, loader, io.Discard)
+	if err != nil {
+		t.Errorf("verify: Run() error = %v", err)
+	}
+}
+
+type diskFileSystem struct{}
+
+func (diskFileSystem) Glob(pattern string) ([]string, error) {
+	return filepath.Glob(pattern)
+}
+
+func (diskFileSystem) ReadFile(name string) ([]byte, error) {
+	return os.ReadFile(name) //nolint:gosec // G304: test temp dir
+}
+
+func (diskFileSystem) WriteFile(name string, data []byte, perm os.FileMode) error {
+	return os.WriteFile(name, data, perm)
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+
+	err := os.MkdirAll(filepath.Dir(path), 0o750)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = os.WriteFile(path, []byte(content), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,100 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Capture returns a matcher that stores the argument in target once the call matches,
+// so a callback, channel, or generated ID can be used later in the test. It matches any
+// value of type T, or only values that also satisfy every inner matcher:
+//
+//	var done func(error)
+//	expect.Start.ArgsShould("job-1", Capture(&done)).Return()
+//	done(nil)
+//
+// Arguments are stored only once every matcher for the call has succeeded, so calls that
+// don't match never overwrite target. It may be nested in other matchers, such as And,
+// Fields, or WithTransform, and used in ReturnsShould. In Eventually mode, read target
+// only after the call has matched (e.g. after imptest.Wait or GetArgs).
+func Capture[T any](target *T, inner ...Matcher) Matcher {
+	return &captureMatcher[T]{
+		name:  "Capture",
+		inner: inner,
+		store: func(value T) { *target = value },
+	}
+}
+
+// CaptureAll is like Capture, but appends the argument of every matching call to target.
+// Pair it with an expectation per call, e.g. in a loop over Eventually expectations.
+func CaptureAll[T any](target *[]T, inner ...Matcher) Matcher {
+	return &captureMatcher[T]{
+		name:  "CaptureAll",
+		inner: inner,
+		store: func(value T) { *target = append(*target, value) },
+	}
+}
+
+type captureMatcher[T any] struct {
+	name   string
+	inner  []Matcher
+	store  func(T)
+	failed Matcher
+}
+
+// Commit stores the matched argument and commits the inner matchers. It is called by
+// imptest after the whole call matched.
+func (m *captureMatcher[T]) Commit(actual any) error {
+	value, _ := actual.(T)
+	m.store(value)
+
+	for _, inner := range m.inner {
+		err := commit(actual, inner)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *captureMatcher[T]) FailureMessage(actual any) string {
+	if m.failed == nil {
+		return fmt.Sprintf("expected %#v to be captured as %s", actual, reflect.TypeFor[T]())
+	}
+
+	return m.failed.FailureMessage(actual)
+}
+
+func (m *captureMatcher[T]) Match(actual any) (bool, error) {
+	_, ok := actual.(T)
+	if !ok && (actual != nil || !acceptsNil(reflect.TypeFor[T]())) {
+		return false, fmt.Errorf("%w: %s into %s, got %T", errTypeMismatch, m.name, reflect.TypeFor[T](), actual)
+	}
+
+	m.failed = nil
+
+	for _, inner := range m.inner {
+		matched, err := inner.Match(actual)
+		if err != nil {
+			return false, err
+		}
+
+		if !matched {
+			m.failed = inner
+
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (m *captureMatcher[T]) String() string {
+	desc := fmt.Sprintf("%s(%s", m.name, reflect.TypeFor[T]())
+	for _, inner := range m.inner {
+		desc += ", " + describe(inner)
+	}
+
+	return desc + ")"
+}
@@ -0,0 +1,153 @@
+package match
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// BeClosed returns a matcher that succeeds when the value is a closed channel.
+// It performs a non-blocking receive, so a buffered value is consumed and the
+// channel is reported as open.
+func BeClosed() Matcher {
+	return &closedMatcher{}
+}
+
+// Receive returns a matcher that succeeds when a value can be received from the channel
+// without blocking. With an expectation, which may be a matcher or a plain value, the
+// received value must also match it:
+//
+//	call.ReturnsShould(Receive(HavePrefix("job-")), BeNil())
+//
+// The receive consumes the value. Until the call that matched is committed, later
+// matches against the same channel reuse that value rather than receiving another, since
+// imptest may evaluate a call's matchers more than once. To wait for a value, use
+// imptest.ExpectReceive.
+func Receive(expected ...any) Matcher {
+	return &receiveMatcher{expected: expected}
+}
+
+// unexported variables.
+var (
+	errNotChannel          = errors.New("value is not a receivable channel")
+	errTooManyExpectations = errors.New("too many expectations")
+)
+
+// closedMatcher backs BeClosed. It remembers what the last receive saw for its message.
+type closedMatcher struct {
+	received reflect.Value
+}
+
+func (m *closedMatcher) FailureMessage(actual any) string {
+	if m.received.IsValid() {
+		return fmt.Sprintf("expected %T to be closed, but received %#v", actual, m.received.Interface())
+	}
+
+	return fmt.Sprintf("expected %T to be closed, but it was open", actual)
+}
+
+func (m *closedMatcher) Match(actual any) (bool, error) {
+	value, err := receivable(actual, "BeClosed")
+	if err != nil {
+		return false, err
+	}
+
+	received, ok := value.TryRecv()
+	m.received = reflect.Value{}
+
+	if ok {
+		m.received = received
+	}
+
+	return received.IsValid() && !ok, nil
+}
+
+func (m *closedMatcher) String() string {
+	return "BeClosed()"
+}
+
+// receiveMatcher backs Receive. It remembers the outcome of the last receive for its message,
+// and the channel a value was received from until that value is committed.
+type receiveMatcher struct {
+	expected []any
+	received reflect.Value
+	closed   bool
+	source   reflect.Value
+	pending  bool
+}
+
+// Commit commits the expectation with the value the matching receive consumed.
+func (m *receiveMatcher) Commit(any) error {
+	m.pending = false
+
+	if len(m.expected) == 1 && m.received.IsValid() {
+		return commit(m.received.Interface(), m.expected[0])
+	}
+
+	return nil
+}
+
+func (m *receiveMatcher) FailureMessage(actual any) string {
+	switch {
+	case m.closed:
+		return fmt.Sprintf("expected to receive from %T, but it was closed", actual)
+	case !m.received.IsValid():
+		return fmt.Sprintf("expected to receive from %T, but nothing was ready", actual)
+	default:
+		return "received value: " + mismatch(m.received.Interface(), m.expected[0])
+	}
+}
+
+func (m *receiveMatcher) Match(actual any) (bool, error) {
+	if len(m.expected) > 1 {
+		return false, fmt.Errorf("%w: Receive takes at most one, got %d", errTooManyExpectations, len(m.expected))
+	}
+
+	value, err := receivable(actual, "Receive")
+	if err != nil {
+		return false, err
+	}
+
+	received, ok := m.receive(value)
+	m.closed = received.IsValid() && !ok
+	m.received = reflect.Value{}
+
+	if !ok {
+		return false, nil
+	}
+
+	m.received = received
+
+	if len(m.expected) == 0 {
+		return true, nil
+	}
+
+	return matchOrEqual(received.Interface(), m.expected[0])
+}
+
+func (m *receiveMatcher) String() string {
+	return describeCall("Receive", m.expected)
+}
+
+// receive takes the next value from ch without blocking, or returns the value an earlier
+// Match took from ch if it hasn't been committed yet.
+func (m *receiveMatcher) receive(ch reflect.Value) (reflect.Value, bool) {
+	if m.pending && m.source.Pointer() == ch.Pointer() {
+		return m.received, true
+	}
+
+	received, ok := ch.TryRecv()
+	m.source, m.pending = ch, ok
+
+	return received, ok
+}
+
+// receivable returns actual as a channel value that can be received from.
+func receivable(actual any, name string) (reflect.Value, error) {
+	value := reflect.ValueOf(actual)
+	if value.Kind() != reflect.Chan || value.Type().ChanDir()&reflect.RecvDir == 0 {
+		return reflect.Value{}, fmt.Errorf("%w: %s expects a channel, got %T", errNotChannel, name, actual)
+	}
+
+	return value, nil
+}
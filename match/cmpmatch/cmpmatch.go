@@ -0,0 +1,69 @@
+// Package cmpmatch provides matchers built on go-cmp. It lives apart from match, which
+// depends only on the standard library, so that only tests using it depend on go-cmp.
+package cmpmatch
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/toejough/imptest/match"
+)
+
+// Cmp returns a matcher that compares values with go-cmp, so options such as
+// cmpopts.IgnoreFields, cmpopts.EquateApprox, and cmpopts.SortSlices control what
+// counts as equal. On failure it reports a cmp.Diff of the expected and actual values:
+//
+//	expect.Save.ArgsShould(Cmp(want, cmpopts.IgnoreFields(User{}, "Created"))).Return(nil)
+//
+// Structs with unexported fields need cmp.AllowUnexported or cmpopts.IgnoreUnexported;
+// without them the comparison fails with an error instead of panicking.
+func Cmp(expected any, opts ...cmp.Option) match.Matcher {
+	return cmpMatcher{expected: expected, opts: opts}
+}
+
+// CmpEquality returns a matcher factory for imptest.SetEquality, making Cmp with opts
+// the comparison behind ArgsEqual and ReturnsEqual for a test:
+//
+//	imptest.SetEquality(t, CmpEquality(cmpopts.EquateEmpty()))
+func CmpEquality(opts ...cmp.Option) func(expected any) match.Matcher {
+	return func(expected any) match.Matcher {
+		return Cmp(expected, opts...)
+	}
+}
+
+// unexported variables.
+var (
+	errCmp = errors.New("cmp cannot compare values")
+)
+
+type cmpMatcher struct {
+	expected any
+	opts     []cmp.Option
+}
+
+func (m cmpMatcher) FailureMessage(actual any) (message string) {
+	defer func() {
+		if r := recover(); r != nil {
+			message = fmt.Sprintf("expected %#v, got %#v", m.expected, actual)
+		}
+	}()
+
+	return "mismatch (-want +got):\n" + strings.TrimRight(cmp.Diff(m.expected, actual, m.opts...), "\n")
+}
+
+func (m cmpMatcher) Match(actual any) (matched bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			matched, err = false, fmt.Errorf("%w: %v", errCmp, r)
+		}
+	}()
+
+	return cmp.Equal(m.expected, actual, m.opts...), nil
+}
+
+func (m cmpMatcher) String() string {
+	return fmt.Sprintf("Cmp(%#v)", m.expected)
+}
@@ -0,0 +1,126 @@
+package match
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ContainElement returns a matcher that succeeds when a slice, array, or map holds an
+// element matching element, which may itself be a matcher. Maps are searched by value.
+func ContainElement(element any) Matcher {
+	return containElementMatcher{element: element}
+}
+
+// HaveLen returns a matcher that succeeds when a string, slice, array, map, or channel
+// has exactly length elements.
+func HaveLen(length int) Matcher {
+	return lenMatcher{length: length}
+}
+
+// unexported variables.
+var (
+	errNoElements = errors.New("value has no elements")
+	errNoLength   = errors.New("value has no length")
+)
+
+type containElementMatcher struct {
+	element any
+}
+
+// Commit commits the element expectation with the first element that matches it.
+func (m containElementMatcher) Commit(actual any) error {
+	elements, err := containerElements(actual)
+	if err != nil {
+		return nil //nolint:nilerr // Match already reported the unsupported container
+	}
+
+	for _, element := range elements {
+		if matched, err := matchOrEqual(element.Interface(), m.element); err == nil && matched {
+			return commit(element.Interface(), m.element)
+		}
+	}
+
+	return nil
+}
+
+func (m containElementMatcher) FailureMessage(actual any) string {
+	if _, ok := m.element.(Matcher); ok {
+		return fmt.Sprintf("expected %#v to contain an element matching %s", actual, describe(m.element))
+	}
+
+	return fmt.Sprintf("expected %#v to contain %#v", actual, m.element)
+}
+
+func (m containElementMatcher) Match(actual any) (bool, error) {
+	elements, err := containerElements(actual)
+	if err != nil {
+		return false, err
+	}
+
+	for _, element := range elements {
+		matched, err := matchOrEqual(element.Interface(), m.element)
+		if err != nil {
+			return false, err
+		}
+
+		if matched {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (m containElementMatcher) String() string {
+	return fmt.Sprintf("ContainElement(%s)", describe(m.element))
+}
+
+type lenMatcher struct {
+	length int
+}
+
+func (m lenMatcher) FailureMessage(actual any) string {
+	return fmt.Sprintf("expected length %d, got length %d: %#v", m.length, reflect.ValueOf(actual).Len(), actual)
+}
+
+func (m lenMatcher) Match(actual any) (bool, error) {
+	rv := reflect.ValueOf(actual)
+
+	//nolint:exhaustive // only these kinds have a length
+	switch rv.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
+		return rv.Len() == m.length, nil
+	default:
+		return false, fmt.Errorf("%w: HaveLen expects a string, slice, array, map, or channel, got %T",
+			errNoLength, actual)
+	}
+}
+
+func (m lenMatcher) String() string {
+	return fmt.Sprintf("HaveLen(%d)", m.length)
+}
+
+// containerElements lists the elements of a slice or array, or the values of a map.
+func containerElements(actual any) ([]reflect.Value, error) {
+	rv := reflect.ValueOf(actual)
+
+	var elements []reflect.Value
+
+	//nolint:exhaustive // only containers have elements
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := range rv.Len() {
+			elements = append(elements, rv.Index(i))
+		}
+	case reflect.Map:
+		iter := rv.MapRange()
+		for iter.Next() {
+			elements = append(elements, iter.Value())
+		}
+	default:
+		return nil, fmt.Errorf("%w: ContainElement expects a slice, array, or map, got %T", errNoElements, actual)
+	}
+
+	return elements, nil
+}
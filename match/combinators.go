@@ -0,0 +1,237 @@
+package match
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// AllOf returns a matcher that succeeds when the value matches every expectation.
+// Expectations may be matchers or plain values, which are compared with reflect.DeepEqual.
+func AllOf(expected ...any) Matcher {
+	return &allOfMatcher{name: "AllOf", expected: expected}
+}
+
+// And returns a matcher that succeeds when the value satisfies every matcher.
+// Matchers are checked in order and the first failure is reported:
+//
+//	expect.Handle.ArgsShould(And(
+//	    HaveField("Path", HavePrefix("/api")),
+//	    HaveField("Body", Not(HaveLen(0))),
+//	))
+func And(matchers ...Matcher) Matcher {
+	return &allOfMatcher{name: "And", expected: toAny(matchers)}
+}
+
+// AnyOf returns a matcher that succeeds when the value matches at least one expectation.
+// Expectations may be matchers or plain values, which are compared with reflect.DeepEqual:
+//
+//	expect.Fetch.ArgsShould(AnyOf("GET", "HEAD"), BeAny)
+func AnyOf(expected ...any) Matcher {
+	return &anyOfMatcher{name: "AnyOf", expected: expected}
+}
+
+// Not returns a matcher that succeeds when the value does not satisfy matcher.
+// Matchers with a NegatedFailureMessage method, such as gomega's, supply the message.
+func Not(matcher Matcher) Matcher {
+	return &notMatcher{matcher: matcher}
+}
+
+// Or returns a matcher that succeeds when the value satisfies at least one matcher.
+// On failure, every matcher's message is reported.
+func Or(matchers ...Matcher) Matcher {
+	return &anyOfMatcher{name: "Or", expected: toAny(matchers)}
+}
+
+// WithTransform returns a matcher that applies transform to the value and checks the
+// result against expected, which may be a matcher or a plain value:
+//
+//	expect.Handle.ArgsShould(WithTransform(func(r *http.Request) string {
+//	    return r.URL.Path
+//	}, HavePrefix("/api")))
+func WithTransform[T, U any](transform func(T) U, expected any) Matcher {
+	return &transformMatcher[T, U]{transform: transform, expected: expected}
+}
+
+// unexported variables.
+var (
+	errTypeMismatch = errors.New("type mismatch")
+)
+
+// allOfMatcher backs And and AllOf.
+type allOfMatcher struct {
+	name     string
+	expected []any
+	failed   int
+}
+
+// Commit commits every condition, since all of them matched.
+func (m *allOfMatcher) Commit(actual any) error {
+	for _, expected := range m.expected {
+		err := commit(actual, expected)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *allOfMatcher) FailureMessage(actual any) string {
+	return fmt.Sprintf("failed condition %d of %d in %s:\n%s",
+		m.failed+1, len(m.expected), m.name, indent(mismatch(actual, m.expected[m.failed])))
+}
+
+func (m *allOfMatcher) Match(actual any) (bool, error) {
+	for index, expected := range m.expected {
+		matched, err := matchOrEqual(actual, expected)
+		if err != nil {
+			return false, fmt.Errorf("%s condition %d: %w", m.name, index+1, err)
+		}
+
+		if !matched {
+			m.failed = index
+
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (m *allOfMatcher) String() string {
+	return describeCall(m.name, m.expected)
+}
+
+// anyOfMatcher backs Or and AnyOf. It remembers which condition matched last.
+type anyOfMatcher struct {
+	name     string
+	expected []any
+	matched  int
+}
+
+// Commit commits only the condition that matched.
+func (m *anyOfMatcher) Commit(actual any) error {
+	return commit(actual, m.expected[m.matched])
+}
+
+func (m *anyOfMatcher) FailureMessage(actual any) string {
+	lines := make([]string, 0, len(m.expected))
+
+	for _, expected := range m.expected {
+		lines = append(lines, "- "+strings.TrimPrefix(indent(mismatch(actual, expected)), "  "))
+	}
+
+	return fmt.Sprintf("matched none of %d conditions in %s:\n%s",
+		len(m.expected), m.name, indent(strings.Join(lines, "\n")))
+}
+
+func (m *anyOfMatcher) Match(actual any) (bool, error) {
+	for index, expected := range m.expected {
+		matched, err := matchOrEqual(actual, expected)
+		if err != nil {
+			return false, fmt.Errorf("%s condition %d: %w", m.name, index+1, err)
+		}
+
+		if matched {
+			m.matched = index
+
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (m *anyOfMatcher) String() string {
+	return describeCall(m.name, m.expected)
+}
+
+type notMatcher struct {
+	matcher Matcher
+}
+
+func (m *notMatcher) FailureMessage(actual any) string {
+	if negated, ok := m.matcher.(interface{ NegatedFailureMessage(actual any) string }); ok {
+		return negated.NegatedFailureMessage(actual)
+	}
+
+	return fmt.Sprintf("expected %#v not to satisfy %s", actual, describe(m.matcher))
+}
+
+func (m *notMatcher) Match(actual any) (bool, error) {
+	matched, err := m.matcher.Match(actual)
+
+	return !matched, err
+}
+
+func (m *notMatcher) String() string {
+	return fmt.Sprintf("Not(%s)", describe(m.matcher))
+}
+
+type transformMatcher[T, U any] struct {
+	transform   func(T) U
+	expected    any
+	transformed U
+}
+
+// Commit commits the expectation with the transformed value.
+func (m *transformMatcher[T, U]) Commit(any) error {
+	return commit(m.transformed, m.expected)
+}
+
+func (m *transformMatcher[T, U]) FailureMessage(any) string {
+	return "after transform: " + mismatch(m.transformed, m.expected)
+}
+
+func (m *transformMatcher[T, U]) Match(actual any) (bool, error) {
+	value, ok := actual.(T)
+	if !ok && (actual != nil || !acceptsNil(reflect.TypeFor[T]())) {
+		return false, fmt.Errorf("%w: WithTransform expects %s, got %T", errTypeMismatch, reflect.TypeFor[T](), actual)
+	}
+
+	m.transformed = m.transform(value)
+
+	return matchOrEqual(m.transformed, m.expected)
+}
+
+func (m *transformMatcher[T, U]) String() string {
+	return fmt.Sprintf("WithTransform(%T, %s)", m.transform, describe(m.expected))
+}
+
+// acceptsNil reports whether nil is a valid value of typ.
+func acceptsNil(typ reflect.Type) bool {
+	//nolint:exhaustive // only nillable kinds accept nil
+	switch typ.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice:
+		return true
+	default:
+		return false
+	}
+}
+
+// describeCall renders a combinator and its expectations, e.g. And(Equal(1), HaveLen(2)).
+func describeCall(name string, expected []any) string {
+	parts := make([]string, 0, len(expected))
+	for _, e := range expected {
+		parts = append(parts, describe(e))
+	}
+
+	return fmt.Sprintf("%s(%s)", name, strings.Join(parts, ", "))
+}
+
+// indent prefixes every line of a nested failure message with two spaces.
+func indent(message string) string {
+	return "  " + strings.ReplaceAll(message, "\n", "\n  ")
+}
+
+// toAny widens matchers for the value-or-matcher combinators.
+func toAny(matchers []Matcher) []any {
+	expected := make([]any, 0, len(matchers))
+	for _, m := range matchers {
+		expected = append(expected, m)
+	}
+
+	return expected
+}
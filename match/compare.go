@@ -0,0 +1,211 @@
+package match
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"time"
+)
+
+// BeNumerically returns a matcher that compares a number against compareTo using one of
+// "==", "!=", "<", "<=", ">", ">=", or "~". Integers and floats of any size can be mixed.
+// "~" succeeds when the values are within an optional threshold (default 1e-8):
+//
+//	expect.Scale.ArgsShould(BeNumerically(">", 0), BeNumerically("~", 0.5, 0.01))
+func BeNumerically(comparator string, compareTo ...any) Matcher {
+	return numericMatcher{comparator: comparator, compareTo: compareTo}
+}
+
+// BeTemporally returns a matcher that compares a time.Time against compareTo using one
+// of "==", "!=", "<", "<=", ">", ">=", or "~". "~" succeeds when the times are within an
+// optional threshold (default 1ms).
+func BeTemporally(comparator string, compareTo time.Time, threshold ...time.Duration) Matcher {
+	return temporalMatcher{comparator: comparator, compareTo: compareTo, threshold: threshold}
+}
+
+// unexported constants.
+const (
+	defaultNumericThreshold  = 1e-8
+	defaultTemporalThreshold = time.Millisecond
+)
+
+// unexported variables.
+var (
+	errBadComparator = errors.New("invalid comparator")
+	errNotNumber     = errors.New("value is not a number")
+	errNotTime       = errors.New("value is not a time.Time")
+)
+
+type numericMatcher struct {
+	comparator string
+	compareTo  []any
+}
+
+func (m numericMatcher) FailureMessage(actual any) string {
+	if m.comparator == "~" {
+		return fmt.Sprintf("expected %v to be ~ %v (within %v)", actual, m.operand(), m.threshold())
+	}
+
+	return fmt.Sprintf("expected %v to be %s %v", actual, m.comparator, m.operand())
+}
+
+func (m numericMatcher) Match(actual any) (bool, error) {
+	err := checkComparator("BeNumerically", m.comparator, len(m.compareTo))
+	if err != nil {
+		return false, err
+	}
+
+	want, ok := toBigFloat(m.compareTo[0])
+	if !ok {
+		return false, fmt.Errorf("%w: BeNumerically compares against a number, got %T", errNotNumber, m.compareTo[0])
+	}
+
+	got, ok := toBigFloat(actual)
+	if !ok {
+		return false, fmt.Errorf("%w: BeNumerically expects a number, got %T", errNotNumber, actual)
+	}
+
+	if got == nil || want == nil { // NaN is unequal to everything
+		return m.comparator == "!=", nil
+	}
+
+	if m.comparator == "~" {
+		gotFloat, _ := got.Float64()
+		wantFloat, _ := want.Float64()
+
+		return math.Abs(gotFloat-wantFloat) <= m.threshold(), nil
+	}
+
+	return compare(m.comparator, got.Cmp(want)), nil
+}
+
+func (m numericMatcher) String() string {
+	return fmt.Sprintf("BeNumerically(%q, %v)", m.comparator, m.operand())
+}
+
+// operand returns the value being compared against, or nil if none was given.
+func (m numericMatcher) operand() any {
+	if len(m.compareTo) == 0 {
+		return nil
+	}
+
+	return m.compareTo[0]
+}
+
+func (m numericMatcher) threshold() float64 {
+	if len(m.compareTo) > 1 {
+		if threshold, ok := toBigFloat(m.compareTo[1]); ok && threshold != nil {
+			value, _ := threshold.Float64()
+
+			return value
+		}
+	}
+
+	return defaultNumericThreshold
+}
+
+type temporalMatcher struct {
+	comparator string
+	compareTo  time.Time
+	threshold  []time.Duration
+}
+
+func (m temporalMatcher) FailureMessage(actual any) string {
+	if m.comparator == "~" {
+		return fmt.Sprintf("expected %v to be ~ %v (within %v)", actual, m.compareTo, m.within())
+	}
+
+	return fmt.Sprintf("expected %v to be %s %v", actual, m.comparator, m.compareTo)
+}
+
+func (m temporalMatcher) Match(actual any) (bool, error) {
+	err := checkComparator("BeTemporally", m.comparator, len(m.threshold)+1)
+	if err != nil {
+		return false, err
+	}
+
+	got, ok := actual.(time.Time)
+	if !ok {
+		return false, fmt.Errorf("%w: BeTemporally expects a time.Time, got %T", errNotTime, actual)
+	}
+
+	if m.comparator == "~" {
+		return got.Sub(m.compareTo).Abs() <= m.within(), nil
+	}
+
+	return compare(m.comparator, got.Compare(m.compareTo)), nil
+}
+
+func (m temporalMatcher) String() string {
+	return fmt.Sprintf("BeTemporally(%q, %v)", m.comparator, m.compareTo)
+}
+
+func (m temporalMatcher) within() time.Duration {
+	if len(m.threshold) > 0 {
+		return m.threshold[0]
+	}
+
+	return defaultTemporalThreshold
+}
+
+// checkComparator validates a comparator and its operand count: one operand, plus an
+// optional threshold for "~".
+func checkComparator(name, comparator string, operands int) error {
+	switch comparator {
+	case "==", "!=", "<", "<=", ">", ">=":
+		if operands == 1 {
+			return nil
+		}
+	case "~":
+		if operands == 1 || operands == 2 { //nolint:mnd // value and optional threshold
+			return nil
+		}
+	default:
+		return fmt.Errorf("%w: %s does not support %q", errBadComparator, name, comparator)
+	}
+
+	return fmt.Errorf("%w: %s(%q) takes a value and, for \"~\", a threshold; got %d operands",
+		errBadComparator, name, comparator, operands)
+}
+
+// compare applies comparator to the result of a three-way comparison.
+func compare(comparator string, cmp int) bool {
+	switch comparator {
+	case "==":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	default:
+		return cmp >= 0
+	}
+}
+
+// toBigFloat converts any integer or float kind to an exact big.Float.
+// It returns (nil, true) for NaN and (nil, false) for non-numbers.
+func toBigFloat(value any) (*big.Float, bool) {
+	rv := reflect.ValueOf(value)
+
+	//nolint:exhaustive // only numeric kinds convert
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Float).SetInt64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Float).SetUint64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		if math.IsNaN(rv.Float()) {
+			return nil, true
+		}
+
+		return new(big.Float).SetFloat64(rv.Float()), true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,150 @@
+// Package docmatch provides matchers that compare serialized JSON and YAML documents. They
+// live apart from match, which depends only on the standard library, because they need
+// go-cmp and a YAML decoder.
+package docmatch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"go.yaml.in/yaml/v3"
+
+	"github.com/toejough/imptest/match"
+)
+
+// MatchJSON returns a matcher that succeeds when the value is a JSON document that is
+// semantically equal to expected, ignoring key order and whitespace. Both the value and
+// expected may be a string or []byte; any other expected value is encoded as JSON first.
+// On mismatch the failure message is a diff of the decoded documents.
+func MatchJSON(expected any) match.Matcher {
+	return &documentMatcher{
+		name:     "MatchJSON",
+		format:   "JSON",
+		expected: expected,
+		marshal:  json.Marshal,
+		decode:   func(data []byte, value *any) error { return json.Unmarshal(data, value) },
+	}
+}
+
+// MatchYAML returns a matcher that succeeds when the value is a YAML document that is
+// semantically equal to expected, ignoring key order, formatting, and comments. Both the
+// value and expected may be a string or []byte; any other expected value is encoded as
+// YAML first. On mismatch the failure message is a diff of the decoded documents.
+func MatchYAML(expected any) match.Matcher {
+	return &documentMatcher{
+		name:     "MatchYAML",
+		format:   "YAML",
+		expected: expected,
+		marshal:  yaml.Marshal,
+		decode:   func(data []byte, value *any) error { return yaml.Unmarshal(data, value) },
+	}
+}
+
+// unexported variables.
+var (
+	errBadDocument = errors.New("invalid document")
+	errNotString   = errors.New("value is not a string")
+)
+
+// documentMatcher backs MatchJSON and MatchYAML. It keeps the decoded documents of the
+// last comparison for its message.
+type documentMatcher struct {
+	name     string
+	format   string
+	expected any
+	marshal  func(any) ([]byte, error)
+	decode   func([]byte, *any) error
+	want     any
+	got      any
+}
+
+func (m *documentMatcher) FailureMessage(any) string {
+	return fmt.Sprintf("%s mismatch (-want +got):\n%s", m.format, strings.TrimRight(cmp.Diff(m.want, m.got), "\n"))
+}
+
+func (m *documentMatcher) Match(actual any) (bool, error) {
+	want, err := m.document(m.expected, true)
+	if err != nil {
+		return false, fmt.Errorf("%w: %s expected value: %w", errBadDocument, m.name, err)
+	}
+
+	got, err := m.document(actual, false)
+	if err != nil {
+		return false, fmt.Errorf("%w: %s actual value: %w", errBadDocument, m.name, err)
+	}
+
+	m.want, m.got = want, got
+
+	return cmp.Equal(want, got), nil
+}
+
+func (m *documentMatcher) String() string {
+	return fmt.Sprintf("%s(%s)", m.name, describeDocument(m.expected))
+}
+
+// document decodes value, which must be text unless encode allows other values to be
+// encoded in the matcher's format first.
+func (m *documentMatcher) document(value any, encode bool) (any, error) {
+	text, ok := stringOf(value)
+	if !ok && !encode {
+		return nil, fmt.Errorf("%w: expected a string or []byte, got %T", errNotString, value)
+	}
+
+	data := []byte(text)
+
+	if !ok {
+		encoded, err := m.marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode %T as %s: %w", value, m.format, err)
+		}
+
+		data = encoded
+	}
+
+	var decoded any
+
+	err := m.decode(data, &decoded)
+	if err != nil {
+		return nil, fmt.Errorf("not valid %s: %w", m.format, err)
+	}
+
+	return decoded, nil
+}
+
+// describeDocument renders an expected document for String, quoting text.
+func describeDocument(expected any) string {
+	if text, ok := stringOf(expected); ok {
+		return strconv.Quote(text)
+	}
+
+	return fmt.Sprintf("%#v", expected)
+}
+
+// stringOf returns the string form of strings, byte slices, and Stringers.
+func stringOf(value any) (string, bool) {
+	switch typed := value.(type) {
+	case string:
+		return typed, true
+	case []byte:
+		return string(typed), true
+	case fmt.Stringer:
+		return typed.String(), true
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.String {
+		return rv.String(), true
+	}
+
+	// Named byte slices, such as json.RawMessage.
+	if rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8 {
+		return string(rv.Bytes()), true
+	}
+
+	return "", false
+}
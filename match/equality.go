@@ -0,0 +1,137 @@
+package match
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// BeNil returns a matcher that succeeds for nil and for nil pointers, slices, maps,
+// channels, funcs, and interfaces.
+func BeNil() Matcher {
+	return nilMatcher{}
+}
+
+// BeZero returns a matcher that succeeds when the value is the zero value of its type.
+func BeZero() Matcher {
+	return zeroMatcher{}
+}
+
+// DeepEqual returns a matcher that compares values with reflect.DeepEqual.
+// This is the comparison ArgsEqual and ReturnsEqual use for plain values.
+func DeepEqual(expected any) Matcher {
+	return deepEqualMatcher{expected: expected}
+}
+
+// Equal returns a matcher that succeeds when the value has the same type as expected
+// and is == to it. Values whose type is not comparable produce an error; use DeepEqual
+// for slices, maps, and structs that contain them.
+func Equal(expected any) Matcher {
+	return equalMatcher{expected: expected}
+}
+
+// unexported variables.
+var (
+	errNotComparable = errors.New("not comparable")
+)
+
+type deepEqualMatcher struct {
+	expected any
+}
+
+func (m deepEqualMatcher) FailureMessage(actual any) string {
+	return fmt.Sprintf("expected %#v to deeply equal %#v", actual, m.expected)
+}
+
+func (m deepEqualMatcher) Match(actual any) (bool, error) {
+	return reflect.DeepEqual(actual, m.expected), nil
+}
+
+func (m deepEqualMatcher) String() string {
+	return fmt.Sprintf("DeepEqual(%#v)", m.expected)
+}
+
+type equalMatcher struct {
+	expected any
+}
+
+func (m equalMatcher) FailureMessage(actual any) string {
+	if reflect.TypeOf(actual) != reflect.TypeOf(m.expected) {
+		return fmt.Sprintf("expected %#v (%T) to equal %#v (%T)", actual, actual, m.expected, m.expected)
+	}
+
+	return fmt.Sprintf("expected %#v to equal %#v", actual, m.expected)
+}
+
+func (m equalMatcher) Match(actual any) (matched bool, err error) {
+	if reflect.TypeOf(actual) != reflect.TypeOf(m.expected) {
+		return false, nil
+	}
+
+	if actual == nil {
+		return true, nil
+	}
+
+	if !reflect.TypeOf(actual).Comparable() {
+		return false, fmt.Errorf("%w: Equal cannot compare %T values, use DeepEqual", errNotComparable, actual)
+	}
+
+	// A comparable struct can still hold an uncomparable value in an interface field.
+	defer func() {
+		if r := recover(); r != nil {
+			matched, err = false, fmt.Errorf("%w: %v", errNotComparable, r)
+		}
+	}()
+
+	return actual == m.expected, nil
+}
+
+func (m equalMatcher) String() string {
+	return fmt.Sprintf("Equal(%#v)", m.expected)
+}
+
+type nilMatcher struct{}
+
+func (nilMatcher) FailureMessage(actual any) string {
+	return fmt.Sprintf("expected nil, got %#v (%T)", actual, actual)
+}
+
+func (nilMatcher) Match(actual any) (bool, error) {
+	return isNil(actual), nil
+}
+
+func (nilMatcher) String() string {
+	return "BeNil()"
+}
+
+type zeroMatcher struct{}
+
+func (zeroMatcher) FailureMessage(actual any) string {
+	return fmt.Sprintf("expected the zero value, got %#v (%T)", actual, actual)
+}
+
+func (zeroMatcher) Match(actual any) (bool, error) {
+	return actual == nil || reflect.ValueOf(actual).IsZero(), nil
+}
+
+func (zeroMatcher) String() string {
+	return "BeZero()"
+}
+
+// isNil reports whether value is nil or a nil value of a nillable kind.
+func isNil(value any) bool {
+	if value == nil {
+		return true
+	}
+
+	rv := reflect.ValueOf(value)
+
+	//nolint:exhaustive // only nillable kinds can hold nil
+	switch rv.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice,
+		reflect.UnsafePointer:
+		return rv.IsNil()
+	default:
+		return false
+	}
+}
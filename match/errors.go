@@ -0,0 +1,106 @@
+package match
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrorAs returns a matcher that succeeds when an error has an error in its chain that
+// errors.As can assign to target, which must be a non-nil pointer to an interface or to
+// a type implementing error. On success, target holds the matching error.
+func ErrorAs(target any) Matcher {
+	return errorAsMatcher{target: target}
+}
+
+// ErrorIs returns a matcher that succeeds when errors.Is(actual, target) is true.
+func ErrorIs(target error) Matcher {
+	return errorIsMatcher{target: target}
+}
+
+// unexported variables.
+var (
+	errBadTarget = errors.New("invalid ErrorAs target")
+	errNotError  = errors.New("value is not an error")
+)
+
+type errorAsMatcher struct {
+	target any
+}
+
+func (m errorAsMatcher) FailureMessage(actual any) string {
+	if actual == nil {
+		return fmt.Sprintf("expected an error with a %s in its chain, got nil", m.targetType())
+	}
+
+	return fmt.Sprintf("expected error %q to have a %s in its chain", actual, m.targetType())
+}
+
+func (m errorAsMatcher) Match(actual any) (bool, error) {
+	err := checkErrorAsTarget(m.target)
+	if err != nil {
+		return false, err
+	}
+
+	actualErr, ok := actual.(error)
+	if !ok && actual != nil {
+		return false, fmt.Errorf("%w: ErrorAs expects an error, got %T", errNotError, actual)
+	}
+
+	return actualErr != nil && errors.As(actualErr, m.target), nil
+}
+
+func (m errorAsMatcher) String() string {
+	return fmt.Sprintf("ErrorAs(%s)", m.targetType())
+}
+
+// targetType names the type ErrorAs looks for, or the target's own type if it is invalid.
+func (m errorAsMatcher) targetType() string {
+	targetType := reflect.TypeOf(m.target)
+	if targetType == nil || targetType.Kind() != reflect.Pointer {
+		return fmt.Sprintf("%T", m.target)
+	}
+
+	return targetType.Elem().String()
+}
+
+type errorIsMatcher struct {
+	target error
+}
+
+func (m errorIsMatcher) FailureMessage(actual any) string {
+	if actual == nil {
+		return fmt.Sprintf("expected an error wrapping %q, got nil", m.target)
+	}
+
+	return fmt.Sprintf("expected error %q to wrap %q", actual, m.target)
+}
+
+func (m errorIsMatcher) Match(actual any) (bool, error) {
+	actualErr, ok := actual.(error)
+	if !ok && actual != nil {
+		return false, fmt.Errorf("%w: ErrorIs expects an error, got %T", errNotError, actual)
+	}
+
+	return errors.Is(actualErr, m.target), nil
+}
+
+func (m errorIsMatcher) String() string {
+	return fmt.Sprintf("ErrorIs(%q)", m.target)
+}
+
+// checkErrorAsTarget reports the targets errors.As would panic on.
+func checkErrorAsTarget(target any) error {
+	errorType := reflect.TypeFor[error]()
+
+	targetType := reflect.TypeOf(target)
+	if targetType == nil || targetType.Kind() != reflect.Pointer || reflect.ValueOf(target).IsNil() {
+		return fmt.Errorf("%w: expected a non-nil pointer, got %T", errBadTarget, target)
+	}
+
+	if elem := targetType.Elem(); elem.Kind() != reflect.Interface && !elem.Implements(errorType) {
+		return fmt.Errorf("%w: %s does not implement error", errBadTarget, elem)
+	}
+
+	return nil
+}
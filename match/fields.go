@@ -0,0 +1,203 @@
+package match
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// Fields matches a struct by a subset of its fields, ignoring fields that aren't listed.
+// Keys are field names or dotted paths, and values are matchers or plain values. A nested
+// Fields matches a nested struct, and failures are reported with the full field path:
+//
+//	expect.Save.ArgsShould(Fields{
+//	    "Name":    Equal("bob"),
+//	    "Age":     BeNumerically(">", 18),
+//	    "Address": Fields{"City": "Paris"},
+//	})
+//
+// It works on any struct, including the generated Args and Returns structs.
+type Fields map[string]any
+
+// Commit commits each field's expectation with that field of the matched struct.
+func (f Fields) Commit(actual any) error {
+	for _, name := range f.names() {
+		value, err := fieldByPath(actual, name)
+		if err != nil {
+			continue
+		}
+
+		err = commit(value, f[name])
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// FailureMessage lists every field that did not match, one per line.
+func (f Fields) FailureMessage(actual any) string {
+	failures, err := f.failures(actual, "")
+	if err != nil {
+		return err.Error()
+	}
+
+	return strings.Join(failures, "\n")
+}
+
+// Match reports whether every listed field matches.
+func (f Fields) Match(actual any) (bool, error) {
+	failures, err := f.failures(actual, "")
+
+	return len(failures) == 0 && err == nil, err
+}
+
+func (f Fields) String() string {
+	parts := make([]string, 0, len(f))
+	for _, name := range f.names() {
+		parts = append(parts, fmt.Sprintf("%q: %s", name, describe(f[name])))
+	}
+
+	return fmt.Sprintf("Fields{%s}", strings.Join(parts, ", "))
+}
+
+// failures checks each listed field of actual, naming failures with prefix plus the field path.
+func (f Fields) failures(actual any, prefix string) ([]string, error) {
+	var failures []string
+
+	for _, name := range f.names() {
+		path := prefix + name
+
+		value, err := fieldByPath(actual, name)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", path, err)
+		}
+
+		if nested, ok := f[name].(Fields); ok {
+			inner, err := nested.failures(value, path+".")
+			if err != nil {
+				return nil, err
+			}
+
+			failures = append(failures, inner...)
+
+			continue
+		}
+
+		matched, err := matchOrEqual(value, f[name])
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", path, err)
+		}
+
+		if !matched {
+			failures = append(failures, fmt.Sprintf("field %s: %s", path, mismatch(value, f[name])))
+		}
+	}
+
+	return failures, nil
+}
+
+// names returns the listed field names in a stable order.
+func (f Fields) names() []string {
+	names := make([]string, 0, len(f))
+	for name := range f {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// HaveField returns a matcher that succeeds when the struct field at path matches
+// expected, which may be a plain value or a matcher. The path may name nested fields
+// with dots ("Request.URL.Path"); pointers and interfaces along the way are followed.
+func HaveField(path string, expected any) Matcher {
+	return &fieldMatcher{path: path, expected: expected}
+}
+
+// unexported variables.
+var (
+	errNoField = errors.New("no such field")
+)
+
+type fieldMatcher struct {
+	path     string
+	expected any
+	value    any
+}
+
+// Commit commits the expectation with the field value of the last match.
+func (m *fieldMatcher) Commit(any) error {
+	return commit(m.value, m.expected)
+}
+
+func (m *fieldMatcher) FailureMessage(any) string {
+	return fmt.Sprintf("field %s: %s", m.path, mismatch(m.value, m.expected))
+}
+
+func (m *fieldMatcher) Match(actual any) (bool, error) {
+	value, err := fieldByPath(actual, m.path)
+	if err != nil {
+		return false, err
+	}
+
+	m.value = value
+
+	return matchOrEqual(value, m.expected)
+}
+
+func (m *fieldMatcher) String() string {
+	return fmt.Sprintf("HaveField(%q, %s)", m.path, describe(m.expected))
+}
+
+// describePath names a partially walked field path for error messages.
+func describePath(walked string) string {
+	if walked == "" {
+		return "value"
+	}
+
+	return "field " + walked
+}
+
+// fieldByPath walks the dotted path through value's struct fields.
+func fieldByPath(value any, path string) (any, error) {
+	current := reflect.ValueOf(value)
+	walked := ""
+
+	for name := range strings.SplitSeq(path, ".") {
+		for current.Kind() == reflect.Pointer || current.Kind() == reflect.Interface {
+			if current.IsNil() {
+				return nil, fmt.Errorf("%w: cannot read %s through nil %s", errNoField, name, describePath(walked))
+			}
+
+			current = current.Elem()
+		}
+
+		if current.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("%w: %s is a %s, not a struct", errNoField, describePath(walked), current.Kind())
+		}
+
+		field, ok := current.Type().FieldByName(name)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s has no field %s", errNoField, current.Type(), name)
+		}
+
+		if !field.IsExported() {
+			return nil, fmt.Errorf("%w: %s.%s is unexported", errNoField, current.Type(), name)
+		}
+
+		next, err := current.FieldByIndexErr(field.Index)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s.%s: %w", errNoField, current.Type(), name, err)
+		}
+
+		current = next
+		walked = strings.TrimPrefix(walked+"."+name, ".")
+	}
+
+	return current.Interface(), nil
+}
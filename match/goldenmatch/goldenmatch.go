@@ -0,0 +1,155 @@
+// Package goldenmatch provides a matcher that compares values with golden files. It lives
+// apart from match, which depends only on the standard library, because it reports
+// mismatches with a text diff package.
+package goldenmatch
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/akedrou/textdiff"
+
+	"github.com/toejough/imptest/match"
+)
+
+// Exported constants.
+const (
+	// UpdateEnv is the environment variable that makes Golden matchers rewrite
+	// their files with the actual values, e.g. IMPTEST_UPDATE_GOLDEN=1 go test ./...
+	UpdateEnv = "IMPTEST_UPDATE_GOLDEN"
+)
+
+// Golden returns a matcher that compares the value's formatted form with the contents
+// of the file at path, resolved relative to the test's package directory:
+//
+//	expect.Exec.ArgsShould(Golden("testdata/report_query.golden"), BeAny).Return(nil)
+//
+// Strings and byte slices are compared as-is, errors by their message, and other values
+// as indented JSON. On mismatch the failure message is a unified diff.
+//
+// Set UpdateEnv, or pass -update to a test binary that defines an update flag, to
+// write the actual value to the file (creating directories as needed) instead of comparing.
+// The file is written only for the call that matched, once every other matcher agreed.
+func Golden(path string) match.Matcher {
+	return &goldenMatcher{path: path}
+}
+
+// unexported variables.
+var (
+	errGolden = errors.New("golden file")
+)
+
+// goldenMatcher backs Golden. It remembers the last comparison for its message.
+type goldenMatcher struct {
+	path    string
+	got     string
+	want    string
+	missing bool
+}
+
+// Commit writes the matched value to the file when golden files are being updated.
+// Match only compares, so the file is written once, for the call that matched. A write
+// failure is returned for imptest to fail the test with.
+func (m *goldenMatcher) Commit(actual any) error {
+	if !updateGolden() {
+		return nil
+	}
+
+	got, err := goldenText(actual)
+	if err == nil {
+		err = os.WriteFile(m.path, []byte(got), 0o600)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w: updating %s: %w", errGolden, m.path, err)
+	}
+
+	return nil
+}
+
+func (m *goldenMatcher) FailureMessage(any) string {
+	if m.missing {
+		return fmt.Sprintf("golden file %s does not exist; rerun with %s=1 to create it", m.path, UpdateEnv)
+	}
+
+	return fmt.Sprintf("golden file %s differs; rerun with %s=1 to update it\n%s",
+		m.path, UpdateEnv, textdiff.Unified(m.path, "actual", m.want, m.got))
+}
+
+func (m *goldenMatcher) Match(actual any) (bool, error) {
+	got, err := goldenText(actual)
+	if err != nil {
+		return false, err
+	}
+
+	m.got = got
+
+	if updateGolden() {
+		err := os.MkdirAll(filepath.Dir(m.path), 0o750)
+		if err != nil {
+			return false, fmt.Errorf("%w: updating %s: %w", errGolden, m.path, err)
+		}
+
+		return true, nil
+	}
+
+	want, err := os.ReadFile(m.path)
+	m.missing = errors.Is(err, fs.ErrNotExist)
+
+	if m.missing {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("%w: reading %s: %w", errGolden, m.path, err)
+	}
+
+	m.want = string(want)
+
+	return m.want == got, nil
+}
+
+func (m *goldenMatcher) String() string {
+	return fmt.Sprintf("Golden(%q)", m.path)
+}
+
+// goldenText renders a value in the form stored in golden files.
+func goldenText(actual any) (string, error) {
+	switch value := actual.(type) {
+	case string:
+		return value, nil
+	case []byte:
+		return string(value), nil
+	case error:
+		return value.Error(), nil
+	}
+
+	data, err := json.MarshalIndent(actual, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("%w: cannot serialize %T: %w", errGolden, actual, err)
+	}
+
+	return string(data) + "\n", nil
+}
+
+// updateGolden reports whether golden files should be rewritten, via UpdateEnv or
+// an -update flag defined by the test binary.
+func updateGolden() bool {
+	if update, err := strconv.ParseBool(os.Getenv(UpdateEnv)); err == nil && update {
+		return true
+	}
+
+	if f := flag.Lookup("update"); f != nil {
+		update, err := strconv.ParseBool(f.Value.String())
+
+		return err == nil && update
+	}
+
+	return false
+}
@@ -0,0 +1,229 @@
+package match
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// JSONPath returns a matcher that decodes a JSON document and checks the value at path
+// against expected, which may be a matcher or a plain value. Paths start at $ and use
+// dots for object keys and brackets for array indexes or quoted keys:
+//
+//	expect.Publish.ArgsShould("users", JSONPath("$.user.roles[0]", "admin")).Return(nil)
+//
+// JSON numbers decode as float64, so matchers see float64 values. Plain expected values
+// are converted through JSON first, so JSONPath("$.user.id", 42) matches.
+func JSONPath(path string, expected any) Matcher {
+	steps, err := parseJSONPath(path)
+
+	return &jsonPathMatcher{path: path, steps: steps, err: err, expected: expected}
+}
+
+// unexported variables.
+var (
+	errBadDocument = errors.New("invalid document")
+	errBadPath     = errors.New("invalid JSON path")
+)
+
+// jsonPathMatcher backs JSONPath. It keeps the value found by the last match, or why
+// none was found, for its message.
+type jsonPathMatcher struct {
+	path     string
+	steps    []jsonPathStep
+	err      error
+	expected any
+	value    any
+	missing  string
+}
+
+// Commit commits the expectation with the value found by the last match.
+func (m *jsonPathMatcher) Commit(any) error {
+	return commit(m.value, m.expected)
+}
+
+func (m *jsonPathMatcher) FailureMessage(any) string {
+	if m.missing != "" {
+		return fmt.Sprintf("%s not found: %s", m.path, m.missing)
+	}
+
+	return fmt.Sprintf("%s: %s", m.path, mismatch(m.value, m.expected))
+}
+
+func (m *jsonPathMatcher) Match(actual any) (bool, error) {
+	if m.err != nil {
+		return false, m.err
+	}
+
+	text, ok := stringOf(actual)
+	if !ok {
+		return false, fmt.Errorf("%w: JSONPath expects a string or []byte, got %T", errNotString, actual)
+	}
+
+	var document any
+
+	err := json.Unmarshal([]byte(text), &document)
+	if err != nil {
+		return false, fmt.Errorf("%w: JSONPath: not valid JSON: %w", errBadDocument, err)
+	}
+
+	m.value, m.missing = walkJSONPath(document, m.steps)
+	if m.missing != "" {
+		return false, nil
+	}
+
+	expected := m.expected
+	if _, isMatcher := expected.(Matcher); !isMatcher {
+		expected, err = jsonRoundTrip(expected)
+		if err != nil {
+			return false, fmt.Errorf("%w: JSONPath expected value: %w", errBadDocument, err)
+		}
+	}
+
+	return matchOrEqual(m.value, expected)
+}
+
+func (m *jsonPathMatcher) String() string {
+	return fmt.Sprintf("JSONPath(%q, %s)", m.path, describe(m.expected))
+}
+
+// jsonPathStep is an object key or, if isIndex, an array index.
+type jsonPathStep struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+// jsonKind names the JSON type of a decoded value.
+func jsonKind(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "a boolean"
+	case float64:
+		return "a number"
+	case string:
+		return "a string"
+	case []any:
+		return "an array"
+	default:
+		return "an object"
+	}
+}
+
+// jsonRoundTrip converts a Go value to the form json.Unmarshal produces for it.
+func jsonRoundTrip(value any) (any, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("cannot encode %T as JSON: %w", value, err)
+	}
+
+	var decoded any
+
+	err = json.Unmarshal(data, &decoded)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode %T from JSON: %w", value, err)
+	}
+
+	return decoded, nil
+}
+
+// parseJSONPath splits a path like $.items[0]['first name'] into steps.
+func parseJSONPath(path string) ([]jsonPathStep, error) {
+	rest, ok := strings.CutPrefix(path, "$")
+	if !ok {
+		return nil, fmt.Errorf("%w: %q must start with $", errBadPath, path)
+	}
+
+	var steps []jsonPathStep
+
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			end := strings.IndexAny(rest[1:], ".[") + 1
+			if end == 0 {
+				end = len(rest)
+			}
+
+			if end == 1 {
+				return nil, fmt.Errorf("%w: %q has an empty key", errBadPath, path)
+			}
+
+			steps = append(steps, jsonPathStep{key: rest[1:end]})
+			rest = rest[end:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("%w: %q has an unclosed [", errBadPath, path)
+			}
+
+			step, err := parseJSONPathBracket(rest[1:end])
+			if err != nil {
+				return nil, fmt.Errorf("%w: %q: %w", errBadPath, path, err)
+			}
+
+			steps = append(steps, step)
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("%w: %q: expected . or [ at %q", errBadPath, path, rest)
+		}
+	}
+
+	return steps, nil
+}
+
+// parseJSONPathBracket parses the inside of [...]: an index or a quoted key.
+func parseJSONPathBracket(inner string) (jsonPathStep, error) {
+	if len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0] {
+		return jsonPathStep{key: inner[1 : len(inner)-1]}, nil
+	}
+
+	index, err := strconv.Atoi(inner)
+	if err != nil || index < 0 {
+		return jsonPathStep{}, fmt.Errorf("[%s] is not an index or quoted key", inner)
+	}
+
+	return jsonPathStep{index: index, isIndex: true}, nil
+}
+
+// walkJSONPath follows steps through a decoded JSON document. If a step cannot be
+// followed, it returns a description of why instead of a value.
+func walkJSONPath(document any, steps []jsonPathStep) (any, string) {
+	current := document
+	at := "$"
+
+	for _, step := range steps {
+		if step.isIndex {
+			items, ok := current.([]any)
+			if !ok {
+				return nil, fmt.Sprintf("%s is %s, not an array", at, jsonKind(current))
+			}
+
+			if step.index >= len(items) {
+				return nil, fmt.Sprintf("%s has no index %d (length %d)", at, step.index, len(items))
+			}
+
+			current = items[step.index]
+			at += fmt.Sprintf("[%d]", step.index)
+
+			continue
+		}
+
+		object, ok := current.(map[string]any)
+		if !ok {
+			return nil, fmt.Sprintf("%s is %s, not an object", at, jsonKind(current))
+		}
+
+		current, ok = object[step.key]
+		if !ok {
+			return nil, fmt.Sprintf("%s has no key %q", at, step.key)
+		}
+
+		at += "." + step.key
+	}
+
+	return current, ""
+}
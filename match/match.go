@@ -1,15 +1,25 @@
 // Package match provides matchers for use with imptest's ArgsShould, ReturnsShould, and PanicShould.
-// This package is designed to be dot-imported alongside gomega matchers:
+// The matchers depend only on the standard library and are named after their gomega
+// counterparts, so the package is designed to be dot-imported in place of gomega:
 //
-//	import (
-//	    . "github.com/onsi/gomega"
-//	    . "github.com/toejough/imptest/match"
-//	)
+//	import . "github.com/toejough/imptest/match"
 //
 //	expect.Add.ArgsShould(BeNumerically(">", 0), BeAny).Return(42)
+//
+// Failure messages describe the mismatched value on its own, since imptest already
+// prefixes them with the method and argument position. Gomega matchers, or any type
+// with Match and FailureMessage methods, can be used wherever a Matcher is accepted.
+//
+// Matchers that need other modules live in subpackages: cmpmatch (go-cmp), docmatch
+// (JSON and YAML documents), and goldenmatch (golden files).
 package match
 
-import "github.com/toejough/imptest/internal/core"
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/toejough/imptest/internal/core"
+)
 
 // BeAny matches any value - useful for "don't care" arguments.
 var BeAny = core.BeAny //nolint:gochecknoglobals // BeAny is an exported sentinel value
@@ -26,6 +36,53 @@ type Matcher = core.Matcher
 //	    if x < 0 { return fmt.Errorf("expected positive, got %d", x) }
 //	    return nil
 //	}))
+//
+// Given to PanicShould, a predicate on imptest.PanicInfo receives the panic value
+// together with the stack trace where it was recovered.
 func Satisfy[T any](predicate func(T) error) Matcher {
 	return core.Satisfy(predicate)
 }
+
+// commit passes the matched value on to expected if it is a core.Committer, so that
+// captors and other side effects nested inside a matcher take effect for the matched call.
+func commit(actual, expected any) error {
+	if committer, ok := expected.(core.Committer); ok {
+		return committer.Commit(actual)
+	}
+
+	return nil
+}
+
+// describe renders an expectation for use inside another matcher's failure message.
+// Matchers describe themselves via String when they can, otherwise by type; plain
+// values are rendered with %#v.
+func describe(expected any) string {
+	if matcher, ok := expected.(Matcher); ok {
+		if stringer, ok := matcher.(fmt.Stringer); ok {
+			return stringer.String()
+		}
+
+		return fmt.Sprintf("%T", matcher)
+	}
+
+	return fmt.Sprintf("%#v", expected)
+}
+
+// matchOrEqual checks actual against expected, which may be a Matcher or a plain
+// value compared with reflect.DeepEqual.
+func matchOrEqual(actual, expected any) (bool, error) {
+	if matcher, ok := expected.(Matcher); ok {
+		return matcher.Match(actual)
+	}
+
+	return reflect.DeepEqual(actual, expected), nil
+}
+
+// mismatch explains why actual failed matchOrEqual against expected.
+func mismatch(actual, expected any) string {
+	if matcher, ok := expected.(Matcher); ok {
+		return matcher.FailureMessage(actual)
+	}
+
+	return fmt.Sprintf("expected %#v, got %#v", expected, actual)
+}
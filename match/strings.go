@@ -0,0 +1,106 @@
+package match
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// ContainSubstring returns a matcher that succeeds when a string, []byte, or
+// fmt.Stringer contains substr.
+func ContainSubstring(substr string) Matcher {
+	return stringMatcher{
+		name:     "ContainSubstring",
+		relation: "contain substring",
+		operand:  substr,
+		test:     func(s string) bool { return strings.Contains(s, substr) },
+	}
+}
+
+// HavePrefix returns a matcher that succeeds when a string, []byte, or fmt.Stringer
+// starts with prefix.
+func HavePrefix(prefix string) Matcher {
+	return stringMatcher{
+		name:     "HavePrefix",
+		relation: "have prefix",
+		operand:  prefix,
+		test:     func(s string) bool { return strings.HasPrefix(s, prefix) },
+	}
+}
+
+// MatchRegexp returns a matcher that succeeds when a string, []byte, or fmt.Stringer
+// matches pattern. An invalid pattern is reported as a match error.
+func MatchRegexp(pattern string) Matcher {
+	re, err := regexp.Compile(pattern)
+
+	return stringMatcher{
+		name:     "MatchRegexp",
+		relation: "match regexp",
+		operand:  pattern,
+		err:      err,
+		test:     func(s string) bool { return re.MatchString(s) },
+	}
+}
+
+// unexported variables.
+var (
+	errNotString = errors.New("value is not a string")
+)
+
+// stringMatcher checks the string form of a value with test.
+type stringMatcher struct {
+	name     string
+	relation string
+	operand  string
+	err      error
+	test     func(string) bool
+}
+
+func (m stringMatcher) FailureMessage(actual any) string {
+	s, _ := stringOf(actual)
+
+	return fmt.Sprintf("expected %q to %s %q", s, m.relation, m.operand)
+}
+
+func (m stringMatcher) Match(actual any) (bool, error) {
+	if m.err != nil {
+		return false, fmt.Errorf("%s: %w", m.name, m.err)
+	}
+
+	s, ok := stringOf(actual)
+	if !ok {
+		return false, fmt.Errorf("%w: %s expects a string, []byte, or fmt.Stringer, got %T", errNotString, m.name, actual)
+	}
+
+	return m.test(s), nil
+}
+
+func (m stringMatcher) String() string {
+	return fmt.Sprintf("%s(%q)", m.name, m.operand)
+}
+
+// stringOf returns the string form of strings, byte slices, and Stringers.
+func stringOf(value any) (string, bool) {
+	switch typed := value.(type) {
+	case string:
+		return typed, true
+	case []byte:
+		return string(typed), true
+	case fmt.Stringer:
+		return typed.String(), true
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.String {
+		return rv.String(), true
+	}
+
+	// Named byte slices, such as json.RawMessage.
+	if rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8 {
+		return string(rv.Bytes()), true
+	}
+
+	return "", false
+}